@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/armon/go-metrics"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -177,6 +178,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithBlockHeight(req.Header.Height)
 	}
 
+	app.resetParallelExecution(req)
+
 	gasMeter := app.getBlockGasMeter(app.deliverState.ctx)
 
 	app.deliverState.ctx = app.deliverState.ctx.
@@ -337,6 +340,10 @@ func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.
 	}()
 
 	resp = app.processProposal(ctx, req)
+	if resp.Status == abci.ResponseProcessProposal_ACCEPT {
+		app.setProposal(req)
+	}
+
 	return resp
 }
 
@@ -397,9 +404,19 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		telemetry.IncrCounter(1, "tx", resultStr)
 		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+		for _, storeGas := range gInfo.StoreGas {
+			telemetry.IncrCounterWithLabels(
+				[]string{"tx", "gas", "store"},
+				float32(storeGas.Gas),
+				[]metrics.Label{
+					telemetry.NewLabel("store", storeGas.StoreName),
+					telemetry.NewLabel("operation", storeGas.Operation),
+				},
+			)
+		}
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	gInfo, result, anteEvents, err := app.deliverTx(req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -453,6 +470,15 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	app.setState(runTxPrepareProposal, header)
 	app.setState(runTxProcessProposal, header)
 
+	// The persisted mempool is replayed on the CheckTx state of the first block
+	// committed once the app is loaded, which has the header of a block.
+	if !app.mempoolReplayed {
+		app.mempoolReplayed = true
+		if err := app.replayMempool(); err != nil {
+			app.logger.Error("failed to replay persisted mempool", "err", err)
+		}
+	}
+
 	// empty/reset the deliver state
 	app.deliverState = nil
 
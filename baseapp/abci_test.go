@@ -16,6 +16,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
@@ -810,6 +811,44 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateTx_GasAttribution(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	gInfo, result, err := suite.baseApp.Simulate(txBytes)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	// the ante handler and the message read and write the counters of the same store
+	require.Len(t, gInfo.StoreGas, 2)
+	require.Equal(t, capKey1.Name(), gInfo.StoreGas[0].StoreName)
+	require.Equal(t, "read", gInfo.StoreGas[0].Operation)
+	require.Equal(t, capKey1.Name(), gInfo.StoreGas[1].StoreName)
+	require.Equal(t, "write", gInfo.StoreGas[1].Operation)
+
+	var storeGas uint64
+	for _, sg := range gInfo.StoreGas {
+		require.NotZero(t, sg.Gas)
+		storeGas += sg.Gas
+	}
+	// the message handler also consumes gas outside of the stores
+	require.Equal(t, gInfo.GasUsed-5, storeGas)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1512,6 +1551,133 @@ func TestABCI_PrepareProposal_Failures(t *testing.T) {
 	require.Equal(t, 1, len(res.Txs))
 }
 
+func TestABCI_Proposal_Lanes(t *testing.T) {
+	pool := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:          "batch",
+			Match:         func(tx sdk.Tx) bool { return len(tx.GetMsgs()) > 1 },
+			Mempool:       mempool.NewSenderNonceMempool(),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	laneOpt := func(bapp *baseapp.BaseApp) {
+		handler := baseapp.NewLaneProposalHandler(pool, bapp)
+		bapp.SetPrepareProposal(handler.PrepareProposalHandler())
+		bapp.SetProcessProposal(handler.ProcessProposalHandler())
+	}
+	suite := NewBaseAppSuite(t, laneOpt, baseapp.SetMempool(pool))
+
+	encode := func(tx sdk.Tx) []byte {
+		bz, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the batch lane is filled first, whatever the insertion order
+	batchTxs := []sdk.Tx{newTxCounter(t, suite.txConfig, 0, 1, 2), newTxCounter(t, suite.txConfig, 1, 3, 4)}
+	defaultTxs := []sdk.Tx{newTxCounter(t, suite.txConfig, 2, 5), newTxCounter(t, suite.txConfig, 3, 6)}
+	for _, tx := range append(defaultTxs, batchTxs...) {
+		require.NoError(t, pool.Insert(sdk.Context{}, tx))
+	}
+	require.Equal(t, 4, pool.CountTx())
+
+	batchSize := int64(len(encode(batchTxs[1])))
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 4 * batchSize, MaxGas: -1},
+		},
+	})
+
+	resPrepareProposal := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes: 1000,
+		Height:     1,
+	})
+	expected := [][]byte{encode(batchTxs[0]), encode(batchTxs[1]), encode(defaultTxs[0]), encode(defaultTxs[1])}
+	require.Equal(t, expected, resPrepareProposal.Txs)
+
+	resProcessProposal := suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: expected, Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcessProposal.Status)
+
+	// the lanes must be ordered
+	unordered := [][]byte{encode(newTxCounter(t, suite.txConfig, 0, 1)), encode(newTxCounter(t, suite.txConfig, 1, 2, 3))}
+	resProcessProposal = suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: unordered, Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcessProposal.Status)
+
+	// the batch lane may use half of the block
+	oversized := [][]byte{encode(batchTxs[0]), encode(batchTxs[1]), encode(newTxCounter(t, suite.txConfig, 2, 5, 6))}
+	resProcessProposal = suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: oversized, Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcessProposal.Status)
+
+	// the space left by the batch lane is used by the default lane
+	resPrepareProposal = suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes: 2 * batchSize,
+		Height:     1,
+	})
+	require.Equal(t, [][]byte{expected[0], expected[2]}, resPrepareProposal.Txs)
+}
+
+func TestABCI_PersistentMempool(t *testing.T) {
+	anteKey := []byte("ante-key")
+	appDB, mempoolDB := dbm.NewMemDB(), dbm.NewMemDB()
+
+	// the transactions are replayed through CheckTx on restart, the last one
+	// becoming invalid
+	restarted := false
+	newSuite := func() *BaseAppSuite {
+		anteOpt := func(bapp *baseapp.BaseApp) {
+			anteHandler := anteHandlerTxTest(t, capKey1, anteKey)
+			bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				if counter, _ := parseTxMemo(t, tx); restarted && counter == 2 {
+					return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid after restart")
+				}
+				return anteHandler(ctx, tx, simulate)
+			})
+		}
+		return newBaseAppSuiteWithDB(t, appDB, anteOpt,
+			baseapp.SetMempool(mempool.NewSenderNonceMempool()), baseapp.SetPersistentMempool(mempoolDB))
+	}
+	prepareProposal := func(suite *BaseAppSuite) [][]byte {
+		return suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 1000, Height: suite.baseApp.LastBlockHeight() + 1}).Txs
+	}
+	// the persisted transactions are replayed by the first commit after a restart
+	commitBlock := func(suite *BaseAppSuite) {
+		height := suite.baseApp.LastBlockHeight() + 1
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+		suite.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+		suite.baseApp.Commit()
+	}
+
+	suite := newSuite()
+	suite.baseApp.InitChain(abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	commitBlock(suite)
+
+	var txs [][]byte
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, i, i))
+		require.NoError(t, err)
+		res := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		require.True(t, res.IsOK(), res.Log)
+		txs = append(txs, txBytes)
+	}
+	require.Equal(t, txs, prepareProposal(suite))
+
+	restarted = true
+	suite = newSuite()
+	require.Empty(t, prepareProposal(suite))
+	commitBlock(suite)
+	require.Equal(t, txs[:2], prepareProposal(suite))
+
+	// the dropped transaction is not recorded anymore
+	suite = newSuite()
+	commitBlock(suite)
+	require.Equal(t, txs[:2], prepareProposal(suite))
+}
+
 func TestABCI_PrepareProposal_PanicRecovery(t *testing.T) {
 	prepareOpt := func(app *baseapp.BaseApp) {
 		app.SetPrepareProposal(func(ctx sdk.Context, rpp abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
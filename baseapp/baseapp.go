@@ -23,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 type (
@@ -63,6 +64,7 @@ type BaseApp struct { //nolint: maligned
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
 
 	mempool         mempool.Mempool            // application side mempool
+	mempoolReplayed bool                       // whether the persisted mempool was replayed
 	anteHandler     sdk.AnteHandler            // ante handler for fee and auth
 	postHandler     sdk.PostHandler            // post handler, optional, e.g. for tips
 	initChainer     sdk.InitChainer            // initialize state with validators and state blob
@@ -141,9 +143,21 @@ type BaseApp struct { //nolint: maligned
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// gasAttribution set will attribute the gas consumed by the transactions to the
+	// stores and operations consuming it, simulations always attribute it.
+	gasAttribution bool
+
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []storetypes.ABCIListener
+
+	// parallelExecutor executes the transactions of the blocks optimistically in
+	// parallel, nil if they are executed serially
+	parallelExecutor *parallelExecutor
+
+	// stateOverrideHandlers apply the module overrides of the state of the
+	// simulations
+	stateOverrideHandlers []txtypes.StateOverrideHandler
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -314,6 +328,18 @@ func (app *BaseApp) CommitMultiStore() storetypes.CommitMultiStore {
 	return app.cms
 }
 
+// Close is called in start cmd to gracefully cleanup resources, it waits for the
+// background pruning of the CommitMultiStore to finish.
+func (app *BaseApp) Close() error {
+	if mp, ok := app.mempool.(*mempool.PersistentMempool); ok {
+		if err := mp.Close(); err != nil {
+			return err
+		}
+	}
+
+	return app.cms.Close()
+}
+
 // SnapshotManager returns the snapshot manager.
 // application use this to register extra extension snapshotters.
 func (app *BaseApp) SnapshotManager() *snapshots.Manager {
@@ -392,6 +418,10 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setGasAttribution(enabled bool) {
+	app.gasAttribution = enabled
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -571,7 +601,14 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 	if modeState == nil {
 		panic(fmt.Sprintf("state is nil for mode %v", mode))
 	}
-	ctx := modeState.ctx.
+
+	return app.contextForTx(modeState.ctx, mode, txBytes)
+}
+
+// contextForTx returns the context for the tx w/ txBytes derived from the context
+// of the state of the mode.
+func (app *BaseApp) contextForTx(ctx sdk.Context, mode runTxMode, txBytes []byte) sdk.Context {
+	ctx = ctx.
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos)
 
@@ -615,19 +652,36 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, app.mempool.Remove)
+}
+
+// runTxWithContext processes a transaction as runTx within the given context, the
+// transaction passing the AnteHandler in DeliverTx mode is removed from the mempool
+// with removeTx.
+func (app *BaseApp) runTxWithContext(
+	ctx sdk.Context, mode runTxMode, txBytes []byte, removeTx func(sdk.Tx) error,
+) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
+	tracer := txTracerFromContext(ctx)
 
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, 0, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
+	// The attribution is kept by the AnteHandler when it replaces the gas meter,
+	// see x/auth/ante.SetGasMeter.
+	var attribution *storetypes.GasAttribution
+	if mode == runTxModeSimulate || app.gasAttribution || tracer != nil {
+		attribution = storetypes.NewGasAttribution()
+		ctx = ctx.WithGasMeter(storetypes.NewAttributingGasMeter(ctx.GasMeter(), attribution))
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
@@ -635,6 +689,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		if attribution != nil {
+			gInfo.StoreGas = storeGasInfos(attribution)
+		}
 	}()
 
 	blockGasConsumed := false
@@ -686,6 +743,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+		tracer.beginStep(txtypes.TraceStepType_TRACE_STEP_TYPE_ANTE, 0, nil, anteCtx.GasMeter().GasConsumed())
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
 
 		if !newCtx.IsZero() {
@@ -699,10 +757,17 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 
 		events := ctx.EventManager().Events()
+		tracer.endStep(ctx.GasMeter().GasConsumed(), events.ToABCIEvents())
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
 
+		// keep attributing the gas of the messages if the AnteHandler replaced the
+		// gas meter without keeping the attribution
+		if _, ok := ctx.GasMeter().(storetypes.AttributingGasMeter); attribution != nil && !ok {
+			ctx = ctx.WithGasMeter(storetypes.NewAttributingGasMeter(ctx.GasMeter(), attribution))
+		}
+
 		if err != nil {
 			return gInfo, nil, nil, 0, err
 		}
@@ -718,7 +783,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			return gInfo, nil, anteEvents, priority, err
 		}
 	} else if mode == runTxModeDeliver {
-		err = app.mempool.Remove(tx)
+		err = removeTx(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents, priority,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -744,10 +809,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			// Note that the state is still preserved.
 			postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
 
+			tracer.beginStep(txtypes.TraceStepType_TRACE_STEP_TYPE_POST, 0, nil, postCtx.GasMeter().GasConsumed())
 			newCtx, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate, err == nil)
 			if err != nil {
 				return gInfo, nil, anteEvents, priority, err
 			}
+			tracer.endStep(newCtx.GasMeter().GasConsumed(), newCtx.EventManager().ABCIEvents())
 
 			result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
 		}
@@ -768,6 +835,17 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	return gInfo, result, anteEvents, priority, err
 }
 
+// storeGasInfos returns the gas recorded by the attribution, ordered by store name
+// and operation.
+func storeGasInfos(attribution *storetypes.GasAttribution) []sdk.StoreGasInfo {
+	consumed := attribution.Consumed()
+	infos := make([]sdk.StoreGasInfo, len(consumed))
+	for i, c := range consumed {
+		infos[i] = sdk.StoreGasInfo{StoreName: c.StoreName, Operation: c.Operation.String(), Gas: c.Gas}
+	}
+	return infos
+}
+
 // runMsgs iterates through a list of messages and executes them with the provided
 // Context and execution mode. Messages will only be executed during simulation
 // and DeliverTx. An error is returned if any single message fails or if a
@@ -777,6 +855,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 	msgLogs := make(sdk.ABCIMessageLogs, 0, len(msgs))
 	events := sdk.EmptyEvents()
 	var msgResponses []*codectypes.Any
+	tracer := txTracerFromContext(ctx)
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
@@ -784,6 +863,8 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
+		tracer.beginStep(txtypes.TraceStepType_TRACE_STEP_TYPE_MSG, i, msg, ctx.GasMeter().GasConsumed())
+
 		handler := app.msgServiceRouter.Handler(msg)
 		if handler == nil {
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
@@ -797,6 +878,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 		// create message events
 		msgEvents := createEvents(msgResult.GetEvents(), msg)
+		tracer.endStep(ctx.GasMeter().GasConsumed(), msgEvents.ToABCIEvents())
 
 		// append message events, data and logs
 		//
@@ -0,0 +1,16 @@
+package baseapp
+
+import "context"
+
+// CircuitBreaker is an interface that defines the methods for a circuit breaker.
+// The MsgServiceRouter refuses to execute the Msgs it does not allow.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
+
+// CircuitBreakerError is an optional interface of a CircuitBreaker returning
+// its own error for the Msgs it does not allow. The MsgServiceRouter returns
+// ErrUnauthorized for the circuit breakers which do not implement it.
+type CircuitBreakerError interface {
+	DisabledError(typeURL string) error
+}
@@ -0,0 +1,155 @@
+package baseapp
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// LaneProposalHandler defines the ABCI PrepareProposal and ProcessProposal
+// handlers of the blocks made of the lanes of a LaneMempool.
+type LaneProposalHandler struct {
+	mempool    *mempool.LaneMempool
+	txVerifier ProposalTxVerifier
+}
+
+func NewLaneProposalHandler(mp *mempool.LaneMempool, txVerifier ProposalTxVerifier) LaneProposalHandler {
+	return LaneProposalHandler{
+		mempool:    mp,
+		txVerifier: txVerifier,
+	}
+}
+
+// PrepareProposalHandler returns the PrepareProposal handler filling the block
+// with the lanes of the mempool, in order. The valid transactions of a lane are
+// added in the order of its mempool until the next one would exceed the block
+// space of the lane, i.e. its share of RequestPrepareProposal.MaxTxBytes and of
+// the max gas of the block, or the space left in the block. Transactions are
+// valid if they successfully encode to bytes and pass runTx (AnteHandler only),
+// the invalid ones are removed from the mempool.
+//
+// The transactions requested from CometBFT are ignored.
+func (h LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		var (
+			selectedTxs          [][]byte
+			totalBytes, totalGas int64
+		)
+
+		maxGas := blockMaxGas(ctx)
+		for _, lane := range h.mempool.Lanes() {
+			var (
+				laneBytes, laneGas int64
+				maxLaneBytes       = lane.Limit(req.MaxTxBytes)
+				maxLaneGas         = lane.Limit(maxGas)
+			)
+
+			for iterator := lane.Mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+				memTx := iterator.Tx()
+
+				bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+				if err != nil {
+					err := h.mempool.Remove(memTx)
+					if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+						panic(err)
+					}
+					continue
+				}
+
+				txSize, txGas := int64(len(bz)), txGasLimit(memTx)
+				if laneBytes+txSize > maxLaneBytes || totalBytes+txSize > req.MaxTxBytes {
+					break
+				}
+				if maxGas > 0 && (laneGas+txGas > maxLaneGas || totalGas+txGas > maxGas) {
+					break
+				}
+
+				laneBytes += txSize
+				laneGas += txGas
+				totalBytes += txSize
+				totalGas += txGas
+				selectedTxs = append(selectedTxs, bz)
+			}
+		}
+
+		return abci.ResponsePrepareProposal{Txs: selectedTxs}
+	}
+}
+
+// ProcessProposalHandler returns the ProcessProposal handler verifying that the
+// proposal is made of the lanes of the mempool. The proposal is rejected if:
+//
+// 1. A transaction does not decode to a valid transaction (i.e. pass runTx,
+// AnteHandler only) or is matched by no lane.
+// 2. The transactions are not ordered by lane.
+// 3. The transactions of a lane exceed its share of the max bytes or of the max
+// gas of the block.
+//
+// The max bytes of the block are used as the proposer's MaxTxBytes are not known,
+// they are lower.
+func (h LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		var (
+			lanes              = h.mempool.Lanes()
+			current            = 0
+			laneBytes, laneGas int64
+		)
+
+		maxBytes, maxGas := blockMaxBytes(ctx), blockMaxGas(ctx)
+		for _, txBytes := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			if err != nil {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+
+			i := h.mempool.LaneIndex(tx)
+			if i < current {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+			if i > current {
+				current, laneBytes, laneGas = i, 0, 0
+			}
+
+			laneBytes += int64(len(txBytes))
+			laneGas += txGasLimit(tx)
+			if maxBytes > 0 && laneBytes > lanes[current].Limit(maxBytes) {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+			if maxGas > 0 && laneGas > lanes[current].Limit(maxGas) {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+		}
+
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+	}
+}
+
+// blockMaxBytes returns the max bytes of a block, 0 if they are not set.
+func blockMaxBytes(ctx sdk.Context) int64 {
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		return cp.Block.MaxBytes
+	}
+
+	return 0
+}
+
+// blockMaxGas returns the max gas of a block, 0 or -1 if it is unlimited.
+func blockMaxGas(ctx sdk.Context) int64 {
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		return cp.Block.MaxGas
+	}
+
+	return 0
+}
+
+// txGasLimit returns the gas limit of the transaction, 0 if it has no fee.
+func txGasLimit(tx sdk.Tx) int64 {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		return int64(feeTx.GetGas())
+	}
+
+	return 0
+}
@@ -0,0 +1,44 @@
+package baseapp
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// encodeTx encodes the transaction with the TxEncoder of the BaseApp, which may
+// be set after the mempool.
+func (app *BaseApp) encodeTx(tx sdk.Tx) ([]byte, error) {
+	return app.txEncoder(tx)
+}
+
+// replayMempool replays the transactions recorded by a PersistentMempool through
+// CheckTx, which inserts the ones which are still valid back in the mempool. It is
+// called by the first Commit, so that the CheckTx state has the header of the last
+// committed block.
+func (app *BaseApp) replayMempool() error {
+	mp, ok := app.mempool.(*mempool.PersistentMempool)
+	if !ok {
+		return nil
+	}
+
+	kept, dropped, err := mp.Replay(func(txBytes []byte) error {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		if !res.IsOK() {
+			return errors.New(res.Log)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if kept > 0 || dropped > 0 {
+		app.logger.Info("replayed persisted mempool", "kept", kept, "dropped", dropped)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package baseapp_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -15,6 +18,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -142,3 +147,58 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb.disabled[typeURL], nil
+}
+
+var errMockMsgDisabled = errors.New("mock msg disabled")
+
+// mockCircuitBreakerWithError returns its own error for the disabled msgs.
+type mockCircuitBreakerWithError struct {
+	mockCircuitBreaker
+}
+
+func (cb mockCircuitBreakerWithError) DisabledError(typeURL string) error {
+	return fmt.Errorf("%s: %w", typeURL, errMockMsgDisabled)
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	var (
+		appBuilder        *runtime.AppBuilder
+		interfaceRegistry codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(makeMinimalConfig(), &appBuilder, &interfaceRegistry)
+	require.NoError(t, err)
+	app := appBuilder.Build(log.NewNopLogger(), dbm.NewMemDB(), nil)
+
+	testdata.RegisterInterfaces(interfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+	cb := mockCircuitBreaker{disabled: map[string]bool{}}
+	app.SetCircuitBreaker(cb)
+	_ = app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	ctx := app.NewContext(false, cmtproto.Header{Height: 1})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := app.MsgServiceRouter().Handler(msg)
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	// a tripped msg is not executed anymore
+	cb.disabled[sdk.MsgTypeURL(msg)] = true
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.ErrorContains(t, err, "circuit breaker disables execution of /testpb.MsgCreateDog")
+
+	// the circuit breaker may return its own error
+	app.MsgServiceRouter().SetCircuit(mockCircuitBreakerWithError{cb})
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, errMockMsgDisabled)
+}
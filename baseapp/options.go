@@ -10,6 +10,7 @@ import (
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cast"
 
@@ -18,6 +19,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -65,6 +67,19 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetGasAttribution provides a BaseApp option function that enables the attribution of
+// the gas consumed by the transactions to the stores and operations consuming it.
+func SetGasAttribution(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setGasAttribution(enabled) }
+}
+
+// SetParallelExecution provides a BaseApp option function that executes the
+// transactions of the blocks optimistically in parallel with the number of workers,
+// the transactions are executed serially if it is not positive.
+func SetParallelExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setParallelExecution(workers) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
@@ -75,6 +90,11 @@ func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLDisableFastNode(disable) }
 }
 
+// SetAsyncPruning enables/disables the pruning of the IAVL store heights in a background worker.
+func SetAsyncPruning(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetAsyncPruning(enabled) }
+}
+
 // SetIAVLLazyLoading enables/disables lazy loading of the IAVL store.
 func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetLazyLoading(lazyLoading) }
@@ -91,11 +111,34 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetSnapshotRestoreConcurrency sets the maximum number of stores imported concurrently
+// while restoring a state sync snapshot.
+func SetSnapshotRestoreConcurrency(concurrency int) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetRestoreConcurrency(concurrency) }
+}
+
 // SetMempool sets the mempool on BaseApp.
 func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetPersistentMempool wraps the mempool of the BaseApp, which must be set by a
+// previous option, in a PersistentMempool recording its transactions to the db.
+// They are replayed through CheckTx by the first Commit once the BaseApp is loaded.
+func SetPersistentMempool(db dbm.DB) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if app.mempool == nil {
+			panic("SetPersistentMempool() requires a mempool")
+		}
+
+		mp, err := mempool.NewPersistentMempool(app.mempool, db, app.encodeTx)
+		if err != nil {
+			panic(fmt.Errorf("failed to load persistent mempool: %w", err))
+		}
+		app.SetMempool(mp)
+	}
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -252,18 +295,29 @@ func (app *BaseApp) SetStreamingService(
 	if err != nil {
 		return err
 	}
-	// add the listeners for each StoreKey
 	for _, streamer := range streamers {
-		for key, lis := range streamer.Listeners() {
-			app.cms.AddListeners(key, lis)
-		}
-		// register the StreamingService within the BaseApp
-		// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
-		app.abciListeners = append(app.abciListeners, streamer)
+		app.AddStreamingService(streamer)
 	}
 	return nil
 }
 
+// AddStreamingService adds a streaming service built by the application, e.g. to
+// feed an index of the state, to the BaseApp hooks and loads its listeners into the
+// multistore.
+func (app *BaseApp) AddStreamingService(streamer storetypes.StreamingService) {
+	// add the listeners for each StoreKey
+	for key, lis := range streamer.Listeners() {
+		app.cms.AddListeners(key, lis)
+	}
+	// register the StreamingService within the BaseApp
+	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, streamer)
+	// the version store also serves the queries of the heights pruned from the multistore
+	if versionDB, ok := streamer.(*versiondb.StreamingService); ok {
+		app.cms.SetVersionStore(versionDB.VersionStore())
+	}
+}
+
 // SetTxDecoder sets the TxDecoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
@@ -297,6 +351,15 @@ func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
 	app.processProposal = handler
 }
 
+// SetStateOverrideHandlers sets the handlers applying the module overrides of the
+// state of the simulations, in order.
+func (app *BaseApp) SetStateOverrideHandlers(handlers ...txtypes.StateOverrideHandler) {
+	if app.sealed {
+		panic("SetStateOverrideHandlers() on sealed BaseApp")
+	}
+	app.stateOverrideHandlers = handlers
+}
+
 // SetPrepareProposal sets the prepare proposal function for the BaseApp.
 func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
 	if app.sealed {
@@ -314,3 +377,13 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 
 	app.cms.SetMetrics(gatherer)
 }
+
+// SetCircuitBreaker sets the circuit breaker checked by the MsgServiceRouter
+// before executing a Msg.
+func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
+	if app.sealed {
+		panic("SetCircuitBreaker() on sealed BaseApp")
+	}
+
+	app.msgServiceRouter.SetCircuit(cb)
+}
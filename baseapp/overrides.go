@@ -0,0 +1,74 @@
+package baseapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// SimulateWithOverrides simulates the transaction against the CheckTx state changed
+// by the overrides. The overrides are applied on the branch of the simulation only,
+// the store overrides first and then the module ones by the state override handlers.
+// Applying them consumes no gas and emits no events.
+func (app *BaseApp) SimulateWithOverrides(txBytes []byte, overrides *txtypes.StateOverrides) (sdk.GasInfo, *sdk.Result, error) {
+	ctx := app.getContextForTx(runTxModeSimulate, txBytes)
+
+	if !overrides.IsEmpty() {
+		if err := app.applyStateOverrides(ctx, overrides); err != nil {
+			return sdk.GasInfo{}, nil, err
+		}
+	}
+
+	gasInfo, result, _, _, err := app.runTxWithContext(ctx, runTxModeSimulate, txBytes, app.mempool.Remove)
+	return gasInfo, result, err
+}
+
+// applyStateOverrides applies the overrides to the state of the context.
+func (app *BaseApp) applyStateOverrides(ctx sdk.Context, overrides *txtypes.StateOverrides) error {
+	if err := overrides.ValidateBasic(); err != nil {
+		return err
+	}
+
+	ctx = ctx.
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+
+	if len(overrides.Stores) > 0 {
+		cms, ok := app.cms.(interface {
+			StoreKeysByName() map[string]storetypes.StoreKey
+		})
+		if !ok {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "store overrides are not supported by the multistore")
+		}
+
+		keys := cms.StoreKeysByName()
+		for _, store := range overrides.Stores {
+			key, ok := keys[store.StoreName]
+			if !ok {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown store %s", store.StoreName)
+			}
+
+			kvStore := ctx.MultiStore().GetKVStore(key)
+			if store.Delete {
+				kvStore.Delete(store.Key)
+			} else {
+				kvStore.Set(store.Key, store.Value)
+			}
+		}
+	}
+
+	if (len(overrides.Sequences) > 0 || len(overrides.Balances) > 0) && len(app.stateOverrideHandlers) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no state override handler is set")
+	}
+
+	for _, handler := range app.stateOverrideHandlers {
+		if err := handler(ctx, overrides); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
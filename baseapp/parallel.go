@@ -0,0 +1,283 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cockroachdb/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// parallelExecutor executes the transactions of a block optimistically in parallel.
+//
+// The transactions of the proposal accepted by ProcessProposal are executed at the
+// first DeliverTx of the block, each on its own branch of the DeliverTx state
+// recording the values it reads. Every DeliverTx then writes the branch of its
+// transaction to the DeliverTx state if none of the values the transaction read was
+// changed by the transactions delivered before it, and executes the transaction again
+// otherwise, so the results and the state are the ones of a serial execution.
+//
+// The transactions are executed concurrently, so the keepers must not keep state
+// outside of the stores which is changed by the transactions.
+type parallelExecutor struct {
+	workers int
+
+	// the proposal accepted by ProcessProposal
+	height int64
+	hash   []byte
+	txs    [][]byte
+
+	// the speculative executions of the transactions of the block being delivered,
+	// a nil execution must be executed by DeliverTx
+	executed   bool
+	executions []*speculativeTx
+	next       int
+}
+
+// speculativeTx is the execution of a transaction on a branch of the DeliverTx state.
+type speculativeTx struct {
+	ms            storetypes.ReadTrackedCacheMultiStore
+	gasMeter      *speculativeGasMeter
+	blockGasMeter storetypes.GasMeter
+
+	// tx is the transaction to remove from the mempool, nil if it did not pass the
+	// AnteHandler
+	tx sdk.Tx
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// setParallelExecution enables the parallel execution of the transactions of the
+// blocks with the number of workers, or disables it if workers is not positive.
+func (app *BaseApp) setParallelExecution(workers int) {
+	if workers <= 0 {
+		app.parallelExecutor = nil
+		return
+	}
+
+	app.parallelExecutor = &parallelExecutor{workers: workers}
+}
+
+// setProposal records the transactions of the proposal accepted by ProcessProposal,
+// they are executed in parallel if the block is committed.
+func (app *BaseApp) setProposal(req abci.RequestProcessProposal) {
+	if app.parallelExecutor == nil {
+		return
+	}
+
+	pe := app.parallelExecutor
+	pe.height, pe.hash, pe.txs = req.Height, req.Hash, req.Txs
+}
+
+// resetParallelExecution discards the speculative executions of the previous block,
+// and the accepted proposal if it is not the block starting at the header.
+func (app *BaseApp) resetParallelExecution(req abci.RequestBeginBlock) {
+	if app.parallelExecutor == nil {
+		return
+	}
+
+	pe := app.parallelExecutor
+	if pe.height != req.Header.Height || !bytes.Equal(pe.hash, req.Hash) {
+		pe.height, pe.hash, pe.txs = 0, nil, nil
+	}
+	pe.executed, pe.executions, pe.next = false, nil, 0
+}
+
+// deliverTx executes the transaction in DeliverTx mode, from its speculative
+// execution if it is still valid.
+func (app *BaseApp) deliverTx(txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	if spec := app.nextSpeculativeTx(txBytes); spec != nil && app.writeSpeculativeTx(spec) {
+		return spec.gInfo, spec.result, spec.anteEvents, spec.err
+	}
+
+	gInfo, result, anteEvents, _, err = app.runTx(runTxModeDeliver, txBytes)
+	return gInfo, result, anteEvents, err
+}
+
+// nextSpeculativeTx returns the speculative execution of the next transaction of the
+// block, nil if the transaction was not executed speculatively.
+func (app *BaseApp) nextSpeculativeTx(txBytes []byte) *speculativeTx {
+	pe := app.parallelExecutor
+	if pe == nil || len(pe.txs) == 0 {
+		return nil
+	}
+
+	if !pe.executed {
+		pe.executed = true
+		app.executeSpeculatively()
+	}
+
+	i := pe.next
+	pe.next++
+	if i >= len(pe.executions) || !bytes.Equal(pe.txs[i], txBytes) {
+		return nil
+	}
+
+	return pe.executions[i]
+}
+
+// executeSpeculatively executes the transactions of the block in parallel on the
+// DeliverTx state, which is not changed until they are all executed.
+func (app *BaseApp) executeSpeculatively() {
+	pe := app.parallelExecutor
+
+	// a trace of the reads of concurrent transactions is not deterministic, and the
+	// speculative gas meters are infinite
+	ms, ok := app.deliverState.ms.(storetypes.ReadTrackingMultiStore)
+	gasMeter := app.deliverState.ctx.GasMeter()
+	if !ok || ms.TracingEnabled() || gasMeter.Limit() != storetypes.NewInfiniteGasMeter().Limit() {
+		return
+	}
+
+	gasConsumed := gasMeter.GasConsumed()
+	pe.executions = make([]*speculativeTx, len(pe.txs))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < pe.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				pe.executions[i] = app.runSpeculativeTx(ms, gasConsumed, pe.txs[i])
+			}
+		}()
+	}
+
+	for i := range pe.txs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// runSpeculativeTx executes the transaction on a branch of the DeliverTx state. It
+// returns nil if the execution panics out of runTx.
+func (app *BaseApp) runSpeculativeTx(ms storetypes.ReadTrackingMultiStore, gasConsumed uint64, txBytes []byte) (spec *speculativeTx) {
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("panic recovered in speculative transaction execution", "panic", r)
+			spec = nil
+		}
+	}()
+
+	spec = &speculativeTx{
+		ms:            ms.CacheMultiStoreWithReadTracking(),
+		gasMeter:      newSpeculativeGasMeter(gasConsumed),
+		blockGasMeter: storetypes.NewInfiniteGasMeter(),
+	}
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(spec.ms).
+		WithGasMeter(spec.gasMeter).
+		WithBlockGasMeter(spec.blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+	ctx = app.contextForTx(ctx, runTxModeDeliver, txBytes)
+
+	removeTx := func(tx sdk.Tx) error {
+		spec.tx = tx
+		return nil
+	}
+	spec.gInfo, spec.result, spec.anteEvents, _, spec.err = app.runTxWithContext(ctx, runTxModeDeliver, txBytes, removeTx)
+
+	return spec
+}
+
+// writeSpeculativeTx writes the speculative execution of the transaction to the
+// DeliverTx state as runTx would have. It returns false, leaving the DeliverTx state
+// unchanged, if the execution depends on a state changed since it happened.
+func (app *BaseApp) writeSpeculativeTx(spec *speculativeTx) bool {
+	ctx := app.deliverState.ctx
+
+	// runTx rejects the transaction or fails on the block gas limit
+	blockGasMeter, blockGas := ctx.BlockGasMeter(), spec.blockGasMeter.GasConsumed()
+	if blockGasMeter.IsOutOfGas() || blockGas > blockGasMeter.Limit()-blockGasMeter.GasConsumed() {
+		return false
+	}
+
+	// the gas consumed by the DeliverTx state is only reproduced if the transaction
+	// did not read it
+	gasMeter, gasConsumed := ctx.GasMeter(), spec.gasMeter.GasMeter.GasConsumed()
+	if gasConsumed < spec.gasMeter.base || (spec.gasMeter.read && gasMeter.GasConsumed() != spec.gasMeter.base) {
+		return false
+	}
+
+	if !spec.ms.ValidateReads() {
+		return false
+	}
+
+	if spec.tx != nil {
+		if err := app.mempool.Remove(spec.tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return false
+		}
+	}
+
+	blockGasMeter.ConsumeGas(blockGas, "block gas meter")
+	gasMeter.ConsumeGas(gasConsumed-spec.gasMeter.base, "speculative transaction")
+	spec.ms.Write()
+
+	return true
+}
+
+var _ storetypes.GasMeter = (*speculativeGasMeter)(nil)
+
+// speculativeGasMeter stands for the gas meter of the DeliverTx state during the
+// speculative execution of a transaction. It starts from the gas consumed by the
+// DeliverTx state and records if the transaction reads it, making its execution
+// depend on the transactions delivered before it.
+type speculativeGasMeter struct {
+	storetypes.GasMeter
+	base uint64
+	read bool
+}
+
+func newSpeculativeGasMeter(gasConsumed uint64) *speculativeGasMeter {
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	gasMeter.ConsumeGas(gasConsumed, "DeliverTx state")
+
+	return &speculativeGasMeter{GasMeter: gasMeter, base: gasConsumed}
+}
+
+// GasConsumed implements storetypes.GasMeter.
+func (g *speculativeGasMeter) GasConsumed() storetypes.Gas {
+	g.read = true
+	return g.GasMeter.GasConsumed()
+}
+
+// GasConsumedToLimit implements storetypes.GasMeter.
+func (g *speculativeGasMeter) GasConsumedToLimit() storetypes.Gas {
+	g.read = true
+	return g.GasMeter.GasConsumedToLimit()
+}
+
+// GasRemaining implements storetypes.GasMeter.
+func (g *speculativeGasMeter) GasRemaining() storetypes.Gas {
+	g.read = true
+	return g.GasMeter.GasRemaining()
+}
+
+// IsPastLimit implements storetypes.GasMeter.
+func (g *speculativeGasMeter) IsPastLimit() bool {
+	g.read = true
+	return g.GasMeter.IsPastLimit()
+}
+
+// IsOutOfGas implements storetypes.GasMeter.
+func (g *speculativeGasMeter) IsOutOfGas() bool {
+	g.read = true
+	return g.GasMeter.IsOutOfGas()
+}
+
+// String implements storetypes.GasMeter.
+func (g *speculativeGasMeter) String() string {
+	g.read = true
+	return fmt.Sprintf("SpeculativeGasMeter:\n  %s", g.GasMeter.String())
+}
@@ -0,0 +1,213 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// TraceTx executes the transaction and returns the trace of its execution.
+//
+// With a nil BeginBlock request, the transaction is simulated on the CheckTx state.
+// Otherwise the block of the request is replayed on the state committed at the
+// height preceding it: BeginBlocker is run with the header, the last commit info and
+// the evidence of the request, then the transactions preceding the traced one in the
+// block, then the traced transaction in DeliverTx mode with the votes of the last
+// commit info. Nothing is written to the state.
+func (app *BaseApp) TraceTx(txBytes []byte, req *abci.RequestBeginBlock, predecessors [][]byte) (*txtypes.TxTrace, error) {
+	mode, ctx := runTxModeSimulate, sdk.Context{}
+	keepTx := func(sdk.Tx) error { return nil }
+
+	if req == nil {
+		ctx = app.getContextForTx(runTxModeSimulate, txBytes)
+	} else {
+		ms, err := app.cms.CacheMultiStoreWithVersion(req.Header.Height - 1)
+		if err != nil {
+			return nil, err
+		}
+
+		mode = runTxModeDeliver
+		ctx = sdk.NewContext(ms, req.Header, false, app.logger).
+			WithHeaderHash(req.Hash)
+		ctx = ctx.
+			WithConsensusParams(app.GetConsensusParams(ctx)).
+			WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+
+		if app.beginBlocker != nil {
+			if _, err := app.beginBlocker(ctx, *req); err != nil {
+				return nil, fmt.Errorf("failed to replay BeginBlock at height %d: %w", req.Header.Height, err)
+			}
+		}
+
+		votes := req.LastCommitInfo.GetVotes()
+		for _, tx := range predecessors {
+			txCtx := app.contextForTx(ctx, runTxModeDeliver, tx).
+				WithVoteInfos(votes).
+				WithGasMeter(storetypes.NewInfiniteGasMeter()).
+				WithEventManager(sdk.NewEventManager())
+			_, _, _, _, _ = app.runTxWithContext(txCtx, runTxModeDeliver, tx, keepTx) //nolint:dogsled
+		}
+
+		ctx = app.contextForTx(ctx, runTxModeDeliver, txBytes).WithVoteInfos(votes)
+	}
+
+	tracer := &txTracer{}
+	ctx = ctx.
+		WithMultiStore(newTracingMultiStore(ctx.MultiStore().CacheMultiStore(), tracer)).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		WithValue(txTracerKey{}, tracer)
+
+	gInfo, result, _, _, err := app.runTxWithContext(ctx, mode, txBytes, keepTx)
+
+	// the step failing the transaction is not ended
+	tracer.endStep(gInfo.GasUsed, nil)
+
+	trace := &txtypes.TxTrace{
+		GasInfo: &gInfo,
+		Result:  result,
+		Steps:   tracer.steps,
+	}
+	if req != nil {
+		trace.Height = req.Header.Height
+	}
+	if err != nil {
+		trace.Error = err.Error()
+	}
+
+	return trace, nil
+}
+
+// txTracerKey is the context key of the tracer of the transaction executed by TraceTx.
+type txTracerKey struct{}
+
+// txTracer records the steps of the execution of a transaction and the store
+// operations traced by tracekv during each of them. A nil txTracer records nothing.
+type txTracer struct {
+	steps    []*txtypes.TraceStep
+	current  *txtypes.TraceStep
+	gasStart uint64
+}
+
+var _ io.Writer = (*txTracer)(nil)
+
+// txTracerFromContext returns the tracer of the transaction executed with the
+// context, nil if the transaction is not traced.
+func txTracerFromContext(ctx sdk.Context) *txTracer {
+	tracer, _ := ctx.Value(txTracerKey{}).(*txTracer)
+	return tracer
+}
+
+// beginStep starts a step of the execution, the store operations are recorded in
+// it until it ends.
+func (t *txTracer) beginStep(stepType txtypes.TraceStepType, msgIndex int, msg sdk.Msg, gasConsumed uint64) {
+	if t == nil {
+		return
+	}
+
+	t.current = &txtypes.TraceStep{StepType: stepType}
+	if msg != nil {
+		t.current.MsgIndex = uint32(msgIndex)
+		t.current.MsgTypeUrl = sdk.MsgTypeURL(msg)
+	}
+	t.steps = append(t.steps, t.current)
+	t.gasStart = gasConsumed
+}
+
+// endStep ends the current step with the gas consumed by the transaction and the
+// events emitted by the step.
+func (t *txTracer) endStep(gasConsumed uint64, events []abci.Event) {
+	if t == nil || t.current == nil {
+		return
+	}
+
+	t.current.GasConsumed = gasConsumed
+	if gasConsumed > t.gasStart {
+		t.current.GasUsed = gasConsumed - t.gasStart
+	}
+	t.current.Events = events
+	t.current = nil
+}
+
+// traceOperation is an operation written by tracekv.
+type traceOperation struct {
+	Operation string                  `json:"operation"`
+	Key       []byte                  `json:"key"`
+	Value     []byte                  `json:"value"`
+	Metadata  storetypes.TraceContext `json:"metadata"`
+}
+
+// Write implements io.Writer, it records the operation written by tracekv in the
+// current step.
+func (t *txTracer) Write(p []byte) (int, error) {
+	line := bytes.TrimSpace(p)
+	if len(line) == 0 || t.current == nil {
+		return len(p), nil
+	}
+
+	var op traceOperation
+	if err := json.Unmarshal(line, &op); err != nil {
+		return 0, err
+	}
+
+	storeName, _ := op.Metadata[storeNameTraceKey].(string)
+	t.current.Operations = append(t.current.Operations, txtypes.StoreOperation{
+		StoreName: storeName,
+		Operation: op.Operation,
+		Key:       op.Key,
+		Value:     op.Value,
+	})
+
+	return len(p), nil
+}
+
+// storeNameTraceKey is the TraceContext metadata key of the store name of the
+// operations traced by a tracingMultiStore.
+const storeNameTraceKey = "store_name"
+
+// tracingMultiStore is a CacheMultiStore tracing the operations on its stores with
+// tracekv. Its branches trace their own stores, so that every operation made by the
+// transaction is traced once, whichever branch it is made on.
+type tracingMultiStore struct {
+	parentMultiStore
+	tracer *txTracer
+}
+
+// parentMultiStore is the CacheMultiStore embedded by tracingMultiStore, which
+// overrides its CacheMultiStore method.
+type parentMultiStore interface {
+	storetypes.CacheMultiStore
+}
+
+func newTracingMultiStore(parent storetypes.CacheMultiStore, tracer *txTracer) tracingMultiStore {
+	return tracingMultiStore{parentMultiStore: parent, tracer: tracer}
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms tracingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	tc := storetypes.TraceContext{storeNameTraceKey: key.Name()}
+	return tracekv.NewStore(ms.parentMultiStore.GetKVStore(key), ms.tracer, tc)
+}
+
+// CacheMultiStore implements storetypes.MultiStore.
+func (ms tracingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newTracingMultiStore(ms.parentMultiStore.CacheMultiStore(), ms.tracer)
+}
+
+// SetTracer implements storetypes.MultiStore.
+func (ms tracingMultiStore) SetTracer(w io.Writer) storetypes.MultiStore {
+	return newTracingMultiStore(ms.parentMultiStore.SetTracer(w).(storetypes.CacheMultiStore), ms.tracer)
+}
+
+// SetTracingContext implements storetypes.MultiStore.
+func (ms tracingMultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	return newTracingMultiStore(ms.parentMultiStore.SetTracingContext(tc).(storetypes.CacheMultiStore), ms.tracer)
+}
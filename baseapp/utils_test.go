@@ -236,7 +236,7 @@ func setIntOnStore(store storetypes.KVStore, key []byte, i int64) {
 }
 
 type paramStore struct {
-	db *dbm.MemDB
+	db dbm.DB
 }
 
 func (ps *paramStore) Set(_ sdk.Context, value *cmtproto.ConsensusParams) {
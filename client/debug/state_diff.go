@@ -0,0 +1,176 @@
+package debug
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagFrom         = "from"
+	flagTo           = "to"
+	flagStore        = "store"
+	flagAppDBBackend = "app-db-backend"
+)
+
+// CollectionsSchemasProvider is implemented by the applications which expose the
+// collections schemas of their modules, by store name. The state diff uses them
+// to decode the keys and values of the stores.
+type CollectionsSchemasProvider interface {
+	CollectionsSchemas() (map[string]collections.Schema, error)
+}
+
+// StateDiffCmd returns the command comparing the application state at two committed heights.
+func StateDiffCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "Show the keys which differ between two committed heights of the application state",
+		Long: fmt.Sprintf(`Show the keys which were added (+), removed (-) or changed (~) in the IAVL stores
+of the application between two committed heights, to pinpoint the source of an app hash mismatch.
+Stores with the same hash at both the heights are skipped, the keys and values of the stores whose
+modules expose their collections schema are decoded, the other ones are shown in hex.
+The node must not be running, and both the heights must not have been pruned.
+
+Example:
+$ %s debug state-diff --from 100 --to 101 --store bank --home ~/.simapp
+`, version.AppName),
+		Args: cobra.NoArgs,
+		// The command only reads its own flags, the application config must not be bound
+		// to them as its store section would shadow the store flag.
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			from, to := vp.GetInt64(flagFrom), vp.GetInt64(flagTo)
+			if from <= 0 || to <= 0 {
+				return fmt.Errorf("both --%s and --%s must be positive heights", flagFrom, flagTo)
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the state is only read, nothing must be pruned while loading the application.
+			vp.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+			app := appCreator(log.NewNopLogger(), db, nil, vp)
+			rootMultiStore, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the state diff of rootmulti.Store type")
+			}
+
+			var schemas map[string]collections.Schema
+			if provider, ok := app.(CollectionsSchemasProvider); ok {
+				if schemas, err = provider.CollectionsSchemas(); err != nil {
+					return err
+				}
+			}
+
+			return writeStateDiff(cmd.OutOrStdout(), rootMultiStore, from, to, vp.GetStringSlice(flagStore), schemas)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "The application home directory")
+	cmd.Flags().String(flagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().Int64(flagFrom, 0, "The height to compare from")
+	cmd.Flags().Int64(flagTo, 0, "The height to compare to")
+	cmd.Flags().StringSlice(flagStore, nil, "The stores to compare, all the IAVL stores if empty")
+
+	return cmd
+}
+
+// writeStateDiff writes the diff of the stores of the root multi store between the
+// from and to versions, one store header followed by one line per differing key.
+func writeStateDiff(
+	w io.Writer, store *rootmulti.Store, from, to int64, storeNames []string, schemas map[string]collections.Schema,
+) error {
+	var lastStore string
+	stores, keys := 0, 0
+	err := store.DiffVersions(from, to, storeNames, func(storeName string, diff rootmulti.KVDiff) error {
+		if storeName != lastStore {
+			lastStore = storeName
+			stores++
+			if _, err := fmt.Fprintf(w, "store %s\n", storeName); err != nil {
+				return err
+			}
+		}
+		keys++
+
+		schema, ok := schemas[storeName]
+		_, err := fmt.Fprintf(w, "  %s\n", formatKVDiff(diff, schema, ok))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%d keys differ in %d stores between heights %d and %d\n", keys, stores, from, to)
+	return err
+}
+
+// formatKVDiff formats the diff of a key, decoding it with the schema if there is one
+// which owns the key, it falls back to hex otherwise.
+func formatKVDiff(diff rootmulti.KVDiff, schema collections.Schema, hasSchema bool) string {
+	if hasSchema {
+		if s, ok := formatDecodedKVDiff(diff, schema); ok {
+			return s
+		}
+	}
+
+	switch diff.Type {
+	case rootmulti.KVDiffAdded:
+		return fmt.Sprintf("+ %X: %X", diff.Key, diff.NewValue)
+	case rootmulti.KVDiffRemoved:
+		return fmt.Sprintf("- %X: %X", diff.Key, diff.OldValue)
+	default:
+		return fmt.Sprintf("~ %X: %X -> %X", diff.Key, diff.OldValue, diff.NewValue)
+	}
+}
+
+func formatDecodedKVDiff(diff rootmulti.KVDiff, schema collections.Schema) (string, bool) {
+	switch diff.Type {
+	case rootmulti.KVDiffAdded:
+		entry, err := schema.DecodeRaw(diff.Key, diff.NewValue)
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("+ %s %s: %s", entry.Collection, entry.Key, entry.Value), true
+
+	case rootmulti.KVDiffRemoved:
+		entry, err := schema.DecodeRaw(diff.Key, diff.OldValue)
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("- %s %s: %s", entry.Collection, entry.Key, entry.Value), true
+
+	default:
+		oldEntry, err := schema.DecodeRaw(diff.Key, diff.OldValue)
+		if err != nil {
+			return "", false
+		}
+		newEntry, err := schema.DecodeRaw(diff.Key, diff.NewValue)
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("~ %s %s: %s -> %s", oldEntry.Collection, oldEntry.Key, oldEntry.Value, newEntry.Value), true
+	}
+}
@@ -0,0 +1,67 @@
+package debug
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWriteStateDiff(t *testing.T) {
+	bankKey := storetypes.NewKVStoreKey("bank")
+	rawKey := storetypes.NewKVStoreKey("raw")
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(rawKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(bankKey))
+	balances := collections.NewMap(sb, collections.NewPrefix(0), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	ctx := sdk.NewContext(ms, cmtproto.Header{}, false, log.NewNopLogger())
+	require.NoError(t, balances.Set(ctx, "alice", 10))
+	require.NoError(t, balances.Set(ctx, "bob", 20))
+	ms.GetKVStore(rawKey).Set([]byte{0x01}, []byte{0xaa})
+	from := ms.Commit().Version
+
+	require.NoError(t, balances.Set(ctx, "alice", 15))
+	require.NoError(t, balances.Remove(ctx, "bob"))
+	require.NoError(t, balances.Set(ctx, "carol", 5))
+	// a key which is not owned by any collection is shown in hex
+	ms.GetKVStore(bankKey).Set([]byte{0xff}, []byte{0x01})
+	ms.GetKVStore(rawKey).Set([]byte{0x01}, []byte{0xbb})
+	to := ms.Commit().Version
+
+	out := &bytes.Buffer{}
+	err = writeStateDiff(out, ms, from, to, nil, map[string]collections.Schema{"bank": schema})
+	require.NoError(t, err)
+	require.Equal(t, `store bank
+  ~ balances "alice": "10" -> "15"
+  - balances "bob": "20"
+  + balances "carol": "5"
+  + FF: 01
+store raw
+  ~ 01: AA -> BB
+5 keys differ in 2 stores between heights 1 and 2
+`, out.String())
+
+	out.Reset()
+	err = writeStateDiff(out, ms, from, to, []string{"raw"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, `store raw
+  ~ 01: AA -> BB
+1 keys differ in 1 stores between heights 1 and 2
+`, out.String())
+}
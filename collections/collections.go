@@ -83,6 +83,20 @@ type collection interface {
 	// getPrefix is the unique prefix of the collection within a schema.
 	getPrefix() []byte
 
+	// keyType returns the identifier of the key type of the collection.
+	keyType() string
+
+	// valueType returns the identifier of the value type of the collection.
+	valueType() string
+
+	// isSecondaryIndexCollection reports whether the collection is a secondary
+	// index of another collection, secondary indexes do not take part in genesis.
+	isSecondaryIndexCollection() bool
+
+	// decodeRaw decodes the raw key, stripped of the collection prefix, and value
+	// into their JSON and string representations.
+	decodeRaw(key, value []byte) (DecodedEntry, error)
+
 	genesisHandler
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"cosmossdk.io/collections/codec"
 )
@@ -29,6 +30,54 @@ type Index[PrimaryKey, Value any] interface {
 	Unreference(ctx context.Context, pk PrimaryKey, value Value) error
 }
 
+// VerifiableIndex is an Index which can verify the consistency of its entries
+// against the primary entries of the collection it indexes.
+type VerifiableIndex[PrimaryKey, Value any] interface {
+	Index[PrimaryKey, Value]
+	// Verify reports the missing and dangling entries of the index. walkPrimary
+	// calls the provided function for every primary entry of the indexed collection,
+	// stopping at the first error.
+	Verify(
+		ctx context.Context,
+		walkPrimary func(onEntry func(pk PrimaryKey, value Value) error) error,
+	) ([]IndexInconsistency, error)
+}
+
+// IndexInconsistencyKind defines the kind of an IndexInconsistency.
+type IndexInconsistencyKind uint8
+
+const (
+	// IndexEntryMissing reports an index entry which should exist for a primary entry but does not.
+	IndexEntryMissing IndexInconsistencyKind = iota
+	// IndexEntryDangling reports an index entry which does not match any primary entry.
+	IndexEntryDangling
+)
+
+func (k IndexInconsistencyKind) String() string {
+	switch k {
+	case IndexEntryMissing:
+		return "missing"
+	case IndexEntryDangling:
+		return "dangling"
+	default:
+		return fmt.Sprintf("IndexInconsistencyKind(%d)", uint8(k))
+	}
+}
+
+// IndexInconsistency describes an inconsistent entry of an index.
+type IndexInconsistency struct {
+	// Index is the name of the index collection.
+	Index string
+	// Key is the human-readable representation of the index key.
+	Key string
+	// Kind is the kind of the inconsistency.
+	Kind IndexInconsistencyKind
+}
+
+func (i IndexInconsistency) String() string {
+	return fmt.Sprintf("%s index entry %s in %s", i.Kind, i.Key, i.Index)
+}
+
 // IndexedMap works like a Map but creates references between fields of Value and its PrimaryKey.
 // These relationships are expressed and maintained using the Indexes type.
 // Internally IndexedMap can be seen as a partitioned collection, one partition
@@ -52,10 +101,12 @@ func NewIndexedMap[PrimaryKey, Value any, Idx Indexes[PrimaryKey, Value]](
 	valueCodec codec.ValueCodec[Value],
 	indexes Idx,
 ) *IndexedMap[PrimaryKey, Value, Idx] {
-	return &IndexedMap[PrimaryKey, Value, Idx]{
+	im := &IndexedMap[PrimaryKey, Value, Idx]{
 		Indexes: indexes,
-		m:       NewMap(schema, prefix, name, pkCodec, valueCodec),
+		m:       newMap(schema, prefix, name, pkCodec, valueCodec),
 	}
+	schema.addCollection(im)
+	return im
 }
 
 // Get gets the object given its primary key.
@@ -116,6 +167,58 @@ func (m *IndexedMap[PrimaryKey, Value, Idx]) Remove(ctx context.Context, pk Prim
 	return m.m.Remove(ctx, pk)
 }
 
+// Clear removes all the values contained within the provided primary key range,
+// a nil ranger equals to clearing the whole IndexedMap. The references of every
+// removed value are also removed from the indexes.
+// Like Map.Clear, values are read in batches and the iterator is closed before
+// removing them, so the store is never mutated while being iterated.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) Clear(ctx context.Context, ranger Ranger[PrimaryKey]) error {
+	for {
+		kvs, err := m.readBatch(ctx, ranger)
+		if err != nil {
+			return err
+		}
+
+		for _, kv := range kvs {
+			err = m.unref(ctx, kv.Key, kv.Value)
+			if err != nil {
+				return err
+			}
+			err = m.m.Remove(ctx, kv.Key)
+			if err != nil {
+				return err
+			}
+		}
+
+		// if we've retrieved less than the batch size, we're done.
+		if len(kvs) < clearBatchSize {
+			return nil
+		}
+	}
+}
+
+// readBatch reads at most clearBatchSize key-value pairs contained within the provided range.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) readBatch(ctx context.Context, ranger Ranger[PrimaryKey]) ([]KeyValue[PrimaryKey, Value], error) {
+	iter, err := m.m.Iterate(ctx, ranger)
+	switch {
+	case errors.Is(err, ErrInvalidIterator):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	defer iter.Close()
+
+	kvs := make([]KeyValue[PrimaryKey, Value], 0, clearBatchSize)
+	for ; iter.Valid() && len(kvs) < clearBatchSize; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
 // Walk applies the same semantics as Map.Walk.
 func (m *IndexedMap[PrimaryKey, Value, Idx]) Walk(ctx context.Context, ranger Ranger[PrimaryKey], walkFunc func(key PrimaryKey, value Value) bool) error {
 	return m.m.Walk(ctx, ranger, walkFunc)
@@ -134,6 +237,54 @@ func (m *IndexedMap[PrimaryKey, Value, Idx]) ValueCodec() codec.ValueCodec[Value
 	return m.m.ValueCodec()
 }
 
+// VerifyIndexes walks over all the primary entries of the IndexedMap and reports the
+// inconsistencies found between them and the indexes, which are either index entries
+// which are missing for an existing primary entry, or dangling index entries which
+// do not match any primary entry. The check is read-only, which makes it suitable to be
+// used in invariants and upgrade handlers.
+// Every index must implement VerifiableIndex, otherwise an error is returned.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) VerifyIndexes(ctx context.Context) ([]IndexInconsistency, error) {
+	var inconsistencies []IndexInconsistency
+	for _, index := range m.Indexes.IndexesList() {
+		verifiable, ok := index.(VerifiableIndex[PrimaryKey, Value])
+		if !ok {
+			return nil, fmt.Errorf("collections: index %T of %s does not support verification", index, m.m.name)
+		}
+		indexInconsistencies, err := verifiable.Verify(ctx, func(onEntry func(pk PrimaryKey, value Value) error) error {
+			return m.walkPrimary(ctx, onEntry)
+		})
+		if err != nil {
+			return nil, err
+		}
+		inconsistencies = append(inconsistencies, indexInconsistencies...)
+	}
+	return inconsistencies, nil
+}
+
+// walkPrimary calls onEntry for every primary entry of the IndexedMap, stopping at the first error.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) walkPrimary(ctx context.Context, onEntry func(pk PrimaryKey, value Value) error) error {
+	iter, err := m.m.Iterate(ctx, nil)
+	switch {
+	case errors.Is(err, ErrInvalidIterator):
+		return nil
+	case err != nil:
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		err = onEntry(kv.Key, kv.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *IndexedMap[PrimaryKey, Value, Idx]) ref(ctx context.Context, pk PrimaryKey, value Value, oldValue *Value) error {
 	for _, index := range m.Indexes.IndexesList() {
 		err := index.Reference(ctx, pk, value, oldValue)
@@ -153,3 +304,39 @@ func (m *IndexedMap[PrimaryKey, Value, Idx]) unref(ctx context.Context, pk Prima
 	}
 	return nil
 }
+
+// collection and genesis implementation, the IndexedMap is registered in the schema in place
+// of its primary Map. Only the primary entries take part in genesis: the indexes are
+// excluded from it and are rebuilt while importing the primary entries.
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) getName() string { return m.m.getName() }
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) getPrefix() []byte { return m.m.getPrefix() }
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) keyType() string { return m.m.keyType() }
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) valueType() string { return m.m.valueType() }
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) isSecondaryIndexCollection() bool { return false }
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) decodeRaw(key, value []byte) (DecodedEntry, error) {
+	return m.m.decodeRaw(key, value)
+}
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) validateGenesis(reader io.Reader) error {
+	return m.m.validateGenesis(reader)
+}
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) importGenesis(ctx context.Context, reader io.Reader) error {
+	return m.m.doDecodeJSON(reader, func(pk PrimaryKey, value Value) error {
+		return m.Set(ctx, pk, value)
+	})
+}
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) exportGenesis(ctx context.Context, writer io.Writer) error {
+	return m.m.exportGenesis(ctx, writer)
+}
+
+func (m *IndexedMap[PrimaryKey, Value, Idx]) defaultGenesis(writer io.Writer) error {
+	return m.m.defaultGenesis(writer)
+}
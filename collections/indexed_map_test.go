@@ -1,6 +1,8 @@
 package collections_test
 
 import (
+	"io"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/collections"
@@ -102,3 +104,128 @@ func TestIndexedMap(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, company{"milan", 4}, v)
 }
+
+func TestIndexedMap_Clear(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schema := collections.NewSchemaBuilder(sk)
+
+	im := newTestIndexedMap(schema)
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan", Vat: 0}))
+	require.NoError(t, im.Set(ctx, "2", company{City: "milan", Vat: 1}))
+	require.NoError(t, im.Set(ctx, "3", company{City: "rome", Vat: 2}))
+
+	// clear only "1" and "2"
+	require.NoError(t, im.Clear(ctx, new(collections.Range[string]).EndInclusive("2")))
+
+	iter, err := im.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"3"}, keys)
+
+	// references of the cleared values were removed
+	_, err = im.Indexes.Vat.Get(ctx, 0)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = im.Indexes.Vat.Get(ctx, 1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	has, err := im.Indexes.City.Has(ctx, "milan", "1")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// clear everything
+	require.NoError(t, im.Clear(ctx, nil))
+	_, err = im.Indexes.Vat.Get(ctx, 2)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = im.Iterate(ctx, nil)
+	require.ErrorIs(t, err, collections.ErrInvalidIterator)
+
+	// clearing an empty indexed map is a no-op
+	require.NoError(t, im.Clear(ctx, nil))
+}
+
+func TestIndexedMap_Genesis(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	im := newTestIndexedMap(schemaBuilder)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan", Vat: 0}))
+	require.NoError(t, im.Set(ctx, "2", company{City: "rome", Vat: 1}))
+
+	// only the primary entries are exported
+	exported := map[string]*strings.Builder{}
+	err = schema.ExportGenesis(ctx, func(field string) (io.WriteCloser, error) {
+		b := new(strings.Builder)
+		exported[field] = b
+		return nopWriteCloser{b}, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	require.Contains(t, exported, "companies")
+
+	// the index collections are still part of the schema
+	require.Len(t, schema.ListCollections(), 3)
+
+	// importing rebuilds the indexes
+	sk, ctx = colltest.MockStore()
+	schemaBuilder = collections.NewSchemaBuilder(sk)
+	im = newTestIndexedMap(schemaBuilder)
+	schema, err = schemaBuilder.Build()
+	require.NoError(t, err)
+
+	err = schema.InitGenesis(ctx, func(field string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(exported[field].String())), nil
+	})
+	require.NoError(t, err)
+
+	v, err := im.Get(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, company{City: "rome", Vat: 1}, v)
+
+	pk, err := im.Indexes.Vat.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "2", pk)
+
+	has, err := im.Indexes.City.Has(ctx, "milan", "1")
+	require.NoError(t, err)
+	require.True(t, has)
+
+	inconsistencies, err := im.VerifyIndexes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, inconsistencies)
+}
+
+func TestIndexedMap_VerifyIndexes(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schema := collections.NewSchemaBuilder(sk)
+	im := newTestIndexedMap(schema)
+
+	// empty indexed map
+	inconsistencies, err := im.VerifyIndexes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, inconsistencies)
+
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan", Vat: 0}))
+	require.NoError(t, im.Set(ctx, "2", company{City: "rome", Vat: 1}))
+
+	inconsistencies, err = im.VerifyIndexes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, inconsistencies)
+
+	// corrupt the indexes: remove the references of "1" and add references to a non-existing "3"
+	require.NoError(t, im.Indexes.City.Unreference(ctx, "1", company{City: "milan", Vat: 0}))
+	require.NoError(t, im.Indexes.Vat.Unreference(ctx, "1", company{City: "milan", Vat: 0}))
+	require.NoError(t, im.Indexes.City.Reference(ctx, "3", company{City: "turin", Vat: 3}, nil))
+	require.NoError(t, im.Indexes.Vat.Reference(ctx, "3", company{City: "turin", Vat: 3}, nil))
+
+	inconsistencies, err = im.VerifyIndexes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []collections.IndexInconsistency{
+		{Index: "companies_by_city", Key: `("milan", "1")`, Kind: collections.IndexEntryMissing},
+		{Index: "companies_by_city", Key: `("turin", "3")`, Kind: collections.IndexEntryDangling},
+		{Index: "companies_by_vat", Key: "0", Kind: collections.IndexEntryMissing},
+		{Index: "companies_by_vat", Key: "3", Kind: collections.IndexEntryDangling},
+	}, inconsistencies)
+	require.Equal(t, `missing index entry ("milan", "1") in companies_by_city`, inconsistencies[0].String())
+}
@@ -48,6 +48,14 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Context
 	return (*collections.GenericMultiIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(m).Unreference(ctx, pk, value)
 }
 
+// Verify implements collections.VerifiableIndex
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) Verify(
+	ctx context.Context,
+	walkPrimary func(onEntry func(pk PrimaryKey, value Value) error) error,
+) ([]collections.IndexInconsistency, error) {
+	return (*collections.GenericMultiIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(m).Verify(ctx, walkPrimary)
+}
+
 func (m *Multi[ReferenceKey, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]]) (MultiIterator[ReferenceKey, PrimaryKey], error) {
 	iter, err := (*collections.GenericMultiIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(m).Iterate(ctx, ranger)
 	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), err
@@ -71,6 +71,14 @@ func (i *MultiPair[K1, K2, Value]) Unreference(ctx context.Context, pk collectio
 	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).Unreference(ctx, pk, value)
 }
 
+// Verify implements collections.VerifiableIndex
+func (i *MultiPair[K1, K2, Value]) Verify(
+	ctx context.Context,
+	walkPrimary func(onEntry func(pk collections.Pair[K1, K2], value Value) error) error,
+) ([]collections.IndexInconsistency, error) {
+	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).Verify(ctx, walkPrimary)
+}
+
 func (i *MultiPair[K1, K2, Value]) Walk(
 	ctx context.Context,
 	ranger collections.Ranger[collections.Pair[K2, K1]],
@@ -0,0 +1,145 @@
+package indexes
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// MultiTriple is an index that is used with collections.Triple keys. It indexes objects by the second part of the key.
+// When the value is being indexed by collections.IndexedMap then MultiTriple will create a relationship between
+// the second part of the primary key and the first and third parts.
+type MultiTriple[K1, K2, K3, Value any] collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value]
+
+// tripleKeyCodec is an interface to cast a collections.KeyCodec
+// to a triple codec, see pairKeyCodec.
+type tripleKeyCodec[K1, K2, K3 any] interface {
+	KeyCodec1() codec.KeyCodec[K1]
+	KeyCodec2() codec.KeyCodec[K2]
+	KeyCodec3() codec.KeyCodec[K3]
+}
+
+// NewMultiTriple instantiates a new MultiTriple index.
+// NOTE: when using this function you will need to type hint: doing NewMultiTriple[Value]()
+// Example: if the value of the indexed map is string, you need to do NewMultiTriple[string](...)
+func NewMultiTriple[Value any, K1, K2, K3 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	tripleCodec codec.KeyCodec[collections.Triple[K1, K2, K3]],
+) *MultiTriple[K1, K2, K3, Value] {
+	tkc := tripleCodec.(tripleKeyCodec[K1, K2, K3])
+	mi := collections.NewGenericMultiIndex(
+		sb,
+		prefix,
+		name,
+		tkc.KeyCodec2(),
+		collections.PairKeyCodec(tkc.KeyCodec1(), tkc.KeyCodec3()),
+		func(pk collections.Triple[K1, K2, K3], _ Value) ([]collections.IndexReference[K2, collections.Pair[K1, K3]], error) {
+			return []collections.IndexReference[K2, collections.Pair[K1, K3]]{
+				collections.NewIndexReference(pk.K2(), collections.Join(pk.K1(), pk.K3())),
+			}, nil
+		},
+	)
+
+	return (*MultiTriple[K1, K2, K3, Value])(mi)
+}
+
+// Iterate exposes the raw iterator API.
+func (i *MultiTriple[K1, K2, K3, Value]) Iterate(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Pair[K2, collections.Pair[K1, K3]]],
+) (iter MultiTripleIterator[K1, K2, K3], err error) {
+	sIter, err := (*collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value])(i).Iterate(ctx, ranger)
+	if err != nil {
+		return iter, err
+	}
+	return (MultiTripleIterator[K1, K2, K3])(sIter), nil
+}
+
+// MatchExact will return an iterator containing only the primary keys whose second part is equal to the provided key.
+func (i *MultiTriple[K1, K2, K3, Value]) MatchExact(ctx context.Context, key K2) (MultiTripleIterator[K1, K2, K3], error) {
+	return i.Iterate(ctx, collections.NewPrefixedPairRange[K2, collections.Pair[K1, K3]](key))
+}
+
+// Reference implements collections.Index
+func (i *MultiTriple[K1, K2, K3, Value]) Reference(ctx context.Context, pk collections.Triple[K1, K2, K3], value Value, oldValue *Value) error {
+	return (*collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value])(i).Reference(ctx, pk, value, oldValue)
+}
+
+// Unreference implements collections.Index
+func (i *MultiTriple[K1, K2, K3, Value]) Unreference(ctx context.Context, pk collections.Triple[K1, K2, K3], value Value) error {
+	return (*collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value])(i).Unreference(ctx, pk, value)
+}
+
+// Verify implements collections.VerifiableIndex
+func (i *MultiTriple[K1, K2, K3, Value]) Verify(
+	ctx context.Context,
+	walkPrimary func(onEntry func(pk collections.Triple[K1, K2, K3], value Value) error) error,
+) ([]collections.IndexInconsistency, error) {
+	return (*collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value])(i).Verify(ctx, walkPrimary)
+}
+
+func (i *MultiTriple[K1, K2, K3, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Pair[K2, collections.Pair[K1, K3]]],
+	walkFunc func(indexingKey K2, indexedKey collections.Pair[K1, K3]) bool,
+) error {
+	return (*collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value])(i).Walk(ctx, ranger, walkFunc)
+}
+
+func (i *MultiTriple[K1, K2, K3, Value]) IterateRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (
+	iter collections.Iterator[collections.Pair[K2, collections.Pair[K1, K3]], collections.NoValue], err error,
+) {
+	return (*collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value])(i).IterateRaw(ctx, start, end, order)
+}
+
+func (i *MultiTriple[K1, K2, K3, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K2, collections.Pair[K1, K3]]] {
+	return (*collections.GenericMultiIndex[K2, collections.Pair[K1, K3], collections.Triple[K1, K2, K3], Value])(i).KeyCodec()
+}
+
+// MultiTripleIterator is a helper type around a collections.KeySetIterator when used to work
+// with MultiTriple indexes iterations.
+type MultiTripleIterator[K1, K2, K3 any] collections.KeySetIterator[collections.Pair[K2, collections.Pair[K1, K3]]]
+
+// PrimaryKey returns the primary key from the index. The index is composed of the second part
+// of the triple key, followed by the first and third parts. So we rebuild the triple from it.
+func (m MultiTripleIterator[K1, K2, K3]) PrimaryKey() (triple collections.Triple[K1, K2, K3], err error) {
+	fullKey, err := m.FullKey()
+	if err != nil {
+		return triple, err
+	}
+	return collections.Join3(fullKey.K2().K1(), fullKey.K1(), fullKey.K2().K2()), nil
+}
+
+// PrimaryKeys returns all the primary keys contained in the iterator.
+func (m MultiTripleIterator[K1, K2, K3]) PrimaryKeys() (triples []collections.Triple[K1, K2, K3], err error) {
+	defer m.Close()
+	for ; m.Valid(); m.Next() {
+		triple, err := m.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		triples = append(triples, triple)
+	}
+	return triples, err
+}
+
+func (m MultiTripleIterator[K1, K2, K3]) FullKey() (p collections.Pair[K2, collections.Pair[K1, K3]], err error) {
+	return (collections.KeySetIterator[collections.Pair[K2, collections.Pair[K1, K3]]])(m).Key()
+}
+
+func (m MultiTripleIterator[K1, K2, K3]) Next() {
+	(collections.KeySetIterator[collections.Pair[K2, collections.Pair[K1, K3]]])(m).Next()
+}
+
+func (m MultiTripleIterator[K1, K2, K3]) Valid() bool {
+	return (collections.KeySetIterator[collections.Pair[K2, collections.Pair[K1, K3]]])(m).Valid()
+}
+
+func (m MultiTripleIterator[K1, K2, K3]) Close() error {
+	return (collections.KeySetIterator[collections.Pair[K2, collections.Pair[K1, K3]]])(m).Close()
+}
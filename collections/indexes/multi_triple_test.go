@@ -0,0 +1,59 @@
+package indexes
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+)
+
+type LockID = uint64
+
+// lockedBalanceIndex indexes locked balances, saved as collections.Triple[Address, Denom, LockID],
+// by their Denom.
+type lockedBalanceIndex struct {
+	Denom *MultiTriple[Address, Denom, LockID, Amount]
+}
+
+func (b lockedBalanceIndex) IndexesList() []collections.Index[collections.Triple[Address, Denom, LockID], Amount] {
+	return []collections.Index[collections.Triple[Address, Denom, LockID], Amount]{b.Denom}
+}
+
+func TestMultiTriple(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("locked_balances"), "locked_balances",
+		keyCodec,
+		collections.Uint64Value,
+		lockedBalanceIndex{
+			Denom: NewMultiTriple[Amount](sb, collections.NewPrefix("denom_index"), "denom_index", keyCodec),
+		},
+	)
+
+	require.NoError(t, indexedMap.Set(ctx, collections.Join3("address1", "atom", LockID(1)), 100))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join3("address1", "osmo", LockID(2)), 200))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join3("address2", "osmo", LockID(3)), 300))
+
+	// assert if we iterate over osmo we find address1 and address2
+	iter, err := indexedMap.Indexes.Denom.MatchExact(ctx, "osmo")
+	require.NoError(t, err)
+
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[Address, Denom, LockID]{
+		collections.Join3("address1", "osmo", LockID(2)),
+		collections.Join3("address2", "osmo", LockID(3)),
+	}, pks)
+
+	// assert the index is cleared on removal
+	require.NoError(t, indexedMap.Remove(ctx, collections.Join3("address1", "osmo", LockID(2))))
+	iter, err = indexedMap.Indexes.Denom.MatchExact(ctx, "osmo")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[Address, Denom, LockID]{collections.Join3("address2", "osmo", LockID(3))}, pks)
+}
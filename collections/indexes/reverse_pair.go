@@ -0,0 +1,305 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// ReversePair is an index that is used with collections.Pair keys. It indexes objects by their second part of the key.
+// When the value is being indexed by collections.IndexedMap then ReversePair will create a relationship between
+// the second part of the primary key and the first part.
+// Unlike MultiPair, the references depend only on the primary key, so updating the value associated with an
+// existing primary key does not alter the index.
+type ReversePair[K1, K2, Value any] struct {
+	name    string
+	refKeys collections.KeySet[collections.Pair[K2, K1]] // refKeys has the relationships between Join(K2, K1)
+}
+
+// NewReversePair instantiates a new ReversePair index.
+// NOTE: when using this function you will need to type hint: doing NewReversePair[Value]()
+// Example: if the value of the indexed map is string, you need to do NewReversePair[string](...)
+func NewReversePair[Value any, K1, K2 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	pairCodec codec.KeyCodec[collections.Pair[K1, K2]],
+) *ReversePair[K1, K2, Value] {
+	return newReversePair[Value](sb, prefix, name, pairCodec, true)
+}
+
+// newReversePair instantiates a new ReversePair index, secondaryIndex reports whether the
+// index is excluded from genesis, which is the case when it's rebuilt by an IndexedMap.
+func newReversePair[Value any, K1, K2 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	pairCodec codec.KeyCodec[collections.Pair[K1, K2]],
+	secondaryIndex bool,
+) *ReversePair[K1, K2, Value] {
+	pkc := pairCodec.(pairKeyCodec[K1, K2])
+	refKeysCodec := collections.PairKeyCodec(pkc.KeyCodec2(), pkc.KeyCodec1())
+	var refKeys collections.KeySet[collections.Pair[K2, K1]]
+	if secondaryIndex {
+		refKeys = collections.NewKeySet(sb, prefix, name, refKeysCodec, collections.WithKeySetSecondaryIndex())
+	} else {
+		refKeys = collections.NewKeySet(sb, prefix, name, refKeysCodec)
+	}
+	return &ReversePair[K1, K2, Value]{
+		name:    name,
+		refKeys: refKeys,
+	}
+}
+
+// Iterate exposes the raw iterator API.
+func (i *ReversePair[K1, K2, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[K2, K1]]) (iter ReversePairIterator[K2, K1], err error) {
+	sIter, err := i.refKeys.Iterate(ctx, ranger)
+	if err != nil {
+		return
+	}
+	return (ReversePairIterator[K2, K1])(sIter), nil
+}
+
+// MatchExact will return an iterator containing only the primary keys starting with the provided second part of the multipart pair key.
+func (i *ReversePair[K1, K2, Value]) MatchExact(ctx context.Context, key K2) (ReversePairIterator[K2, K1], error) {
+	return i.Iterate(ctx, collections.NewPrefixedPairRange[K2, K1](key))
+}
+
+// Has reports if the index contains the reversed primary key.
+func (i *ReversePair[K1, K2, Value]) Has(ctx context.Context, pk collections.Pair[K1, K2]) (bool, error) {
+	return i.refKeys.Has(ctx, collections.Join(pk.K2(), pk.K1()))
+}
+
+// Reference implements collections.Index. The value is not needed to build the references,
+// hence updates of the value associated with an already referenced primary key are a no-op.
+func (i *ReversePair[K1, K2, Value]) Reference(ctx context.Context, pk collections.Pair[K1, K2], _ Value, oldValue *Value) error {
+	if oldValue != nil {
+		return nil
+	}
+	return i.refKeys.Set(ctx, collections.Join(pk.K2(), pk.K1()))
+}
+
+// Unreference implements collections.Index
+func (i *ReversePair[K1, K2, Value]) Unreference(ctx context.Context, pk collections.Pair[K1, K2], _ Value) error {
+	return i.refKeys.Remove(ctx, collections.Join(pk.K2(), pk.K1()))
+}
+
+func (i *ReversePair[K1, K2, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Pair[K2, K1]],
+	walkFunc func(indexingKey K2, indexedKey K1) bool,
+) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Pair[K2, K1]) bool {
+		return walkFunc(key.K1(), key.K2())
+	})
+}
+
+func (i *ReversePair[K1, K2, Value]) IterateRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (
+	iter collections.Iterator[collections.Pair[K2, K1], collections.NoValue], err error,
+) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+func (i *ReversePair[K1, K2, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K2, K1]] {
+	return i.refKeys.KeyCodec()
+}
+
+// ReversePairIterator is a helper type around a collections.KeySetIterator when used to work
+// with ReversePair indexes iterations.
+type ReversePairIterator[K2, K1 any] collections.KeySetIterator[collections.Pair[K2, K1]]
+
+// PrimaryKey returns the primary key from the index. The index is composed like a reverse
+// pair key. So we just fetch the pair key from the index and return the reverse.
+func (m ReversePairIterator[K2, K1]) PrimaryKey() (pair collections.Pair[K1, K2], err error) {
+	reversePair, err := m.FullKey()
+	if err != nil {
+		return pair, err
+	}
+	pair = collections.Join(reversePair.K2(), reversePair.K1())
+	return pair, nil
+}
+
+// PrimaryKeys returns all the primary keys contained in the iterator.
+func (m ReversePairIterator[K2, K1]) PrimaryKeys() (pairs []collections.Pair[K1, K2], err error) {
+	defer m.Close()
+	for ; m.Valid(); m.Next() {
+		pair, err := m.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, err
+}
+
+func (m ReversePairIterator[K2, K1]) FullKey() (p collections.Pair[K2, K1], err error) {
+	return (collections.KeySetIterator[collections.Pair[K2, K1]])(m).Key()
+}
+
+func (m ReversePairIterator[K2, K1]) Next() {
+	(collections.KeySetIterator[collections.Pair[K2, K1]])(m).Next()
+}
+
+func (m ReversePairIterator[K2, K1]) Valid() bool {
+	return (collections.KeySetIterator[collections.Pair[K2, K1]])(m).Valid()
+}
+
+func (m ReversePairIterator[K2, K1]) Close() error {
+	return (collections.KeySetIterator[collections.Pair[K2, K1]])(m).Close()
+}
+
+// Verify implements collections.VerifiableIndex
+func (i *ReversePair[K1, K2, Value]) Verify(
+	ctx context.Context,
+	walkPrimary func(onEntry func(pk collections.Pair[K1, K2], value Value) error) error,
+) ([]collections.IndexInconsistency, error) {
+	var inconsistencies []collections.IndexInconsistency
+	kc := i.refKeys.KeyCodec()
+	// expected keeps in memory the encoded references built from the primary entries,
+	// so that dangling entries can be detected.
+	expected := make(map[string]struct{})
+	err := walkPrimary(func(pk collections.Pair[K1, K2], _ Value) error {
+		key := collections.Join(pk.K2(), pk.K1())
+		bytesKey, err := encodeKey(kc, key)
+		if err != nil {
+			return err
+		}
+		expected[string(bytesKey)] = struct{}{}
+		has, err := i.refKeys.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !has {
+			inconsistencies = append(inconsistencies, i.inconsistency(key, collections.IndexEntryMissing))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := i.refKeys.Iterate(ctx, nil)
+	switch {
+	case errors.Is(err, collections.ErrInvalidIterator):
+		return inconsistencies, nil
+	case err != nil:
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		bytesKey, err := encodeKey(kc, key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := expected[string(bytesKey)]; !ok {
+			inconsistencies = append(inconsistencies, i.inconsistency(key, collections.IndexEntryDangling))
+		}
+	}
+	return inconsistencies, nil
+}
+
+func (i *ReversePair[K1, K2, Value]) inconsistency(
+	key collections.Pair[K2, K1],
+	kind collections.IndexInconsistencyKind,
+) collections.IndexInconsistency {
+	return collections.IndexInconsistency{
+		Index: i.name,
+		Key:   i.refKeys.KeyCodec().Stringify(key),
+		Kind:  kind,
+	}
+}
+
+// ReversePairKeySet is a collections.KeySet of collections.Pair keys which also maintains
+// a ReversePair index of its keys. It can be used outside collections.IndexedMap whenever
+// a set of relationships needs to be queried from both sides, for example:
+// all the validators of a delegator, and all the delegators of a validator.
+type ReversePairKeySet[K1, K2 any] struct {
+	keys    collections.KeySet[collections.Pair[K1, K2]]
+	reverse *ReversePair[K1, K2, collections.NoValue]
+}
+
+// NewReversePairKeySet instantiates a new ReversePairKeySet. It requires a Prefix and
+// a humanised name for the KeySet and a Prefix and humanised name for its reverse index.
+func NewReversePairKeySet[K1, K2 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	reversePrefix collections.Prefix,
+	reverseName string,
+	pairCodec codec.KeyCodec[collections.Pair[K1, K2]],
+) *ReversePairKeySet[K1, K2] {
+	return &ReversePairKeySet[K1, K2]{
+		keys:    collections.NewKeySet(sb, prefix, name, pairCodec),
+		reverse: newReversePair[collections.NoValue](sb, reversePrefix, reverseName, pairCodec, false),
+	}
+}
+
+// Set adds the key to the set and to the reverse index.
+func (s *ReversePairKeySet[K1, K2]) Set(ctx context.Context, key collections.Pair[K1, K2]) error {
+	err := s.keys.Set(ctx, key)
+	if err != nil {
+		return err
+	}
+	return s.reverse.Reference(ctx, key, collections.NoValue{}, nil)
+}
+
+// Has reports whether the key is present in the set.
+func (s *ReversePairKeySet[K1, K2]) Has(ctx context.Context, key collections.Pair[K1, K2]) (bool, error) {
+	return s.keys.Has(ctx, key)
+}
+
+// Remove removes the key from the set and from the reverse index.
+// If the key does not exist then this is a no-op.
+func (s *ReversePairKeySet[K1, K2]) Remove(ctx context.Context, key collections.Pair[K1, K2]) error {
+	err := s.keys.Remove(ctx, key)
+	if err != nil {
+		return err
+	}
+	return s.reverse.Unreference(ctx, key, collections.NoValue{})
+}
+
+// Iterate iterates over the keys of the set given the provided Ranger.
+func (s *ReversePairKeySet[K1, K2]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[K1, K2]]) (collections.KeySetIterator[collections.Pair[K1, K2]], error) {
+	return s.keys.Iterate(ctx, ranger)
+}
+
+// Walk walks over the keys of the set given the provided Ranger.
+func (s *ReversePairKeySet[K1, K2]) Walk(ctx context.Context, ranger collections.Ranger[collections.Pair[K1, K2]], walkFunc func(key collections.Pair[K1, K2]) bool) error {
+	return s.keys.Walk(ctx, ranger, walkFunc)
+}
+
+// MatchExact returns an iterator containing all the keys whose first part is equal to the provided key.
+func (s *ReversePairKeySet[K1, K2]) MatchExact(ctx context.Context, key K1) (collections.KeySetIterator[collections.Pair[K1, K2]], error) {
+	return s.keys.Iterate(ctx, collections.NewPrefixedPairRange[K1, K2](key))
+}
+
+// MatchExactReversed returns an iterator containing all the keys whose second part is equal to the provided key.
+func (s *ReversePairKeySet[K1, K2]) MatchExactReversed(ctx context.Context, key K2) (ReversePairIterator[K2, K1], error) {
+	return s.reverse.MatchExact(ctx, key)
+}
+
+// Reverse returns the ReversePair index of the set.
+func (s *ReversePairKeySet[K1, K2]) Reverse() *ReversePair[K1, K2, collections.NoValue] {
+	return s.reverse
+}
+
+// KeyCodec returns the KeySet's KeyCodec.
+func (s *ReversePairKeySet[K1, K2]) KeyCodec() codec.KeyCodec[collections.Pair[K1, K2]] {
+	return s.keys.KeyCodec()
+}
+
+// encodeKey encodes the key using the provided KeyCodec.
+func encodeKey[K any](kc codec.KeyCodec[K], key K) ([]byte, error) {
+	buffer := make([]byte, kc.Size(key))
+	_, err := kc.Encode(buffer, key)
+	return buffer, err
+}
@@ -0,0 +1,117 @@
+package indexes
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+)
+
+type reverseBalanceIndex struct {
+	Denom *ReversePair[Address, Denom, Amount]
+}
+
+func (b reverseBalanceIndex) IndexesList() []collections.Index[collections.Pair[Address, Denom], Amount] {
+	return []collections.Index[collections.Pair[Address, Denom], Amount]{b.Denom}
+}
+
+func TestReversePair(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("balances"), "balances",
+		keyCodec,
+		collections.Uint64Value,
+		reverseBalanceIndex{
+			Denom: NewReversePair[Amount](sb, collections.NewPrefix("denom_index"), "denom_index", keyCodec),
+		},
+	)
+
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address1", "atom"), 100))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address1", "osmo"), 200))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address2", "osmo"), 300))
+	// updating the value does not alter the index
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address2", "osmo"), 400))
+
+	// assert if we iterate over osmo we find address1 and address2
+	iter, err := indexedMap.Indexes.Denom.MatchExact(ctx, "osmo")
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[Address, Denom]{
+		collections.Join("address1", "osmo"),
+		collections.Join("address2", "osmo"),
+	}, pks)
+
+	// assert removal clears the reference
+	require.NoError(t, indexedMap.Remove(ctx, collections.Join("address1", "osmo")))
+	has, err := indexedMap.Indexes.Denom.Has(ctx, collections.Join("address1", "osmo"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// assert the index is consistent, then corrupt it
+	inconsistencies, err := indexedMap.VerifyIndexes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, inconsistencies)
+
+	require.NoError(t, indexedMap.Indexes.Denom.Unreference(ctx, collections.Join("address1", "atom"), 0))
+	require.NoError(t, indexedMap.Indexes.Denom.Reference(ctx, collections.Join("address3", "atom"), 0, nil))
+	inconsistencies, err = indexedMap.VerifyIndexes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []collections.IndexInconsistency{
+		{Index: "denom_index", Key: `("atom", "address1")`, Kind: collections.IndexEntryMissing},
+		{Index: "denom_index", Key: `("atom", "address3")`, Kind: collections.IndexEntryDangling},
+	}, inconsistencies)
+}
+
+func TestReversePairKeySet(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+
+	// delegations maps Pair[Delegator, Validator]
+	delegations := NewReversePairKeySet(
+		sb,
+		collections.NewPrefix("delegations"), "delegations",
+		collections.NewPrefix("delegations_by_validator"), "delegations_by_validator",
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+	)
+
+	require.NoError(t, delegations.Set(ctx, collections.Join("del1", "val1")))
+	require.NoError(t, delegations.Set(ctx, collections.Join("del1", "val2")))
+	require.NoError(t, delegations.Set(ctx, collections.Join("del2", "val1")))
+
+	// all validators of a delegator
+	iter, err := delegations.MatchExact(ctx, "del1")
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, string]{
+		collections.Join("del1", "val1"),
+		collections.Join("del1", "val2"),
+	}, keys)
+
+	// all delegators of a validator
+	revIter, err := delegations.MatchExactReversed(ctx, "val1")
+	require.NoError(t, err)
+	pks, err := revIter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, string]{
+		collections.Join("del1", "val1"),
+		collections.Join("del2", "val1"),
+	}, pks)
+
+	// removal is reflected in the reverse index
+	require.NoError(t, delegations.Remove(ctx, collections.Join("del2", "val1")))
+	has, err := delegations.Has(ctx, collections.Join("del2", "val1"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	revIter, err = delegations.MatchExactReversed(ctx, "val1")
+	require.NoError(t, err)
+	pks, err = revIter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[string, string]{collections.Join("del1", "val1")}, pks)
+}
@@ -42,6 +42,14 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Contex
 	return (*collections.GenericUniqueIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(i).Unreference(ctx, pk, value)
 }
 
+// Verify implements collections.VerifiableIndex
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) Verify(
+	ctx context.Context,
+	walkPrimary func(onEntry func(pk PrimaryKey, value Value) error) error,
+) ([]collections.IndexInconsistency, error) {
+	return (*collections.GenericUniqueIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(i).Verify(ctx, walkPrimary)
+}
+
 func (i *Unique[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, ref ReferenceKey) (PrimaryKey, error) {
 	return (*collections.GenericUniqueIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(i).Get(ctx, ref)
 }
@@ -2,6 +2,7 @@ package collections
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections/codec"
 )
@@ -60,7 +61,7 @@ func NewGenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value any](
 ) *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value] {
 	return &GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]{
 		getRefs: getRefsFunc,
-		refs:    NewKeySet(schema, prefix, name, PairKeyCodec(referencingKeyCodec, referencedKeyCodec)),
+		refs:    NewKeySet(schema, prefix, name, PairKeyCodec(referencingKeyCodec, referencedKeyCodec), WithKeySetSecondaryIndex()),
 	}
 }
 
@@ -155,3 +156,73 @@ func (i *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) Ke
 func (i *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) ValueCodec() codec.ValueCodec[NoValue] {
 	return i.refs.ValueCodec()
 }
+
+// Verify implements VerifiableIndex. The expected references are kept in memory
+// while walking the primary entries, so that dangling entries can be detected.
+func (i *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) Verify(
+	ctx context.Context,
+	walkPrimary func(onEntry func(pk PrimaryKey, value Value) error) error,
+) ([]IndexInconsistency, error) {
+	var inconsistencies []IndexInconsistency
+	expected := make(map[string]struct{})
+	err := walkPrimary(func(pk PrimaryKey, value Value) error {
+		refs, err := i.getRefs(pk, value)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			key := Join(ref.Referring, ref.Referred)
+			bytesKey, err := encodeKeyWithPrefix(nil, i.refs.kc, key)
+			if err != nil {
+				return err
+			}
+			expected[string(bytesKey)] = struct{}{}
+			has, err := i.refs.Has(ctx, key)
+			if err != nil {
+				return err
+			}
+			if !has {
+				inconsistencies = append(inconsistencies, i.inconsistency(key, IndexEntryMissing))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := i.refs.Iterate(ctx, nil)
+	switch {
+	case errors.Is(err, ErrInvalidIterator):
+		return inconsistencies, nil
+	case err != nil:
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		bytesKey, err := encodeKeyWithPrefix(nil, i.refs.kc, key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := expected[string(bytesKey)]; !ok {
+			inconsistencies = append(inconsistencies, i.inconsistency(key, IndexEntryDangling))
+		}
+	}
+	return inconsistencies, nil
+}
+
+func (i *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) inconsistency(
+	key Pair[ReferencingKey, ReferencedKey],
+	kind IndexInconsistencyKind,
+) IndexInconsistency {
+	return IndexInconsistency{
+		Index: i.refs.name,
+		Key:   i.refs.kc.Stringify(key),
+		Kind:  kind,
+	}
+}
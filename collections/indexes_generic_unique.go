@@ -1,7 +1,9 @@
 package collections
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections/codec"
@@ -34,8 +36,11 @@ func NewGenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value any]
 	referencedKeyCodec codec.KeyCodec[ReferencedKey],
 	getRefs func(pk PrimaryKey, value Value) ([]IndexReference[ReferencingKey, ReferencedKey], error),
 ) *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value] {
+	refs := newMap[ReferencingKey, ReferencedKey](schema, prefix, name, referencingKeyCodec, codec.KeyToValueCodec(referencedKeyCodec))
+	refs.isSecondaryIndex = true
+	schema.addCollection(refs)
 	return &GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]{
-		refs:    NewMap[ReferencingKey, ReferencedKey](schema, prefix, name, referencingKeyCodec, codec.KeyToValueCodec(referencedKeyCodec)),
+		refs:    refs,
 		getRefs: getRefs,
 	}
 }
@@ -120,3 +125,93 @@ func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) W
 ) error {
 	return i.refs.Walk(ctx, ranger, func(k ReferencingKey, v ReferencedKey) bool { return walkFunc(k, v) })
 }
+
+// Verify implements VerifiableIndex. A referencing key which maps a referenced key
+// different from the expected one is reported both as missing and as dangling.
+// The expected references are kept in memory while walking the primary entries,
+// so that dangling entries can be detected.
+func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) Verify(
+	ctx context.Context,
+	walkPrimary func(onEntry func(pk PrimaryKey, value Value) error) error,
+) ([]IndexInconsistency, error) {
+	var inconsistencies []IndexInconsistency
+	// expected maps the encoded referencing keys to the encoded referenced keys.
+	expected := make(map[string][]byte)
+	err := walkPrimary(func(pk PrimaryKey, value Value) error {
+		refs, err := i.getRefs(pk, value)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			bytesKey, err := encodeKeyWithPrefix(nil, i.refs.kc, ref.Referring)
+			if err != nil {
+				return err
+			}
+			bytesValue, err := i.refs.vc.Encode(ref.Referred)
+			if err != nil {
+				return err
+			}
+			expected[string(bytesKey)] = bytesValue
+
+			referred, err := i.refs.Get(ctx, ref.Referring)
+			switch {
+			case errors.Is(err, ErrNotFound):
+				inconsistencies = append(inconsistencies, i.inconsistency(ref.Referring, IndexEntryMissing))
+				continue
+			case err != nil:
+				return err
+			}
+			storedValue, err := i.refs.vc.Encode(referred)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(storedValue, bytesValue) {
+				inconsistencies = append(inconsistencies, i.inconsistency(ref.Referring, IndexEntryMissing))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := i.refs.Iterate(ctx, nil)
+	switch {
+	case errors.Is(err, ErrInvalidIterator):
+		return inconsistencies, nil
+	case err != nil:
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		bytesKey, err := encodeKeyWithPrefix(nil, i.refs.kc, kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		bytesValue, err := i.refs.vc.Encode(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		expectedValue, ok := expected[string(bytesKey)]
+		if !ok || !bytes.Equal(expectedValue, bytesValue) {
+			inconsistencies = append(inconsistencies, i.inconsistency(kv.Key, IndexEntryDangling))
+		}
+	}
+	return inconsistencies, nil
+}
+
+func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) inconsistency(
+	key ReferencingKey,
+	kind IndexInconsistencyKind,
+) IndexInconsistency {
+	return IndexInconsistency{
+		Index: i.refs.name,
+		Key:   i.refs.kc.Stringify(key),
+		Kind:  kind,
+	}
+}
@@ -3,7 +3,10 @@ package collections
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 
 	"cosmossdk.io/collections/codec"
 )
@@ -47,6 +50,86 @@ func (i Item[V]) Remove(ctx context.Context) error {
 	return (Map[noKey, V])(i).Remove(ctx, noKey{})
 }
 
+// ItemWithDefault works like an Item, but when the item is not set Get returns
+// the value produced by the default value factory instead of ErrNotFound.
+// The default value is also used as the item value in the DefaultGenesis.
+type ItemWithDefault[V any] struct {
+	Item[V]
+	defaultValue func() V
+}
+
+// NewItemWithDefault instantiates a new ItemWithDefault instance, given the value encoder of the item V
+// and the factory of the default value of the item, which is called lazily every time the default value is needed.
+// Name and prefix must be unique within the schema and name must match the format specified by NameRegex, or
+// else this method will panic.
+func NewItemWithDefault[V any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[V],
+	defaultValue func() V,
+) ItemWithDefault[V] {
+	item := ItemWithDefault[V]{
+		Item:         (Item[V])(newMap[noKey](schema, prefix, name, noKey{}, valueCodec)),
+		defaultValue: defaultValue,
+	}
+	schema.addCollection(item)
+	return item
+}
+
+// Get gets the item, if it is not set it returns the default value.
+// If value decoding fails then an ErrEncoding is returned.
+func (i ItemWithDefault[V]) Get(ctx context.Context) (V, error) {
+	v, err := i.Item.Get(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return i.defaultValue(), nil
+	}
+	return v, err
+}
+
+func (i ItemWithDefault[V]) getName() string   { return (Map[noKey, V])(i.Item).getName() }
+func (i ItemWithDefault[V]) getPrefix() []byte { return (Map[noKey, V])(i.Item).getPrefix() }
+func (i ItemWithDefault[V]) keyType() string   { return (Map[noKey, V])(i.Item).keyType() }
+func (i ItemWithDefault[V]) valueType() string { return (Map[noKey, V])(i.Item).valueType() }
+
+func (i ItemWithDefault[V]) isSecondaryIndexCollection() bool {
+	return (Map[noKey, V])(i.Item).isSecondaryIndexCollection()
+}
+
+func (i ItemWithDefault[V]) decodeRaw(key, value []byte) (DecodedEntry, error) {
+	return (Map[noKey, V])(i.Item).decodeRaw(key, value)
+}
+
+func (i ItemWithDefault[V]) validateGenesis(r io.Reader) error {
+	return (Map[noKey, V])(i.Item).validateGenesis(r)
+}
+
+func (i ItemWithDefault[V]) importGenesis(ctx context.Context, r io.Reader) error {
+	return (Map[noKey, V])(i.Item).importGenesis(ctx, r)
+}
+
+func (i ItemWithDefault[V]) exportGenesis(ctx context.Context, w io.Writer) error {
+	return (Map[noKey, V])(i.Item).exportGenesis(ctx, w)
+}
+
+// defaultGenesis writes the default value of the item as the only genesis entry.
+func (i ItemWithDefault[V]) defaultGenesis(w io.Writer) error {
+	keyBz, err := i.kc.EncodeJSON(noKey{})
+	if err != nil {
+		return err
+	}
+	valueBz, err := i.vc.EncodeJSON(i.defaultValue())
+	if err != nil {
+		return err
+	}
+	bz, err := json.Marshal([]jsonMapEntry{{Key: keyBz, Value: valueBz}})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
 // noKey defines a KeyCodec which decodes nothing.
 type noKey struct{}
 
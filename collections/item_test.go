@@ -1,6 +1,7 @@
 package collections
 
 import (
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -34,3 +35,49 @@ func TestItem(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, has)
 }
+
+func TestItemWithDefault(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	item := NewItemWithDefault(schemaBuilder, NewPrefix("item"), "item", Uint64Value, func() uint64 { return 10 })
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// get default
+	i, err := item.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), i)
+
+	has, err := item.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// set
+	require.NoError(t, item.Set(ctx, 1000))
+	i, err = item.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), i)
+
+	// remove restores the default
+	require.NoError(t, item.Remove(ctx))
+	i, err = item.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), i)
+
+	// default genesis contains the default value
+	var w *bufCloser
+	require.NoError(t, schema.DefaultGenesis(func(field string) (io.WriteCloser, error) {
+		require.Equal(t, "item", field)
+		w = newBufCloser(t, "")
+		return w, nil
+	}))
+	require.Equal(t, `[{"key":"item","value":"10"}]`, w.Buffer.String())
+
+	// which can be imported
+	require.NoError(t, schema.InitGenesis(ctx, func(field string) (io.ReadCloser, error) {
+		return newBufCloser(t, w.Buffer.String()), nil
+	}))
+	has, err = item.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has)
+}
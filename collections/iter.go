@@ -133,37 +133,46 @@ func (r *Range[K]) RangeValues() (start *RangeKey[K], end *RangeKey[K], order Or
 // iteratorFromRanger generates an Iterator instance, with the proper prefixing and ranging.
 // a nil Ranger can be seen as an ascending iteration over all the possible keys.
 func iteratorFromRanger[K, V any](ctx context.Context, m Map[K, V], r Ranger[K]) (iter Iterator[K, V], err error) {
+	startBytes, endBytes, order, err := parseRangeInstruction(m.prefix, m.kc, r)
+	if err != nil {
+		return iter, err
+	}
+	return newIterator(ctx, startBytes, endBytes, order, m)
+}
+
+// parseRangeInstruction converts the provided Ranger to the prefixed start and end
+// bytes of the iteration domain, and its order.
+// A nil Ranger can be seen as an ascending iteration over all the possible keys.
+func parseRangeInstruction[K any](prefix []byte, keyCodec codec.KeyCodec[K], r Ranger[K]) (startBytes, endBytes []byte, order Order, err error) {
 	var (
 		start *RangeKey[K]
 		end   *RangeKey[K]
-		order = OrderAscending
 	)
 
 	if r != nil {
 		start, end, order, err = r.RangeValues()
 		if err != nil {
-			return iter, err
+			return nil, nil, 0, err
 		}
 	}
 
-	startBytes := m.prefix
+	startBytes = prefix
 	if start != nil {
-		startBytes, err = encodeRangeBound(m.prefix, m.kc, start)
+		startBytes, err = encodeRangeBound(prefix, keyCodec, start)
 		if err != nil {
-			return iter, err
+			return nil, nil, 0, err
 		}
 	}
-	var endBytes []byte
 	if end != nil {
-		endBytes, err = encodeRangeBound(m.prefix, m.kc, end)
+		endBytes, err = encodeRangeBound(prefix, keyCodec, end)
 		if err != nil {
-			return iter, err
+			return nil, nil, 0, err
 		}
 	} else {
-		endBytes = nextBytesPrefixKey(m.prefix)
+		endBytes = nextBytesPrefixKey(prefix)
 	}
 
-	return newIterator(ctx, startBytes, endBytes, order, m)
+	return startBytes, endBytes, order, nil
 }
 
 func newIterator[K, V any](ctx context.Context, start, end []byte, order Order, m Map[K, V]) (Iterator[K, V], error) {
@@ -12,10 +12,36 @@ import (
 // of keys and no value. It can be used, for example, in an allow list.
 type KeySet[K any] Map[K, NoValue]
 
+// WithKeySetSecondaryIndex marks the KeySet as a secondary index of another
+// collection, for example of an IndexedMap. Secondary indexes do not take part
+// in genesis, since they're rebuilt by the collection they index.
+func WithKeySetSecondaryIndex() func(opts *keySetOptions) {
+	return func(opts *keySetOptions) {
+		opts.isSecondaryIndex = true
+	}
+}
+
+type keySetOptions struct {
+	isSecondaryIndex bool
+}
+
 // NewKeySet returns a KeySet given a Schema, Prefix a human name for the collection
 // and a KeyCodec for the key K.
-func NewKeySet[K any](schema *SchemaBuilder, prefix Prefix, name string, keyCodec codec.KeyCodec[K]) KeySet[K] {
-	return (KeySet[K])(NewMap(schema, prefix, name, keyCodec, noValueCodec))
+func NewKeySet[K any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	keyCodec codec.KeyCodec[K],
+	options ...func(opts *keySetOptions),
+) KeySet[K] {
+	opts := keySetOptions{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+	m := newMap(schema, prefix, name, keyCodec, noValueCodec)
+	m.isSecondaryIndex = opts.isSecondaryIndex
+	schema.addCollection(m)
+	return (KeySet[K])(m)
 }
 
 // Set adds the key to the KeySet. Errors on encoding problems.
@@ -57,6 +83,12 @@ func (k KeySet[K]) Walk(ctx context.Context, ranger Ranger[K], walkFunc func(key
 	return (Map[K, NoValue])(k).Walk(ctx, ranger, func(key K, value NoValue) bool { return walkFunc(key) })
 }
 
+// Clear clears the KeySet using the provided Ranger. Refer to Map.Clear for
+// behavioral documentation.
+func (k KeySet[K]) Clear(ctx context.Context, ranger Ranger[K]) error {
+	return (Map[K, NoValue])(k).Clear(ctx, ranger)
+}
+
 func (k KeySet[K]) KeyCodec() codec.KeyCodec[K]           { return (Map[K, NoValue])(k).KeyCodec() }
 func (k KeySet[K]) ValueCodec() codec.ValueCodec[NoValue] { return (Map[K, NoValue])(k).ValueCodec() }
 
@@ -52,6 +52,21 @@ func TestKeySet(t *testing.T) {
 	require.False(t, iter.Valid())
 }
 
+func TestKeySet_Clear(t *testing.T) {
+	sk, ctx := deps()
+	ks := NewKeySet(NewSchemaBuilder(sk), NewPrefix(1), "keyset", StringKey)
+	require.NoError(t, ks.Set(ctx, "A"))
+	require.NoError(t, ks.Set(ctx, "B"))
+	require.NoError(t, ks.Set(ctx, "C"))
+
+	require.NoError(t, ks.Clear(ctx, new(Range[string]).StartExclusive("A")))
+	iter, err := ks.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"A"}, keys)
+}
+
 func Test_noValue(t *testing.T) {
 	require.Equal(t, noValueValueType, noValueCodec.ValueType())
 	require.Equal(t, noValueValueType, noValueCodec.Stringify(NoValue{}))
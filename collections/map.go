@@ -2,6 +2,7 @@ package collections
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections/codec"
@@ -20,6 +21,10 @@ type Map[K, V any] struct {
 	sa     func(context.Context) store.KVStore
 	prefix []byte
 	name   string
+
+	// isSecondaryIndex reports whether the Map is used as a secondary index
+	// of another collection, in which case it is excluded from genesis.
+	isSecondaryIndex bool
 }
 
 // NewMap returns a Map given a StoreKey, a Prefix, human-readable name and the relative value and key encoders.
@@ -32,15 +37,26 @@ func NewMap[K, V any](
 	keyCodec codec.KeyCodec[K],
 	valueCodec codec.ValueCodec[V],
 ) Map[K, V] {
-	m := Map[K, V]{
+	m := newMap(schemaBuilder, prefix, name, keyCodec, valueCodec)
+	schemaBuilder.addCollection(m)
+	return m
+}
+
+// newMap instantiates a Map without registering it in the schema.
+func newMap[K, V any](
+	schemaBuilder *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	keyCodec codec.KeyCodec[K],
+	valueCodec codec.ValueCodec[V],
+) Map[K, V] {
+	return Map[K, V]{
 		kc:     keyCodec,
 		vc:     valueCodec,
 		sa:     schemaBuilder.schema.storeAccessor,
 		prefix: prefix.Bytes(),
 		name:   name,
 	}
-	schemaBuilder.addCollection(m)
-	return m
 }
 
 func (m Map[K, V]) getName() string {
@@ -51,6 +67,45 @@ func (m Map[K, V]) getPrefix() []byte {
 	return m.prefix
 }
 
+func (m Map[K, V]) isSecondaryIndexCollection() bool {
+	return m.isSecondaryIndex
+}
+
+func (m Map[K, V]) keyType() string {
+	return m.kc.KeyType()
+}
+
+func (m Map[K, V]) valueType() string {
+	return m.vc.ValueType()
+}
+
+func (m Map[K, V]) decodeRaw(key, value []byte) (entry DecodedEntry, err error) {
+	read, k, err := m.kc.Decode(key)
+	if err != nil {
+		return entry, fmt.Errorf("%w: key decode: %s", ErrEncoding, err)
+	}
+	if read != len(key) {
+		return entry, fmt.Errorf("%w: key decoder didn't fully consume the key: %T %x %d", ErrEncoding, m.kc, key, read)
+	}
+	v, err := m.vc.Decode(value)
+	if err != nil {
+		return entry, fmt.Errorf("%w: value decode: %s", ErrEncoding, err)
+	}
+
+	entry.Collection = m.name
+	entry.Key, err = m.kc.EncodeJSON(k)
+	if err != nil {
+		return entry, err
+	}
+	entry.Value, err = m.vc.EncodeJSON(v)
+	if err != nil {
+		return entry, err
+	}
+	entry.keyString = m.kc.Stringify(k)
+	entry.valueString = m.vc.Stringify(v)
+	return entry, nil
+}
+
 // Set maps the provided value to the provided key in the store.
 // Errors with ErrEncoding if key or value encoding fails.
 func (m Map[K, V]) Set(ctx context.Context, key K, value V) error {
@@ -94,6 +149,17 @@ func (m Map[K, V]) Get(ctx context.Context, key K) (v V, err error) {
 	return v, nil
 }
 
+// GetOr returns the value associated with the provided key,
+// or the provided default value if the key does not exist.
+// Errors with ErrEncoding if the key or value decoding fails.
+func (m Map[K, V]) GetOr(ctx context.Context, key K, defaultValue V) (V, error) {
+	v, err := m.Get(ctx, key)
+	if errors.Is(err, ErrNotFound) {
+		return defaultValue, nil
+	}
+	return v, err
+}
+
 // Has reports whether the key is present in storage or not.
 // Errors with ErrEncoding if key encoding fails.
 func (m Map[K, V]) Has(ctx context.Context, key K) (bool, error) {
@@ -189,6 +255,61 @@ func (m Map[K, V]) IterateRaw(ctx context.Context, start, end []byte, order Orde
 	}, nil
 }
 
+// Clear clears the collection contained within the provided key range.
+// A nil ranger equals to clearing the whole collection.
+// The keys are deleted in batches: a batch of keys is read, the iterator
+// is closed and only then the keys are deleted, so the store is never
+// mutated while being iterated.
+// NOTE: this API needs to be used with care, considering that as of today
+// cosmos-sdk stores the deletion records to be committed in a memory cache,
+// clearing a lot of data might make the node go OOM.
+func (m Map[K, V]) Clear(ctx context.Context, ranger Ranger[K]) error {
+	startBytes, endBytes, _, err := parseRangeInstruction(m.prefix, m.kc, ranger)
+	if err != nil {
+		return err
+	}
+	return deleteDomain(m.sa(ctx), startBytes, endBytes)
+}
+
+// clearBatchSize defines the maximum number of keys read before deleting them
+// when clearing a collection.
+const clearBatchSize = 10000
+
+// deleteDomain deletes all the keys contained in the domain of an iterator.
+// It reads the keys within the domain in batches, closes the iterator and only
+// then deletes them.
+func deleteDomain(s store.KVStore, start, end []byte) error {
+	for {
+		iter, err := s.Iterator(start, end)
+		if err != nil {
+			return err
+		}
+
+		keys := make([][]byte, 0, clearBatchSize)
+		for ; iter.Valid() && len(keys) < clearBatchSize; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+
+		// we close the iterator here instead of deferring
+		err = iter.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			err = s.Delete(key)
+			if err != nil {
+				return err
+			}
+		}
+
+		// if we've retrieved less than the batch size, we're done.
+		if len(keys) < clearBatchSize {
+			return nil
+		}
+	}
+}
+
 // KeyCodec returns the Map's KeyCodec.
 func (m Map[K, V]) KeyCodec() codec.KeyCodec[K] { return m.kc }
 
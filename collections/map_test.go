@@ -1,6 +1,7 @@
 package collections
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,14 @@ func TestMap(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(100), v)
 
+	// test get or
+	v, err = m.GetOr(ctx, 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), v)
+	v, err = m.GetOr(ctx, 2, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), v)
+
 	// test remove
 	err = m.Remove(ctx, 1)
 	require.NoError(t, err)
@@ -71,6 +80,49 @@ func TestMap_IterateRaw(t *testing.T) {
 	require.Equal(t, []uint64{2, 1, 0}, keys)
 }
 
+func TestMap_Clear(t *testing.T) {
+	makeTest := func() (context.Context, Map[uint64, uint64]) {
+		sk, ctx := deps()
+		// safety check to ensure prefix boundaries are not crossed
+		require.NoError(t, sk.OpenKVStore(ctx).Set([]byte{0x0, 0x0}, []byte("before prefix")))
+		require.NoError(t, sk.OpenKVStore(ctx).Set([]byte{0x2, 0x0}, []byte("after prefix")))
+
+		m := NewMap(NewSchemaBuilder(sk), NewPrefix(1), "m", Uint64Key, Uint64Value)
+		for i := uint64(0); i < clearBatchSize*2+1; i++ {
+			require.NoError(t, m.Set(ctx, i, i))
+		}
+		return ctx, m
+	}
+
+	t.Run("nil ranger", func(t *testing.T) {
+		ctx, m := makeTest()
+		require.NoError(t, m.Clear(ctx, nil))
+		_, err := m.Iterate(ctx, nil)
+		require.ErrorIs(t, err, ErrInvalidIterator)
+
+		// prefix boundaries were not crossed
+		kv := m.sa(ctx)
+		has, err := kv.Has([]byte{0x0, 0x0})
+		require.NoError(t, err)
+		require.True(t, has)
+		has, err = kv.Has([]byte{0x2, 0x0})
+		require.NoError(t, err)
+		require.True(t, has)
+	})
+
+	t.Run("custom ranger", func(t *testing.T) {
+		ctx, m := makeTest()
+		// delete from 0 to 9999 (clearBatchSize)
+		require.NoError(t, m.Clear(ctx, new(Range[uint64]).EndExclusive(clearBatchSize)))
+		iter, err := m.Iterate(ctx, nil)
+		require.NoError(t, err)
+		keys, err := iter.Keys()
+		require.NoError(t, err)
+		require.Len(t, keys, clearBatchSize+1)
+		require.Equal(t, uint64(clearBatchSize), keys[0])
+	})
+}
+
 func Test_encodeKey(t *testing.T) {
 	prefix := "prefix"
 	number := []byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}
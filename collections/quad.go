@@ -0,0 +1,351 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// Quad defines a multipart key composed of four keys.
+type Quad[K1, K2, K3, K4 any] struct {
+	k1 *K1
+	k2 *K2
+	k3 *K3
+	k4 *K4
+}
+
+// Join4 instantiates a new Quad instance composed of the four provided keys, in order.
+func Join4[K1, K2, K3, K4 any](k1 K1, k2 K2, k3 K3, k4 K4) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{&k1, &k2, &k3, &k4}
+}
+
+// K1 returns the first part of the key. If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K1() (x K1) {
+	if q.k1 == nil {
+		return
+	}
+	return *q.k1
+}
+
+// K2 returns the second part of the key. If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K2() (x K2) {
+	if q.k2 == nil {
+		return
+	}
+	return *q.k2
+}
+
+// K3 returns the third part of the key. If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K3() (x K3) {
+	if q.k3 == nil {
+		return
+	}
+	return *q.k3
+}
+
+// K4 returns the fourth part of the key. If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K4() (x K4) {
+	if q.k4 == nil {
+		return
+	}
+	return *q.k4
+}
+
+// QuadPrefix creates a new Quad instance composed only of the first part of the key.
+func QuadPrefix[K1, K2, K3, K4 any](k1 K1) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{k1: &k1}
+}
+
+// QuadSuperPrefix creates a new Quad instance composed only of the first two parts of the key.
+func QuadSuperPrefix[K1, K2, K3, K4 any](k1 K1, k2 K2) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{k1: &k1, k2: &k2}
+}
+
+// QuadSuperPrefix3 creates a new Quad instance composed only of the first three parts of the key.
+func QuadSuperPrefix3[K1, K2, K3, K4 any](k1 K1, k2 K2, k3 K3) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{k1: &k1, k2: &k2, k3: &k3}
+}
+
+// QuadKeyCodec instantiates a new KeyCodec instance that can encode the Quad, given
+// the KeyCodecs of the four parts of the key, in order.
+func QuadKeyCodec[K1, K2, K3, K4 any](
+	keyCodec1 codec.KeyCodec[K1],
+	keyCodec2 codec.KeyCodec[K2],
+	keyCodec3 codec.KeyCodec[K3],
+	keyCodec4 codec.KeyCodec[K4],
+) codec.KeyCodec[Quad[K1, K2, K3, K4]] {
+	return quadKeyCodec[K1, K2, K3, K4]{
+		keyCodec1: keyCodec1,
+		keyCodec2: keyCodec2,
+		keyCodec3: keyCodec3,
+		keyCodec4: keyCodec4,
+	}
+}
+
+type quadKeyCodec[K1, K2, K3, K4 any] struct {
+	keyCodec1 codec.KeyCodec[K1]
+	keyCodec2 codec.KeyCodec[K2]
+	keyCodec3 codec.KeyCodec[K3]
+	keyCodec4 codec.KeyCodec[K4]
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec1() codec.KeyCodec[K1] { return q.keyCodec1 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec2() codec.KeyCodec[K2] { return q.keyCodec2 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec3() codec.KeyCodec[K3] { return q.keyCodec3 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec4() codec.KeyCodec[K4] { return q.keyCodec4 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Encode(buffer []byte, key Quad[K1, K2, K3, K4]) (int, error) {
+	writtenTotal := 0
+	if key.k1 != nil {
+		written, err := q.keyCodec1.EncodeNonTerminal(buffer, *key.k1)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.k2 != nil {
+		written, err := q.keyCodec2.EncodeNonTerminal(buffer[writtenTotal:], *key.k2)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.k3 != nil {
+		written, err := q.keyCodec3.EncodeNonTerminal(buffer[writtenTotal:], *key.k3)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.k4 != nil {
+		written, err := q.keyCodec4.Encode(buffer[writtenTotal:], *key.k4)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	return writtenTotal, nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Decode(buffer []byte) (int, Quad[K1, K2, K3, K4], error) {
+	readTotal := 0
+	read, key1, err := q.keyCodec1.DecodeNonTerminal(buffer)
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	read, key2, err := q.keyCodec2.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	read, key3, err := q.keyCodec3.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	read, key4, err := q.keyCodec4.Decode(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	return readTotal, Join4(key1, key2, key3, key4), nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Size(key Quad[K1, K2, K3, K4]) int {
+	size := 0
+	if key.k1 != nil {
+		size += q.keyCodec1.SizeNonTerminal(*key.k1)
+	}
+	if key.k2 != nil {
+		size += q.keyCodec2.SizeNonTerminal(*key.k2)
+	}
+	if key.k3 != nil {
+		size += q.keyCodec3.SizeNonTerminal(*key.k3)
+	}
+	if key.k4 != nil {
+		size += q.keyCodec4.Size(*key.k4)
+	}
+	return size
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) EncodeNonTerminal(buffer []byte, key Quad[K1, K2, K3, K4]) (int, error) {
+	writtenTotal := 0
+	if key.k1 != nil {
+		written, err := q.keyCodec1.EncodeNonTerminal(buffer, *key.k1)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.k2 != nil {
+		written, err := q.keyCodec2.EncodeNonTerminal(buffer[writtenTotal:], *key.k2)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.k3 != nil {
+		written, err := q.keyCodec3.EncodeNonTerminal(buffer[writtenTotal:], *key.k3)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.k4 != nil {
+		written, err := q.keyCodec4.EncodeNonTerminal(buffer[writtenTotal:], *key.k4)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	return writtenTotal, nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) DecodeNonTerminal(buffer []byte) (int, Quad[K1, K2, K3, K4], error) {
+	readTotal := 0
+	read, key1, err := q.keyCodec1.DecodeNonTerminal(buffer)
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	read, key2, err := q.keyCodec2.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	read, key3, err := q.keyCodec3.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	read, key4, err := q.keyCodec4.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	return readTotal, Join4(key1, key2, key3, key4), nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) SizeNonTerminal(key Quad[K1, K2, K3, K4]) int {
+	size := 0
+	if key.k1 != nil {
+		size += q.keyCodec1.SizeNonTerminal(*key.k1)
+	}
+	if key.k2 != nil {
+		size += q.keyCodec2.SizeNonTerminal(*key.k2)
+	}
+	if key.k3 != nil {
+		size += q.keyCodec3.SizeNonTerminal(*key.k3)
+	}
+	if key.k4 != nil {
+		size += q.keyCodec4.SizeNonTerminal(*key.k4)
+	}
+	return size
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Stringify(key Quad[K1, K2, K3, K4]) string {
+	b := new(strings.Builder)
+	b.WriteByte('(')
+	writePart(b, key.k1 != nil, func() string { return q.keyCodec1.Stringify(*key.k1) })
+	b.WriteString(", ")
+	writePart(b, key.k2 != nil, func() string { return q.keyCodec2.Stringify(*key.k2) })
+	b.WriteString(", ")
+	writePart(b, key.k3 != nil, func() string { return q.keyCodec3.Stringify(*key.k3) })
+	b.WriteString(", ")
+	writePart(b, key.k4 != nil, func() string { return q.keyCodec4.Stringify(*key.k4) })
+	b.WriteByte(')')
+	return b.String()
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyType() string {
+	return fmt.Sprintf("Quad[%s, %s, %s, %s]",
+		q.keyCodec1.KeyType(), q.keyCodec2.KeyType(), q.keyCodec3.KeyType(), q.keyCodec4.KeyType(),
+	)
+}
+
+// GENESIS
+
+type jsonQuadKey [4]json.RawMessage
+
+func (q quadKeyCodec[K1, K2, K3, K4]) EncodeJSON(value Quad[K1, K2, K3, K4]) ([]byte, error) {
+	k1Json, err := q.keyCodec1.EncodeJSON(value.K1())
+	if err != nil {
+		return nil, err
+	}
+	k2Json, err := q.keyCodec2.EncodeJSON(value.K2())
+	if err != nil {
+		return nil, err
+	}
+	k3Json, err := q.keyCodec3.EncodeJSON(value.K3())
+	if err != nil {
+		return nil, err
+	}
+	k4Json, err := q.keyCodec4.EncodeJSON(value.K4())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonQuadKey{k1Json, k2Json, k3Json, k4Json})
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) DecodeJSON(b []byte) (Quad[K1, K2, K3, K4], error) {
+	quadJSON := jsonQuadKey{}
+	err := json.Unmarshal(b, &quadJSON)
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+
+	k1, err := q.keyCodec1.DecodeJSON(quadJSON[0])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+	k2, err := q.keyCodec2.DecodeJSON(quadJSON[1])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+	k3, err := q.keyCodec3.DecodeJSON(quadJSON[2])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+	k4, err := q.keyCodec4.DecodeJSON(quadJSON[3])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+
+	return Join4(k1, k2, k3, k4), nil
+}
+
+// NewPrefixedQuadRange provides a Range for all keys prefixed by the given
+// first part of the Quad key.
+func NewPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1) Ranger[Quad[K1, K2, K3, K4]] {
+	key := QuadPrefix[K1, K2, K3, K4](k1)
+	return &Range[Quad[K1, K2, K3, K4]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
+
+// NewSuperPrefixedQuadRange provides a Range for all keys prefixed by the given
+// first and second parts of the Quad key.
+func NewSuperPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1, k2 K2) Ranger[Quad[K1, K2, K3, K4]] {
+	key := QuadSuperPrefix[K1, K2, K3, K4](k1, k2)
+	return &Range[Quad[K1, K2, K3, K4]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
+
+// NewSuperPrefixedQuadRange3 provides a Range for all keys prefixed by the given
+// first, second and third parts of the Quad key.
+func NewSuperPrefixedQuadRange3[K1, K2, K3, K4 any](k1 K1, k2 K2, k3 K3) Ranger[Quad[K1, K2, K3, K4]] {
+	key := QuadSuperPrefix3[K1, K2, K3, K4](k1, k2, k3)
+	return &Range[Quad[K1, K2, K3, K4]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
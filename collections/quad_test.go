@@ -0,0 +1,62 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuad(t *testing.T) {
+	keyCodec := QuadKeyCodec(StringKey, StringKey, StringKey, StringKey)
+	t.Run("stringify", func(t *testing.T) {
+		s := keyCodec.Stringify(Join4("a", "b", "c", "d"))
+		require.Equal(t, `("a", "b", "c", "d")`, s)
+		s = keyCodec.Stringify(QuadSuperPrefix3[string, string, string, string]("a", "b", "c"))
+		require.Equal(t, `("a", "b", "c", <nil>)`, s)
+		s = keyCodec.Stringify(QuadPrefix[string, string, string, string]("a"))
+		require.Equal(t, `("a", <nil>, <nil>, <nil>)`, s)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := keyCodec.EncodeJSON(Join4("k1", "k2", "k3", "k4"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(`["k1","k2","k3","k4"]`), b)
+		decoded, err := keyCodec.DecodeJSON(b)
+		require.NoError(t, err)
+		require.Equal(t, Join4("k1", "k2", "k3", "k4"), decoded)
+	})
+}
+
+func TestQuadRange(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	kc := QuadKeyCodec(StringKey, StringKey, StringKey, Uint64Key)
+	m := NewMap(schema, NewPrefix(0), "quad", kc, Uint64Value)
+
+	require.NoError(t, m.Set(ctx, Join4("A", "a", "x", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join4("A", "a", "x", uint64(1)), 0))
+	require.NoError(t, m.Set(ctx, Join4("A", "a", "y", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join4("A", "b", "x", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join4("B", "a", "x", uint64(0)), 0))
+
+	iter, err := m.Iterate(ctx, NewPrefixedQuadRange[string, string, string, uint64]("A"))
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 4)
+
+	iter, err = m.Iterate(ctx, NewSuperPrefixedQuadRange[string, string, string, uint64]("A", "a"))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	iter, err = m.Iterate(ctx, NewSuperPrefixedQuadRange3[string, string, string, uint64]("A", "a", "x"))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Quad[string, string, string, uint64]{
+		Join4("A", "a", "x", uint64(0)),
+		Join4("A", "a", "x", uint64(1)),
+	}, keys)
+}
@@ -1,7 +1,9 @@
 package collections
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
@@ -164,7 +166,7 @@ func NewSchemaFromAccessor(accessor func(context.Context) store.KVStore) Schema
 
 // DefaultGenesis implements the appmodule.HasGenesis.DefaultGenesis method.
 func (s Schema) DefaultGenesis(target appmodule.GenesisTarget) error {
-	for _, name := range s.collectionsOrdered {
+	for _, name := range s.genesisCollections() {
 		err := s.defaultGenesis(target, name)
 		if err != nil {
 			return fmt.Errorf("failed to instantiate default genesis for %s: %w", name, err)
@@ -191,7 +193,7 @@ func (s Schema) defaultGenesis(target appmodule.GenesisTarget, name string) erro
 
 // ValidateGenesis implements the appmodule.HasGenesis.ValidateGenesis method.
 func (s Schema) ValidateGenesis(source appmodule.GenesisSource) error {
-	for _, name := range s.collectionsOrdered {
+	for _, name := range s.genesisCollections() {
 		err := s.validateGenesis(source, name)
 		if err != nil {
 			return fmt.Errorf("failed genesis validation of %s: %w", name, err)
@@ -222,7 +224,7 @@ func (s Schema) validateGenesis(source appmodule.GenesisSource, name string) err
 
 // InitGenesis implements the appmodule.HasGenesis.InitGenesis method.
 func (s Schema) InitGenesis(ctx context.Context, source appmodule.GenesisSource) error {
-	for _, name := range s.collectionsOrdered {
+	for _, name := range s.genesisCollections() {
 		err := s.initGenesis(ctx, source, name)
 		if err != nil {
 			return fmt.Errorf("failed genesis initialisation of %s: %w", name, err)
@@ -254,7 +256,7 @@ func (s Schema) initGenesis(ctx context.Context, source appmodule.GenesisSource,
 
 // ExportGenesis implements the appmodule.HasGenesis.ExportGenesis method.
 func (s Schema) ExportGenesis(ctx context.Context, target appmodule.GenesisTarget) error {
-	for _, name := range s.collectionsOrdered {
+	for _, name := range s.genesisCollections() {
 		err := s.exportGenesis(ctx, target, name)
 		if err != nil {
 			return fmt.Errorf("failed to export genesis for %s: %w", name, err)
@@ -279,6 +281,94 @@ func (s Schema) exportGenesis(ctx context.Context, target appmodule.GenesisTarge
 	return coll.exportGenesis(ctx, wc)
 }
 
+// CollectionInfo describes a collection registered within a Schema.
+type CollectionInfo struct {
+	// Name is the unique human-readable name of the collection within the Schema.
+	Name string
+	// Prefix is the unique binary prefix of the collection within the Schema.
+	Prefix []byte
+	// KeyType is the identifier of the collection key type, as reported by KeyCodec.KeyType.
+	KeyType string
+	// ValueType is the identifier of the collection value type, as reported by ValueCodec.ValueType.
+	ValueType string
+}
+
+// ListCollections returns the information of all the collections of the Schema,
+// ordered by name.
+func (s Schema) ListCollections() []CollectionInfo {
+	infos := make([]CollectionInfo, 0, len(s.collectionsOrdered))
+	for _, name := range s.collectionsOrdered {
+		infos = append(infos, collectionInfo(s.collectionsByName[name]))
+	}
+	return infos
+}
+
+// GetCollectionInfo returns the information of the collection with the provided name.
+func (s Schema) GetCollectionInfo(name string) (CollectionInfo, error) {
+	coll, err := s.getCollection(name)
+	if err != nil {
+		return CollectionInfo{}, err
+	}
+	return collectionInfo(coll), nil
+}
+
+func collectionInfo(coll collection) CollectionInfo {
+	return CollectionInfo{
+		Name:      coll.getName(),
+		Prefix:    coll.getPrefix(),
+		KeyType:   coll.keyType(),
+		ValueType: coll.valueType(),
+	}
+}
+
+// DecodedEntry is the decoded representation of a raw store entry
+// belonging to a collection.
+type DecodedEntry struct {
+	// Collection is the name of the collection owning the entry.
+	Collection string `json:"collection"`
+	// Key is the JSON representation of the key.
+	Key json.RawMessage `json:"key"`
+	// Value is the JSON representation of the value.
+	Value json.RawMessage `json:"value,omitempty"`
+
+	keyString   string
+	valueString string
+}
+
+// String returns a human-readable representation of the entry.
+func (e DecodedEntry) String() string {
+	return fmt.Sprintf("%s: %s => %s", e.Collection, e.keyString, e.valueString)
+}
+
+// DecodeRaw decodes the provided raw store key and value, finding the collection
+// of the Schema which owns the key through its prefix.
+// Errors with ErrNotFound if no collection owns the key, or with ErrEncoding
+// if the key or value cannot be decoded.
+func (s Schema) DecodeRaw(key, value []byte) (DecodedEntry, error) {
+	for prefix, coll := range s.collectionsByPrefix {
+		if !bytes.HasPrefix(key, []byte(prefix)) {
+			continue
+		}
+		// prefixes do not overlap within a schema, so the first match is the owner.
+		return coll.decodeRaw(key[len(prefix):], value)
+	}
+	return DecodedEntry{}, fmt.Errorf("%w: no collection owns key %x", ErrNotFound, key)
+}
+
+// genesisCollections returns the ordered names of the collections which take
+// part in genesis, secondary indexes are excluded since they're rebuilt by the
+// collection they index.
+func (s Schema) genesisCollections() []string {
+	names := make([]string, 0, len(s.collectionsOrdered))
+	for _, name := range s.collectionsOrdered {
+		if s.collectionsByName[name].isSecondaryIndexCollection() {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
 func (s Schema) getCollection(name string) (collection, error) {
 	coll, ok := s.collectionsByName[name]
 	if !ok {
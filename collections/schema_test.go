@@ -70,3 +70,67 @@ func TestSchemaBuilderCantBeUsedAfterBuild(t *testing.T) {
 		NewMap(schemaBuilder, NewPrefix(2), "def", Uint64Key, Uint64Value)
 	})
 }
+
+func TestSchemaReflection(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "map", PairKeyCodec(StringKey, Uint64Key), StringValue)
+	i := NewItem(schemaBuilder, NewPrefix(2), "item", Uint64Value)
+	ks := NewKeySet(schemaBuilder, NewPrefix(3), "key_set", StringKey)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	t.Run("list collections", func(t *testing.T) {
+		require.Equal(t, []CollectionInfo{
+			{Name: "item", Prefix: []byte{2}, KeyType: "no_key", ValueType: "uint64"},
+			{Name: "key_set", Prefix: []byte{3}, KeyType: "string", ValueType: "no_value"},
+			{Name: "map", Prefix: []byte{1}, KeyType: "Pair[string, uint64]", ValueType: "string"},
+		}, schema.ListCollections())
+
+		info, err := schema.GetCollectionInfo("map")
+		require.NoError(t, err)
+		require.Equal(t, "map", info.Name)
+
+		_, err = schema.GetCollectionInfo("unknown")
+		require.ErrorContains(t, err, "unknown collection")
+	})
+
+	t.Run("decode raw", func(t *testing.T) {
+		require.NoError(t, m.Set(ctx, Join("foo", uint64(1)), "bar"))
+		require.NoError(t, i.Set(ctx, 10))
+		require.NoError(t, ks.Set(ctx, "baz"))
+
+		// walk the raw store and decode every entry
+		iter, err := sk.OpenKVStore(ctx).Iterator(nil, nil)
+		require.NoError(t, err)
+		defer iter.Close()
+
+		var entries []DecodedEntry
+		for ; iter.Valid(); iter.Next() {
+			entry, err := schema.DecodeRaw(iter.Key(), iter.Value())
+			require.NoError(t, err)
+			entries = append(entries, entry)
+		}
+		require.Len(t, entries, 3)
+
+		require.Equal(t, "map", entries[0].Collection)
+		require.JSONEq(t, `["foo","1"]`, string(entries[0].Key))
+		require.JSONEq(t, `"bar"`, string(entries[0].Value))
+		require.Equal(t, `map: ("foo", "1") => bar`, entries[0].String())
+
+		require.Equal(t, "item", entries[1].Collection)
+		require.JSONEq(t, `"10"`, string(entries[1].Value))
+
+		require.Equal(t, "key_set", entries[2].Collection)
+		require.JSONEq(t, `"baz"`, string(entries[2].Key))
+		require.Nil(t, entries[2].Value)
+
+		// unknown prefix
+		_, err = schema.DecodeRaw([]byte{0xff}, nil)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		// undecodable value
+		_, err = schema.DecodeRaw([]byte{2}, []byte{0x1})
+		require.ErrorIs(t, err, ErrEncoding)
+	})
+}
@@ -0,0 +1,74 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriple(t *testing.T) {
+	keyCodec := TripleKeyCodec(StringKey, StringKey, StringKey)
+	t.Run("stringify", func(t *testing.T) {
+		s := keyCodec.Stringify(Join3("a", "b", "c"))
+		require.Equal(t, `("a", "b", "c")`, s)
+		s = keyCodec.Stringify(TripleSuperPrefix[string, string, string]("a", "b"))
+		require.Equal(t, `("a", "b", <nil>)`, s)
+		s = keyCodec.Stringify(TriplePrefix[string, string, string]("a"))
+		require.Equal(t, `("a", <nil>, <nil>)`, s)
+		s = keyCodec.Stringify(Triple[string, string, string]{})
+		require.Equal(t, `(<nil>, <nil>, <nil>)`, s)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := keyCodec.EncodeJSON(Join3("k1", "k2", "k3"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(`["k1","k2","k3"]`), b)
+		decoded, err := keyCodec.DecodeJSON(b)
+		require.NoError(t, err)
+		require.Equal(t, Join3("k1", "k2", "k3"), decoded)
+	})
+
+	t.Run("key type", func(t *testing.T) {
+		require.Equal(t, "Triple[string, string, uint64]", TripleKeyCodec(StringKey, StringKey, Uint64Key).KeyType())
+	})
+}
+
+func TestTripleRange(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	kc := TripleKeyCodec(StringKey, StringKey, Uint64Key)
+	m := NewMap(schema, NewPrefix(0), "triple", kc, Uint64Value)
+
+	require.NoError(t, m.Set(ctx, Join3("A", "a", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join3("A", "a", uint64(1)), 0))
+	require.NoError(t, m.Set(ctx, Join3("A", "b", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join3("B", "a", uint64(0)), 0))
+
+	// expect the whole "A" prefix
+	iter, err := m.Iterate(ctx, NewPrefixedTripleRange[string, string, uint64]("A"))
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Triple[string, string, uint64]{
+		Join3("A", "a", uint64(0)),
+		Join3("A", "a", uint64(1)),
+		Join3("A", "b", uint64(0)),
+	}, keys)
+
+	// expect only "A", "a"
+	iter, err = m.Iterate(ctx, NewSuperPrefixedTripleRange[string, string, uint64]("A", "a"))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Triple[string, string, uint64]{
+		Join3("A", "a", uint64(0)),
+		Join3("A", "a", uint64(1)),
+	}, keys)
+
+	// expect only "A", "b" using a generic range
+	iter, err = m.Iterate(ctx, new(Range[Triple[string, string, uint64]]).Prefix(TripleSuperPrefix[string, string, uint64]("A", "b")))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Triple[string, string, uint64]{Join3("A", "b", uint64(0))}, keys)
+}
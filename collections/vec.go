@@ -0,0 +1,145 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections/codec"
+)
+
+var (
+	// ErrEmptyVec is returned when trying to pop an element from an empty Vec.
+	ErrEmptyVec = errors.New("collections: vec is empty")
+	// ErrOutOfBounds is returned when trying to do an operation on an index that is out of bounds.
+	ErrOutOfBounds = errors.New("collections: index out of bounds")
+)
+
+var (
+	// VecLengthSuffix is appended to the Vec Prefix to build the prefix of the length Item.
+	VecLengthSuffix = []byte{0}
+	// VecElementsSuffix is appended to the Vec Prefix to build the prefix of the elements Map.
+	VecElementsSuffix = []byte{1}
+)
+
+// Vec represents an ordered list of elements, which supports appending and
+// removing elements from the tail and random access through their index.
+// Its length is tracked separately, so it can be queried in O(1).
+type Vec[T any] struct {
+	length   Item[uint64]
+	elements Map[uint64, T]
+}
+
+// NewVec creates a new Vec instance. Since Vec relies on two collections, one for the length
+// and the other for the elements, it will register two state objects on the schema builder.
+// The first is the length, an Item, whose prefix is the provided prefix followed by VecLengthSuffix
+// and whose name is the provided name followed by "_length".
+// The second are the elements, a Map, whose prefix is the provided prefix followed by VecElementsSuffix
+// and whose name is the provided name followed by "_elements".
+// Both collections take part in the Schema genesis import and export.
+func NewVec[T any](sb *SchemaBuilder, prefix Prefix, name string, vc codec.ValueCodec[T]) Vec[T] {
+	return Vec[T]{
+		length:   NewItem(sb, suffixedPrefix(prefix, VecLengthSuffix), name+"_length", Uint64Value),
+		elements: NewMap(sb, suffixedPrefix(prefix, VecElementsSuffix), name+"_elements", Uint64Key, vc),
+	}
+}
+
+// Push adds an element to the end of the Vec.
+func (v Vec[T]) Push(ctx context.Context, elem T) error {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return err
+	}
+	err = v.elements.Set(ctx, length, elem)
+	if err != nil {
+		return err
+	}
+	return v.length.Set(ctx, length+1)
+}
+
+// Pop removes the last element of the Vec and returns it.
+// Errors with ErrEmptyVec if the Vec is empty.
+func (v Vec[T]) Pop(ctx context.Context) (elem T, err error) {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return elem, err
+	}
+	if length == 0 {
+		return elem, ErrEmptyVec
+	}
+	lastIndex := length - 1
+	elem, err = v.elements.Get(ctx, lastIndex)
+	if err != nil {
+		return elem, err
+	}
+	err = v.elements.Remove(ctx, lastIndex)
+	if err != nil {
+		return elem, err
+	}
+	return elem, v.length.Set(ctx, lastIndex)
+}
+
+// Replace replaces the element at the given index.
+// Errors with ErrOutOfBounds if the index is greater or equal than the length of the Vec.
+func (v Vec[T]) Replace(ctx context.Context, index uint64, elem T) error {
+	err := v.checkBounds(ctx, index)
+	if err != nil {
+		return err
+	}
+	return v.elements.Set(ctx, index, elem)
+}
+
+// Get returns the element at the given index.
+// Errors with ErrOutOfBounds if the index is greater or equal than the length of the Vec.
+func (v Vec[T]) Get(ctx context.Context, index uint64) (elem T, err error) {
+	err = v.checkBounds(ctx, index)
+	if err != nil {
+		return elem, err
+	}
+	return v.elements.Get(ctx, index)
+}
+
+// Len returns the length of the Vec.
+func (v Vec[T]) Len(ctx context.Context) (uint64, error) {
+	length, err := v.length.Get(ctx)
+	switch {
+	case err == nil:
+		return length, nil
+	case errors.Is(err, ErrNotFound):
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+// Iterate iterates over the Vec, the Ranger is defined over the indexes of the elements.
+// A nil ranger equals to iterate over all the elements in ascending order.
+func (v Vec[T]) Iterate(ctx context.Context, ranger Ranger[uint64]) (Iterator[uint64, T], error) {
+	return v.elements.Iterate(ctx, ranger)
+}
+
+// Walk walks over the Vec with the provided range, calls the provided walk function
+// with the index and the element. If the callback function returns true then the walking is stopped.
+// A nil ranger equals to walking over all the elements.
+func (v Vec[T]) Walk(ctx context.Context, ranger Ranger[uint64], walkFunc func(index uint64, elem T) bool) error {
+	return v.elements.Walk(ctx, ranger, walkFunc)
+}
+
+func (v Vec[T]) checkBounds(ctx context.Context, index uint64) error {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return err
+	}
+	if index >= length {
+		return fmt.Errorf("%w: index %d, length %d", ErrOutOfBounds, index, length)
+	}
+	return nil
+}
+
+// suffixedPrefix returns a new Prefix composed of the provided prefix followed by the suffix,
+// it never modifies the underlying bytes of the provided prefix.
+func suffixedPrefix(prefix Prefix, suffix []byte) Prefix {
+	b := make([]byte, 0, len(prefix)+len(suffix))
+	b = append(b, prefix...)
+	return append(b, suffix...)
+}
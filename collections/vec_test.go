@@ -0,0 +1,137 @@
+package collections_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVec(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	vec := collections.NewVec(schemaBuilder, collections.NewPrefix("vec"), "vec", collections.StringValue)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// length when empty
+	length, err := vec.Len(ctx)
+	require.NoError(t, err)
+	require.Zero(t, length)
+
+	// pop when empty
+	_, err = vec.Pop(ctx)
+	require.ErrorIs(t, err, collections.ErrEmptyVec)
+
+	// get out of bounds
+	_, err = vec.Get(ctx, 0)
+	require.ErrorIs(t, err, collections.ErrOutOfBounds)
+
+	// replace out of bounds
+	err = vec.Replace(ctx, 0, "foo")
+	require.ErrorIs(t, err, collections.ErrOutOfBounds)
+
+	// push
+	require.NoError(t, vec.Push(ctx, "foo"))
+	require.NoError(t, vec.Push(ctx, "bar"))
+	require.NoError(t, vec.Push(ctx, "baz"))
+
+	length, err = vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), length)
+
+	// get
+	elem, err := vec.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "bar", elem)
+
+	// replace
+	require.NoError(t, vec.Replace(ctx, 1, "qux"))
+	elem, err = vec.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "qux", elem)
+
+	// iterate
+	iter, err := vec.Iterate(ctx, nil)
+	require.NoError(t, err)
+	values, err := iter.Values()
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo", "qux", "baz"}, values)
+
+	// iterate in a range
+	iter, err = vec.Iterate(ctx, new(collections.Range[uint64]).StartExclusive(0).Descending())
+	require.NoError(t, err)
+	values, err = iter.Values()
+	require.NoError(t, err)
+	require.Equal(t, []string{"baz", "qux"}, values)
+
+	// pop
+	elem, err = vec.Pop(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "baz", elem)
+
+	length, err = vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), length)
+
+	_, err = vec.Get(ctx, 2)
+	require.ErrorIs(t, err, collections.ErrOutOfBounds)
+
+	// push after pop reuses the index
+	require.NoError(t, vec.Push(ctx, "quux"))
+	var walked []string
+	err = vec.Walk(ctx, nil, func(index uint64, elem string) bool {
+		require.Equal(t, uint64(len(walked)), index)
+		walked = append(walked, elem)
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo", "qux", "quux"}, walked)
+}
+
+func TestVecGenesis(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	vec := collections.NewVec(schemaBuilder, collections.NewPrefix("vec"), "vec", collections.StringValue)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, vec.Push(ctx, "foo"))
+	require.NoError(t, vec.Push(ctx, "bar"))
+
+	exported := map[string]*strings.Builder{}
+	err = schema.ExportGenesis(ctx, func(field string) (io.WriteCloser, error) {
+		b := new(strings.Builder)
+		exported[field] = b
+		return nopWriteCloser{b}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, `[{"key":"item","value":"2"}]`, exported["vec_length"].String())
+	require.Equal(t, `[{"key":"0","value":"foo"},{"key":"1","value":"bar"}]`, exported["vec_elements"].String())
+
+	// import into a new store
+	sk, ctx = colltest.MockStore()
+	schemaBuilder = collections.NewSchemaBuilder(sk)
+	vec = collections.NewVec(schemaBuilder, collections.NewPrefix("vec"), "vec", collections.StringValue)
+	schema, err = schemaBuilder.Build()
+	require.NoError(t, err)
+
+	err = schema.InitGenesis(ctx, func(field string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(exported[field].String())), nil
+	})
+	require.NoError(t, err)
+
+	length, err := vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), length)
+	elem, err := vec.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "bar", elem)
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
@@ -153,7 +153,10 @@ func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (a *App) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry)
+	authtx.RegisterTxServiceWithOptions(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry, authtx.TxServiceOptions{
+		SimulateWithOverrides: a.SimulateWithOverrides,
+		TraceTx:               a.TraceTx,
+	})
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -46,6 +46,9 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningAsync prunes the heights in a background worker instead of during Commit.
+	PruningAsync bool `mapstructure:"pruning-async"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -91,6 +94,14 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// GasAttribution enables the attribution of the gas consumed by the delivered
+	// transactions to the stores and operations consuming it.
+	GasAttribution bool `mapstructure:"gas-attribution"`
+
+	// ParallelExecutionWorkers is the number of workers executing the transactions
+	// of the blocks optimistically in parallel, 0 to execute them serially.
+	ParallelExecutionWorkers int `mapstructure:"parallel-execution-workers"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -159,6 +170,14 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotCompression sets the compression of the snapshots taken: zlib,
+	// zstd or none.
+	SnapshotCompression string `mapstructure:"snapshot-compression"`
+
+	// RestoreConcurrency sets the maximum number of stores imported concurrently
+	// while restoring a snapshot. Values lower than 2 restore the stores sequentially.
+	RestoreConcurrency int `mapstructure:"restore-concurrency"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -169,6 +188,10 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int
+
+	// Persist defines if the txs of the mempool are recorded to a db in the data
+	// directory, and replayed through CheckTx on restart.
+	Persist bool
 }
 
 type (
@@ -182,7 +205,8 @@ type (
 	// fields are required to be set when state streaming is enabled via a non-empty
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
-		File FileStreamerConfig `mapstructure:"file"`
+		File      FileStreamerConfig      `mapstructure:"file"`
+		VersionDB VersionDBStreamerConfig `mapstructure:"versiondb"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -200,6 +224,15 @@ type (
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
 	}
+
+	// VersionDBStreamerConfig defines the configuration options of the version store
+	// streamer, which serves the queries of the heights pruned from the multistore.
+	VersionDBStreamerConfig struct {
+		// Keys must be ["*"], the version store needs the state of every store.
+		Keys []string `mapstructure:"keys"`
+		// Dir is the directory of the version store db, relative to the node home.
+		Dir string `mapstructure:"dir"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -253,12 +286,16 @@ func DefaultConfig() *Config {
 			Pruning:             pruningtypes.PruningOptionDefault,
 			PruningKeepRecent:   "0",
 			PruningInterval:     "0",
+			PruningAsync:        false,
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
+			GasAttribution:      false,
 			AppDBBackend:        "",
+
+			ParallelExecutionWorkers: 0,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -282,8 +319,10 @@ func DefaultConfig() *Config {
 			Enable: true,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:    0,
+			SnapshotKeepRecent:  2,
+			SnapshotCompression: "zlib",
+			RestoreConcurrency:  1,
 		},
 		Store: StoreConfig{
 			Streamers: []string{},
@@ -298,6 +337,10 @@ func DefaultConfig() *Config {
 				// in face of system crash.
 				Fsync: false,
 			},
+			VersionDB: VersionDBStreamerConfig{
+				Keys: []string{"*"},
+				Dir:  "data/versiondb",
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
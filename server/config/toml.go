@@ -31,6 +31,11 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningAsync prunes the heights in a background worker instead of during the block
+# commit, the commit latency is then not affected by the pruning intervals.
+# Default is false.
+pruning-async = {{ .BaseConfig.PruningAsync }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -81,6 +86,16 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# GasAttribution enables the attribution of the gas consumed by the delivered transactions
+# to the stores and operations consuming it, emitted as the tx_gas_store telemetry counter.
+# Simulations always return the attributed gas. Default is false.
+gas-attribution = {{ .BaseConfig.GasAttribution }}
+
+# ParallelExecutionWorkers is the number of workers executing the transactions of the
+# blocks optimistically in parallel, the results being the ones of a serial execution.
+# The transactions are executed serially if it is 0. Default is 0.
+parallel-execution-workers = {{ .BaseConfig.ParallelExecutionWorkers }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # First fallback is the deprecated compile-time types.DBBackend value.
@@ -198,6 +213,14 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-compression specifies the compression of the snapshots taken (zlib|zstd|none).
+# It is recorded in the snapshot metadata, so nodes restore snapshots of any compression.
+snapshot-compression = "{{ .StateSync.SnapshotCompression }}"
+
+# restore-concurrency specifies the maximum number of stores imported concurrently while
+# restoring a snapshot (1 to restore the stores sequentially).
+restore-concurrency = {{ .StateSync.RestoreConcurrency }}
+
 ###############################################################################
 ###                         Store / State Streaming                         ###
 ###############################################################################
@@ -221,6 +244,16 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
 
+# The versiondb streamer writes the state changes of every block to a version store,
+# which serves the queries of the heights pruned from the IAVL stores. It is enabled by
+# adding "versiondb" to store.streamers, when enabled on a node with existing state the
+# state of the latest height is imported, and queries are served from that height on.
+[streamers.versiondb]
+# keys must expose every store.
+keys = [{{ range .Streamers.VersionDB.Keys }}{{ printf "%q, " . }}{{end}}]
+# dir is the directory of the version store, relative to the node home.
+dir = "{{ .Streamers.VersionDB.Dir }}"
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -233,6 +266,11 @@ fsync = "{{ .Streamers.File.Fsync }}"
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+# persist records the transactions of the mempool to a db in the data directory. On
+# restart, they are replayed through CheckTx and the ones which are no longer valid
+# are dropped.
+persist = {{ .Mempool.Persist }}
 `
 
 var configTemplate *template.Template
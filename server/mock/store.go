@@ -142,6 +142,22 @@ func (ms multiStore) SetLazyLoading(bool) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetRestoreConcurrency(int) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetAsyncPruning(bool) {
+	panic("not implemented")
+}
+
+func (ms multiStore) Close() error {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetVersionStore(storetypes.VersionStore) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
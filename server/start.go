@@ -53,15 +53,20 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningAsync        = "pruning-async"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagGasAttribution      = "gas-attribution"
+	FlagParallelExecution   = "parallel-execution-workers"
 
 	// state sync-related flags
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval    = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent  = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotCompression = "state-sync.snapshot-compression"
+	FlagStateSyncRestoreConcurrency  = "state-sync.restore-concurrency"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -80,7 +85,8 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs  = "mempool.max-txs"
+	FlagMempoolPersist = "mempool.persist"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -165,6 +171,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(FlagPruningAsync, false, "Prune the heights in a background worker instead of during the block commit")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -181,8 +188,13 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().String(FlagStateSyncSnapshotCompression, "zlib", "State sync snapshot compression (zlib|zstd|none)")
+	cmd.Flags().Int(FlagStateSyncRestoreConcurrency, 1, "Maximum number of stores imported concurrently while restoring a state sync snapshot")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagGasAttribution, false, "Attribute the gas consumed by the delivered transactions to the stores and operations in the telemetry")
+	cmd.Flags().Int(FlagParallelExecution, 0, "Number of workers executing the transactions of the blocks optimistically in parallel (0 executes them serially)")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Bool(FlagMempoolPersist, false, "Persist the transactions of the app-side mempool and replay them on restart")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -251,7 +263,11 @@ func startStandAlone(svrCtx *Context, appCreator types.AppCreator) error {
 		return svr.Stop()
 	})
 
-	return g.Wait()
+	err = g.Wait()
+	if cerr := app.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	return err
 }
 
 func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
@@ -453,6 +469,11 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 			_ = tmNode.Stop()
 		}
 
+		// the app is closed once CometBFT stopped committing blocks
+		if err := app.Close(); err != nil {
+			svrCtx.Logger.Error("failed to close the application", "err", err)
+		}
+
 		if traceWriterCleanup != nil {
 			traceWriterCleanup()
 		}
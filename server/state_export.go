@@ -0,0 +1,134 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/snapshots"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// snapshotManagerApp is implemented by the applications which expose the snapshot manager,
+// e.g. the ones built on BaseApp.
+type snapshotManagerApp interface {
+	SnapshotManager() *snapshots.Manager
+}
+
+// ExportStateCmd creates a command to export the state of every store of the multistore to
+// a directory, in the binary snapshot format.
+func ExportStateCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state [dir]",
+		Short: "Export the state of the application stores to a directory",
+		Long: `Export the state of every store of the application to a directory, independently of
+the genesis JSON. The state is written in the binary format of the state sync snapshots, and
+can be copied to another node to be imported with the import-state command.
+
+The state of the latest height is exported, unless another height, which must not be pruned,
+is provided with --height.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			defer app.Close()
+
+			manager, err := appSnapshotManager(app)
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+			if height <= 0 {
+				return fmt.Errorf("invalid height %d, the application has no committed state", height)
+			}
+
+			exported, err := manager.ExportState(uint64(height), args[0])
+			if err != nil {
+				return fmt.Errorf("failed to export state: %w", err)
+			}
+
+			cmd.Printf("Exported state of height %d in %d chunks to %s\n", exported.Height, exported.Chunks, args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Export the state of this height, 0 for the latest height")
+	return cmd
+}
+
+// ImportStateCmd creates a command to import a state exported with ExportStateCmd into the
+// empty stores of the application.
+func ImportStateCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-state [dir]",
+		Short: "Import a state exported with export-state into the application stores",
+		Long: `Import a state exported with the export-state command into the stores of the
+application, which must be empty. This bootstraps a node without replaying the chain or
+importing the genesis JSON.
+
+The state is imported at the exported height, unless a higher height is provided with
+--height, e.g. to start a fork or a testnet. Once imported, the genesis of CometBFT must
+have an initial_height of the imported height + 1 and the app_hash printed by the command.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			defer app.Close()
+
+			manager, err := appSnapshotManager(app)
+			if err != nil {
+				return err
+			}
+
+			if version := app.CommitMultiStore().LastCommitID().Version; version != 0 {
+				return fmt.Errorf("cannot import state, the application already has the state of height %d", version)
+			}
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height < 0 {
+				return fmt.Errorf("invalid height %d", height)
+			}
+
+			imported, err := manager.ImportState(args[0], uint64(height))
+			if err != nil {
+				return fmt.Errorf("failed to import state: %w", err)
+			}
+
+			commitID := app.CommitMultiStore().LastCommitID()
+			cmd.Printf("Imported state at height %d with app hash %X\n", imported.Height, commitID.Hash)
+			cmd.Printf("Set initial_height to %d and app_hash to %X in the genesis file before starting the node\n",
+				imported.Height+1, commitID.Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Import the state at this height, 0 for the exported height")
+	return cmd
+}
+
+// appSnapshotManager returns the snapshot manager of the application.
+func appSnapshotManager(app types.Application) (*snapshots.Manager, error) {
+	snapshotApp, ok := app.(snapshotManagerApp)
+	if !ok || snapshotApp.SnapshotManager() == nil {
+		return nil, fmt.Errorf("the application has no snapshot manager")
+	}
+	return snapshotApp.SnapshotManager(), nil
+}
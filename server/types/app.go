@@ -54,6 +54,9 @@ type (
 
 		// CommitMultiStore return the multistore instance
 		CommitMultiStore() storetypes.CommitMultiStore
+
+		// Close is called in start cmd to gracefully cleanup resources.
+		Close() error
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -315,6 +315,8 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		startCmd,
 		cometCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		ExportStateCmd(appCreator, defaultNodeHome),
+		ImportStateCmd(appCreator, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
 	)
@@ -460,8 +462,12 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.Compression, err = snapshottypes.ParseCompression(cast.ToString(appOpts.Get(FlagStateSyncSnapshotCompression)))
+	if err != nil {
+		panic(err)
+	}
 
-	return []func(*baseapp.BaseApp){
+	opts := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -471,6 +477,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
+		baseapp.SetSnapshotRestoreConcurrency(cast.ToInt(appOpts.Get(FlagStateSyncRestoreConcurrency))),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
 		baseapp.SetMempool(
@@ -479,5 +486,19 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 			),
 		),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
+		baseapp.SetGasAttribution(cast.ToBool(appOpts.Get(FlagGasAttribution))),
+		baseapp.SetAsyncPruning(cast.ToBool(appOpts.Get(FlagPruningAsync))),
+		baseapp.SetParallelExecution(cast.ToInt(appOpts.Get(FlagParallelExecution))),
 	}
+
+	if cast.ToBool(appOpts.Get(FlagMempoolPersist)) {
+		dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+		mempoolDB, err := dbm.NewDB("mempool", GetAppDBBackend(appOpts), dataDir)
+		if err != nil {
+			panic(err)
+		}
+		opts = append(opts, baseapp.SetPersistentMempool(mempoolDB))
+	}
+
+	return opts
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/x/evidence"
+	"cosmossdk.io/x/tokenfactory"
 	"cosmossdk.io/x/upgrade"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -18,6 +19,7 @@ import (
 	"cosmossdk.io/log"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -198,6 +200,7 @@ func TestRunMigrations(t *testing.T) {
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactory.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -272,6 +275,30 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
 
+func TestBalanceHistory(t *testing.T) {
+	appOpts := simtestutil.AppOptionsMap{
+		flags.FlagHome:          t.TempDir(),
+		bank.FlagBalanceHistory: true,
+	}
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:  log.NewTestLogger(t),
+		DB:      dbm.NewMemDB(),
+		AppOpts: appOpts,
+	})
+
+	// the genesis balances and supplies are committed with the first block
+	app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: app.LastBlockHeight() + 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: app.LastBlockHeight() + 1})
+	app.Commit()
+
+	ctx := app.NewContext(true, cmtproto.Header{})
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	res, err := app.BankKeeper.SupplyHistory(ctx, &banktypes.QuerySupplyHistoryRequest{Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Equal(t, []banktypes.AmountChange{{Height: 1, Amount: supply.Amount}}, res.Changes)
+}
+
 // TestMergedRegistry tests that fetching the gogo/protov2 merged registry
 // doesn't fail after loading all file descriptors.
 func TestMergedRegistry(t *testing.T) {
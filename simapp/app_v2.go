@@ -13,11 +13,18 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/x/circuit"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
 	"cosmossdk.io/x/evidence"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	nftkeeper "cosmossdk.io/x/nft/keeper"
 	nftmodule "cosmossdk.io/x/nft/module"
+	"cosmossdk.io/x/tokenfactory"
+	tokenfactorykeeper "cosmossdk.io/x/tokenfactory/keeper"
+	tokenfactorytypes "cosmossdk.io/x/tokenfactory/types"
 
 	storetypes "cosmossdk.io/store/types"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
@@ -37,6 +44,7 @@ import (
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import for side-effects
@@ -46,6 +54,7 @@ import (
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consensus "github.com/cosmos/cosmos-sdk/x/consensus"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -101,6 +110,8 @@ var (
 		vesting.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
 		consensus.AppModuleBasic{},
+		circuit.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
 	)
 )
 
@@ -137,6 +148,8 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	CircuitKeeper         circuitkeeper.Keeper
+	TokenFactoryKeeper    tokenfactorykeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -163,6 +176,8 @@ func NewSimApp(
 	var (
 		app        = &SimApp{}
 		appBuilder *runtime.AppBuilder
+		// the concrete bank keeper provides the balance overrides of the simulations
+		bankKeeper bankkeeper.BaseKeeper
 
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
@@ -206,6 +221,7 @@ func NewSimApp(
 		&app.autoCliOpts,
 		&app.AccountKeeper,
 		&app.BankKeeper,
+		&bankKeeper,
 		&app.StakingKeeper,
 		&app.SlashingKeeper,
 		&app.MintKeeper,
@@ -220,6 +236,8 @@ func NewSimApp(
 		&app.GroupKeeper,
 		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.CircuitKeeper,
+		&app.TokenFactoryKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -256,8 +274,15 @@ func NewSimApp(
 		panic(fmt.Errorf("failed to load state streaming: %w", err))
 	}
 
+	if err := setBalanceHistory(app.App.BaseApp, appOpts, app.BankKeeper, app.GetKey(banktypes.StoreKey)); err != nil {
+		panic(fmt.Errorf("failed to load the balance history: %w", err))
+	}
+
 	/****  Module Options ****/
 
+	// set the ante handler of SimApp, including the circuit breaker
+	app.setAnteHandler(app.txConfig)
+
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
 
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
@@ -277,6 +302,9 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// apply the sequence and balance overrides of the simulations
+	app.SetStateOverrideHandlers(app.AccountKeeper.StateOverrideHandler(), bankKeeper.StateOverrideHandler())
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
@@ -319,6 +347,28 @@ func (app *SimApp) InterfaceRegistry() codectypes.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// setAnteHandler sets the ante handler of SimApp, i.e. the default SDK ante
+// handler with a circuit breaker.
+func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: txConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			CircuitKeeper: &app.CircuitKeeper,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	app.SetAnteHandler(anteHandler)
+}
+
 // TxConfig returns SimApp's TxConfig
 func (app *SimApp) TxConfig() client.TxConfig {
 	return app.txConfig
@@ -341,6 +391,21 @@ func (app *SimApp) GetKey(storeKey string) *storetypes.KVStoreKey {
 	return kvStoreKey
 }
 
+// CollectionsSchemas returns the collections schemas of the modules by store name,
+// they are used by the debug state-diff command to decode the store entries.
+func (app *SimApp) CollectionsSchemas() (map[string]collections.Schema, error) {
+	bankKeeper, ok := app.BankKeeper.(bankkeeper.BaseKeeper)
+	if !ok {
+		return nil, fmt.Errorf("unexpected bank keeper type %T", app.BankKeeper)
+	}
+
+	return map[string]collections.Schema{
+		banktypes.StoreKey:         bankKeeper.Schema,
+		circuittypes.StoreKey:      app.CircuitKeeper.Schema,
+		tokenfactorytypes.StoreKey: app.TokenFactoryKeeper.Schema,
+	}, nil
+}
+
 func (app *SimApp) kvStoreKeys() map[string]*storetypes.KVStoreKey {
 	keys := make(map[string]*storetypes.KVStoreKey)
 	for _, k := range app.GetStoreKeys() {
@@ -0,0 +1,46 @@
+package simapp
+
+import (
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+)
+
+// setBalanceHistory enables the balance history index of the bank keeper if the
+// x-bank-balance-history flag is set. The index is stored in data/bank_history of
+// the node home, in a db of the app-db-backend, and fed with the state changes of
+// the bank store by a streaming service. It must be called before the stores are
+// loaded.
+func setBalanceHistory(app *baseapp.BaseApp, appOpts servertypes.AppOptions, bankKeeper bankkeeper.Keeper, storeKey storetypes.StoreKey) error {
+	if !cast.ToBool(appOpts.Get(bank.FlagBalanceHistory)) {
+		return nil
+	}
+
+	baseKeeper, ok := bankKeeper.(bankkeeper.BaseKeeper)
+	if !ok {
+		return fmt.Errorf("the balance history requires a bank BaseKeeper, got %T", bankKeeper)
+	}
+
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB("bank_history", server.GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return err
+	}
+
+	history := bankkeeper.NewBalanceHistory(db, storeKey, app.Logger())
+	baseKeeper.SetBalanceHistory(history)
+	app.AddStreamingService(bankkeeper.NewBalanceHistoryStreamingService(history, storeKey))
+
+	return nil
+}
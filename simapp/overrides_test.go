@@ -0,0 +1,71 @@
+package simapp
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSimulateWithOverrides(t *testing.T) {
+	app := Setup(t, false)
+	txConfig := app.TxConfig()
+
+	key := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(key.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the sender does not exist
+	msg := banktypes.NewMsgSend(addr, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	tx, err := simtestutil.GenSignedMockTx(rand.New(rand.NewSource(1)), txConfig, []sdk.Msg{msg}, sdk.Coins{},
+		simtestutil.DefaultGenTxGas, "", []uint64{0}, []uint64{5}, key)
+	require.NoError(t, err)
+	txBytes, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	_, _, err = app.SimulateWithOverrides(txBytes, nil)
+	require.Error(t, err)
+
+	overrides := &txtypes.StateOverrides{
+		Sequences: []txtypes.SequenceOverride{{Address: addr.String(), Sequence: 5}},
+		Balances: []txtypes.BalanceOverride{{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		}},
+	}
+	gasInfo, result, err := app.SimulateWithOverrides(txBytes, overrides)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotZero(t, gasInfo.GasUsed)
+
+	// the overrides are not applied to the CheckTx state
+	ctx := app.NewContext(true, cmtproto.Header{})
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, addr))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+
+	// the store overrides are applied before the module ones
+	acc := authtypes.NewBaseAccount(addr, nil, 100, 5)
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.NoError(t, err)
+	overrides.Stores = []txtypes.StoreOverride{{StoreName: authtypes.StoreKey, Key: authtypes.AddressStoreKey(addr), Value: bz}}
+	overrides.Sequences = nil
+	_, _, err = app.SimulateWithOverrides(txBytes, overrides)
+	require.NoError(t, err)
+
+	overrides.Stores[0].Delete = true
+	_, _, err = app.SimulateWithOverrides(txBytes, overrides)
+	require.Error(t, err, "the account created by the balance override has another sequence")
+
+	// the store must exist
+	overrides.Stores = []txtypes.StoreOverride{{StoreName: "unknown", Key: []byte{1}, Value: []byte{1}}}
+	_, _, err = app.SimulateWithOverrides(txBytes, overrides)
+	require.Error(t, err)
+}
@@ -0,0 +1,264 @@
+package simapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const parallelTestChainID = "parallel-test-chain"
+
+type parallelTestAccount struct {
+	key      *secp256k1.PrivKey
+	address  sdk.AccAddress
+	number   uint64
+	sequence uint64
+}
+
+// setupParallelTestApps returns a SimApp executing the transactions serially and one
+// executing them in parallel, both at the same genesis state.
+func setupParallelTestApps(t *testing.T, accounts []*parallelTestAccount) (serial, parallel *SimApp) {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	genAccs := make([]authtypes.GenesisAccount, len(accounts))
+	balances := make([]banktypes.Balance, len(accounts))
+	for i, acc := range accounts {
+		genAccs[i] = authtypes.NewBaseAccount(acc.address, acc.key.PubKey(), acc.number, 0)
+		balances[i] = banktypes.Balance{
+			Address: acc.address.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000))),
+		}
+	}
+
+	// the blocks include invalid transactions
+	acceptProposals := func(app *baseapp.BaseApp) { app.SetProcessProposal(baseapp.NoOpProcessProposal()) }
+
+	newApp := func(options ...func(*baseapp.BaseApp)) *SimApp {
+		options = append(options, acceptProposals)
+		app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), options...)
+
+		genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, genAccs, balances...)
+		require.NoError(t, err)
+		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+		require.NoError(t, err)
+
+		app.InitChain(abci.RequestInitChain{
+			ChainId:         parallelTestChainID,
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		})
+		app.Commit()
+		return app
+	}
+
+	return newApp(), newApp(baseapp.SetParallelExecution(4))
+}
+
+// deliverBlock delivers the transactions as a block accepted by ProcessProposal.
+func deliverBlock(t *testing.T, app *SimApp, height int64, txs [][]byte) ([]abci.ResponseDeliverTx, []byte) {
+	t.Helper()
+
+	hash := []byte(fmt.Sprintf("block-%d", height))
+	blockTime := time.Date(2023, 1, 1, 0, 0, int(height), 0, time.UTC)
+
+	res := app.ProcessProposal(abci.RequestProcessProposal{Txs: txs, Height: height, Hash: hash, Time: blockTime})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	app.BeginBlock(abci.RequestBeginBlock{
+		Hash:   hash,
+		Header: cmtproto.Header{ChainID: parallelTestChainID, Height: height, Time: blockTime},
+	})
+
+	responses := make([]abci.ResponseDeliverTx, len(txs))
+	for i, tx := range txs {
+		responses[i] = app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+	}
+
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	return responses, app.Commit().Data
+}
+
+func TestParallelExecutionDeterminism(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	accounts := make([]*parallelTestAccount, 12)
+	for i := range accounts {
+		key := secp256k1.GenPrivKey()
+		accounts[i] = &parallelTestAccount{key: key, address: sdk.AccAddress(key.PubKey().Address()), number: uint64(i)}
+	}
+	serial, parallel := setupParallelTestApps(t, accounts)
+
+	txConfig := serial.TxConfig()
+	send := func(from, to *parallelTestAccount, amount int64, sequence uint64) []byte {
+		msg := banktypes.NewMsgSend(from.address, to.address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{msg}, sdk.Coins{}, simtestutil.DefaultGenTxGas,
+			parallelTestChainID, []uint64{from.number}, []uint64{sequence}, from.key)
+		require.NoError(t, err)
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	nextSend := func(from, to *parallelTestAccount, amount int64) []byte {
+		from.sequence++
+		return send(from, to, amount, from.sequence-1)
+	}
+
+	succeeded := func(codes ...bool) func(*testing.T, []abci.ResponseDeliverTx) {
+		return func(t *testing.T, responses []abci.ResponseDeliverTx) {
+			for i, res := range responses {
+				require.Equal(t, codes[i], res.IsOK(), "transaction %d: %s", i, res.Log)
+			}
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		txs    func() [][]byte
+		verify func(*testing.T, []abci.ResponseDeliverTx)
+	}{
+		{
+			"independent transactions",
+			func() [][]byte {
+				var txs [][]byte
+				for i := 0; i < 6; i++ {
+					txs = append(txs, nextSend(accounts[i], accounts[i+6], 1000))
+				}
+				return txs
+			},
+			succeeded(true, true, true, true, true, true),
+		},
+		{
+			"transactions of the same sender",
+			func() [][]byte {
+				var txs [][]byte
+				for i := 0; i < 5; i++ {
+					txs = append(txs, nextSend(accounts[0], accounts[i+1], 1000))
+				}
+				return txs
+			},
+			succeeded(true, true, true, true, true),
+		},
+		{
+			"transactions to the same recipient",
+			func() [][]byte {
+				var txs [][]byte
+				for i := 1; i < 8; i++ {
+					txs = append(txs, nextSend(accounts[i], accounts[0], int64(100*i)))
+				}
+				return txs
+			},
+			succeeded(true, true, true, true, true, true, true),
+		},
+		{
+			"chained transfers",
+			func() [][]byte {
+				// every transfer spends the funds received by the previous one
+				return [][]byte{
+					nextSend(accounts[11], accounts[10], 100000000),
+					nextSend(accounts[10], accounts[9], 150000000),
+					nextSend(accounts[9], accounts[8], 200000000),
+				}
+			},
+			succeeded(true, true, true),
+		},
+		{
+			"failing transactions",
+			func() [][]byte {
+				return [][]byte{
+					// wrong sequence
+					send(accounts[2], accounts[3], 1000, accounts[2].sequence+1),
+					// insufficient funds
+					nextSend(accounts[4], accounts[5], 1000000000),
+					// duplicated transaction, the second one has a used sequence
+					send(accounts[6], accounts[7], 1000, accounts[6].sequence),
+					nextSend(accounts[6], accounts[7], 1000),
+					// invalid transaction
+					[]byte("invalid"),
+					nextSend(accounts[7], accounts[6], 1000),
+				}
+			},
+			succeeded(false, false, true, false, false, true),
+		},
+		{
+			"mixed transactions",
+			func() [][]byte {
+				var txs [][]byte
+				for i := 0; i < 30; i++ {
+					from, to := accounts[r.Intn(len(accounts))], accounts[r.Intn(len(accounts))]
+					txs = append(txs, nextSend(from, to, r.Int63n(10000)+1))
+				}
+				return txs
+			},
+			nil,
+		},
+	}
+
+	height := serial.LastBlockHeight()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			height++
+			txs := tc.txs()
+
+			serialResponses, serialHash := deliverBlock(t, serial, height, txs)
+			parallelResponses, parallelHash := deliverBlock(t, parallel, height, txs)
+
+			require.Equal(t, serialResponses, parallelResponses)
+			require.Equal(t, serialHash, parallelHash)
+			if tc.verify != nil {
+				tc.verify(t, parallelResponses)
+			}
+		})
+	}
+}
+
+func TestParallelExecutionWithoutProposal(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	accounts := []*parallelTestAccount{{key: key, address: sdk.AccAddress(key.PubKey().Address())}}
+	serial, parallel := setupParallelTestApps(t, accounts)
+
+	txConfig := serial.TxConfig()
+	msg := banktypes.NewMsgSend(accounts[0].address, accounts[0].address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	tx, err := simtestutil.GenSignedMockTx(rand.New(rand.NewSource(1)), txConfig, []sdk.Msg{msg}, sdk.Coins{},
+		simtestutil.DefaultGenTxGas, parallelTestChainID, []uint64{0}, []uint64{0}, key)
+	require.NoError(t, err)
+	txBytes, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	serialResponses, serialHash := deliverBlock(t, serial, 2, [][]byte{txBytes})
+
+	// the block committed is not the accepted proposal, its transactions are executed serially
+	height, hash := int64(2), []byte("block-2")
+	res := parallel.ProcessProposal(abci.RequestProcessProposal{Txs: [][]byte{txBytes}, Height: height, Hash: []byte("other")})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+	parallel.BeginBlock(abci.RequestBeginBlock{
+		Hash:   hash,
+		Header: cmtproto.Header{ChainID: parallelTestChainID, Height: height, Time: time.Date(2023, 1, 1, 0, 0, 2, 0, time.UTC)},
+	})
+	require.Equal(t, serialResponses[0], parallel.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}))
+	parallel.EndBlock(abci.RequestEndBlock{Height: height})
+	require.Equal(t, serialHash, parallel.Commit().Data)
+}
@@ -0,0 +1,102 @@
+package simapp
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTraceTx(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	accounts := make([]*parallelTestAccount, 3)
+	for i := range accounts {
+		key := secp256k1.GenPrivKey()
+		accounts[i] = &parallelTestAccount{key: key, address: sdk.AccAddress(key.PubKey().Address()), number: uint64(i)}
+	}
+	app, _ := setupParallelTestApps(t, accounts)
+
+	txConfig := app.TxConfig()
+	send := func(from, to *parallelTestAccount, amount int64) []byte {
+		msg := banktypes.NewMsgSend(from.address, to.address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{msg}, sdk.Coins{}, simtestutil.DefaultGenTxGas,
+			parallelTestChainID, []uint64{from.number}, []uint64{from.sequence}, from.key)
+		require.NoError(t, err)
+		from.sequence++
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the second transaction spends the funds received from the first one
+	height := app.LastBlockHeight() + 1
+	txs := [][]byte{send(accounts[0], accounts[1], 1000), send(accounts[1], accounts[2], 100000500)}
+	responses, _ := deliverBlock(t, app, height, txs)
+	require.True(t, responses[1].IsOK(), responses[1].Log)
+
+	beginBlock := &abci.RequestBeginBlock{
+		Hash:   []byte(fmt.Sprintf("block-%d", height)),
+		Header: cmtproto.Header{ChainID: parallelTestChainID, Height: height, Time: time.Date(2023, 1, 1, 0, 0, int(height), 0, time.UTC)},
+	}
+	trace, err := app.TraceTx(txs[1], beginBlock, txs[:1])
+	require.NoError(t, err)
+	require.Empty(t, trace.Error)
+	require.Equal(t, height, trace.Height)
+	require.Equal(t, uint64(responses[1].GasUsed), trace.GasInfo.GasUsed)
+
+	// DeliverTx marks the events to index
+	indexAll := map[string]struct{}{}
+	require.Equal(t, responses[1].Events, sdk.MarkEventsToIndex(trace.Result.Events, indexAll))
+	require.NotEmpty(t, trace.GasInfo.StoreGas)
+
+	require.Len(t, trace.Steps, 2)
+	ante, msg := trace.Steps[0], trace.Steps[1]
+	require.Equal(t, txtypes.TraceStepType_TRACE_STEP_TYPE_ANTE, ante.StepType)
+	require.Equal(t, txtypes.TraceStepType_TRACE_STEP_TYPE_MSG, msg.StepType)
+	require.Equal(t, uint32(0), msg.MsgIndex)
+	require.Equal(t, sdk.MsgTypeURL(&banktypes.MsgSend{}), msg.MsgTypeUrl)
+	require.Equal(t, ante.GasConsumed+msg.GasUsed, msg.GasConsumed)
+	require.Equal(t, trace.GasInfo.GasUsed, msg.GasConsumed)
+
+	// the events of the steps are the events of the transaction
+	require.Equal(t, responses[1].Events, sdk.MarkEventsToIndex(append(ante.Events, msg.Events...), indexAll))
+
+	// the balances of the sender and the recipient are written by the message
+	var bankWrites int
+	for _, op := range msg.Operations {
+		if op.StoreName == banktypes.StoreKey && op.Operation == "write" {
+			bankWrites++
+		}
+	}
+	require.Equal(t, 2, bankWrites)
+
+	// without the first transaction of the block, the sender has not the funds
+	trace, err = app.TraceTx(txs[1], beginBlock, nil)
+	require.NoError(t, err)
+	require.Contains(t, trace.Error, "insufficient funds")
+	require.Nil(t, trace.Result)
+	require.Len(t, trace.Steps, 2)
+	require.Equal(t, trace.GasInfo.GasUsed, trace.Steps[1].GasConsumed)
+
+	// a transaction which is not committed is simulated on the latest state
+	trace, err = app.TraceTx(send(accounts[2], accounts[0], 1000), nil, nil)
+	require.NoError(t, err)
+	require.Empty(t, trace.Error)
+	require.Zero(t, trace.Height)
+	require.Len(t, trace.Steps, 2)
+
+	// the state of the block must exist
+	_, err = app.TraceTx(txs[1], &abci.RequestBeginBlock{Header: cmtproto.Header{ChainID: parallelTestChainID, Height: height + 10}}, txs[:1])
+	require.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package cachekv
+
+import (
+	"bytes"
+	"sync"
+
+	"cosmossdk.io/store/types"
+)
+
+// readSet records the values a Store reads from its parent: the values of the keys
+// missing from its cache, and the pairs yielded by the iterators of the parent. A
+// branch executed against a parent is still valid once the parent changed as long
+// as the parent holds the same values.
+type readSet struct {
+	mtx        sync.Mutex
+	gets       map[string][]byte
+	iterations []*iterationRead
+}
+
+// iterationRead is the record of an iterator of the parent, the pairs it yielded and
+// whether it was exhausted.
+type iterationRead struct {
+	start, end   []byte
+	ascending    bool
+	keys, values [][]byte
+	exhausted    bool
+}
+
+func newReadSet() *readSet {
+	return &readSet{gets: make(map[string][]byte)}
+}
+
+// recordGet records the value read from the parent for the key, the first read of
+// a key is the one the store depends on.
+func (rs *readSet) recordGet(key, value []byte) {
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+
+	if _, ok := rs.gets[string(key)]; !ok {
+		rs.gets[string(key)] = value
+	}
+}
+
+// recordIterator returns the parent iterator recording the pairs it yields.
+func (rs *readSet) recordIterator(parent types.Iterator, start, end []byte, ascending bool) types.Iterator {
+	read := &iterationRead{
+		start:     bytes.Clone(start),
+		end:       bytes.Clone(end),
+		ascending: ascending,
+	}
+
+	rs.mtx.Lock()
+	rs.iterations = append(rs.iterations, read)
+	rs.mtx.Unlock()
+
+	iter := &trackingIterator{Iterator: parent, read: read}
+	iter.record()
+	return iter
+}
+
+// validate returns true if the parent still holds the values of the read set.
+func (rs *readSet) validate(parent types.KVStore) bool {
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+
+	for key, value := range rs.gets {
+		if !equalValues(parent.Get([]byte(key)), value) {
+			return false
+		}
+	}
+
+	for _, read := range rs.iterations {
+		if !read.validate(parent) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validate returns true if an iterator of the parent over the same domain yields
+// the recorded pairs, and is exhausted after them if the recorded one was.
+func (read *iterationRead) validate(parent types.KVStore) bool {
+	var iter types.Iterator
+	if read.ascending {
+		iter = parent.Iterator(read.start, read.end)
+	} else {
+		iter = parent.ReverseIterator(read.start, read.end)
+	}
+	defer iter.Close()
+
+	for i, key := range read.keys {
+		if !iter.Valid() || !bytes.Equal(iter.Key(), key) || !equalValues(iter.Value(), read.values[i]) {
+			return false
+		}
+		iter.Next()
+	}
+
+	return !read.exhausted || !iter.Valid()
+}
+
+// equalValues compares two values, telling apart a missing value from an empty one.
+func equalValues(a, b []byte) bool {
+	return (a == nil) == (b == nil) && bytes.Equal(a, b)
+}
+
+// trackingIterator records every pair of the parent it is positioned on.
+type trackingIterator struct {
+	types.Iterator
+	read *iterationRead
+}
+
+// Next implements types.Iterator.
+func (it *trackingIterator) Next() {
+	it.Iterator.Next()
+	it.record()
+}
+
+func (it *trackingIterator) record() {
+	if !it.Iterator.Valid() {
+		it.read.exhausted = true
+		return
+	}
+
+	it.read.keys = append(it.read.keys, bytes.Clone(it.Iterator.Key()))
+	it.read.values = append(it.read.values, bytes.Clone(it.Iterator.Value()))
+}
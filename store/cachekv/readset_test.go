@@ -0,0 +1,148 @@
+package cachekv_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+func TestTrackingStore_ValidateReads(t *testing.T) {
+	testCases := []struct {
+		name   string
+		read   func(st *cachekv.Store)
+		change func(parent *cachekv.Store)
+		valid  bool
+	}{
+		{
+			"unchanged read",
+			func(st *cachekv.Store) { st.Get(keyFmt(1)) },
+			func(parent *cachekv.Store) { parent.Set(keyFmt(2), valFmt(3)) },
+			true,
+		},
+		{
+			"changed read",
+			func(st *cachekv.Store) { st.Get(keyFmt(1)) },
+			func(parent *cachekv.Store) { parent.Set(keyFmt(1), valFmt(3)) },
+			false,
+		},
+		{
+			"deleted read",
+			func(st *cachekv.Store) { st.Has(keyFmt(1)) },
+			func(parent *cachekv.Store) { parent.Delete(keyFmt(1)) },
+			false,
+		},
+		{
+			"created read",
+			func(st *cachekv.Store) { st.Get(keyFmt(5)) },
+			func(parent *cachekv.Store) { parent.Set(keyFmt(5), []byte{}) },
+			false,
+		},
+		{
+			"same value written",
+			func(st *cachekv.Store) { st.Get(keyFmt(1)) },
+			func(parent *cachekv.Store) { parent.Set(keyFmt(1), valFmt(1)) },
+			true,
+		},
+		{
+			"key written before being read",
+			func(st *cachekv.Store) {
+				st.Set(keyFmt(1), valFmt(4))
+				st.Get(keyFmt(1))
+			},
+			func(parent *cachekv.Store) { parent.Set(keyFmt(1), valFmt(3)) },
+			true,
+		},
+		{
+			"iterated pair changed",
+			func(st *cachekv.Store) { collectKeys(st, keyFmt(0), keyFmt(3), true) },
+			func(parent *cachekv.Store) { parent.Set(keyFmt(2), valFmt(3)) },
+			false,
+		},
+		{
+			"key inserted in the iterated domain",
+			func(st *cachekv.Store) { collectKeys(st, nil, nil, false) },
+			func(parent *cachekv.Store) { parent.Set(keyFmt(4), valFmt(4)) },
+			false,
+		},
+		{
+			"key deleted in the iterated domain",
+			func(st *cachekv.Store) { collectKeys(st, keyFmt(0), keyFmt(3), true) },
+			func(parent *cachekv.Store) { parent.Delete(keyFmt(1)) },
+			false,
+		},
+		{
+			"change out of the iterated domain",
+			func(st *cachekv.Store) { collectKeys(st, keyFmt(0), keyFmt(3), true) },
+			func(parent *cachekv.Store) { parent.Set(keyFmt(3), valFmt(3)) },
+			true,
+		},
+		{
+			"change after the iteration stopped",
+			func(st *cachekv.Store) {
+				iter := st.Iterator(nil, nil)
+				defer iter.Close()
+				require.Equal(t, keyFmt(1), iter.Key())
+			},
+			func(parent *cachekv.Store) { parent.Set(keyFmt(4), valFmt(4)) },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+			parent.Set(keyFmt(1), valFmt(1))
+			parent.Set(keyFmt(2), valFmt(2))
+
+			st := cachekv.NewTrackingStore(parent)
+			tc.read(st)
+			require.True(t, st.ValidateReads())
+
+			tc.change(parent)
+			require.Equal(t, tc.valid, st.ValidateReads())
+		})
+	}
+
+	// a store not tracking its reads is always valid
+	parent := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	st := cachekv.NewStore(parent)
+	st.Get(keyFmt(1))
+	parent.Set(keyFmt(1), valFmt(1))
+	require.True(t, st.ValidateReads())
+}
+
+func TestTrackingStore_Write(t *testing.T) {
+	parent := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	parent.Set(keyFmt(1), valFmt(1))
+
+	st := cachekv.NewTrackingStore(parent)
+	st.Get(keyFmt(1))
+	st.Set(keyFmt(2), valFmt(2))
+	st.Delete(keyFmt(1))
+	require.True(t, st.ValidateReads())
+
+	st.Write()
+	require.Nil(t, parent.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), parent.Get(keyFmt(2)))
+}
+
+func collectKeys(st *cachekv.Store, start, end []byte, ascending bool) [][]byte {
+	var iter types.Iterator
+	if ascending {
+		iter = st.Iterator(start, end)
+	} else {
+		iter = st.ReverseIterator(start, end)
+	}
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
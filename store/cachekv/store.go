@@ -30,6 +30,7 @@ type Store struct {
 	unsortedCache map[string]struct{}
 	sortedCache   internal.BTree // always ascending sorted
 	parent        types.KVStore
+	reads         *readSet // nil unless the store tracks its reads
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
@@ -44,6 +45,14 @@ func NewStore(parent types.KVStore) *Store {
 	}
 }
 
+// NewTrackingStore creates a new Store object recording the values it reads from
+// the parent, see ValidateReads.
+func NewTrackingStore(parent types.KVStore) *Store {
+	store := NewStore(parent)
+	store.reads = newReadSet()
+	return store
+}
+
 // GetStoreType implements Store.
 func (store *Store) GetStoreType() types.StoreType {
 	return store.parent.GetStoreType()
@@ -60,6 +69,9 @@ func (store *Store) Get(key []byte) (value []byte) {
 	if !ok {
 		value = store.parent.Get(key)
 		store.setCacheValue(key, value, false)
+		if store.reads != nil {
+			store.reads.recordGet(key, value)
+		}
 	} else {
 		value = cacheValue.value
 	}
@@ -143,6 +155,17 @@ func (store *Store) Write() {
 	store.sortedCache = internal.NewBTree()
 }
 
+// ValidateReads returns true if the parent still holds the values the store read from
+// it, i.e. if writing the store leaves the parent as if the operations of the store
+// were executed against the current state of the parent. It always returns true if
+// the store does not track its reads.
+func (store *Store) ValidateReads() bool {
+	if store.reads == nil {
+		return true
+	}
+	return store.reads.validate(store.parent)
+}
+
 // CacheWrap implements CacheWrapper.
 func (store *Store) CacheWrap() types.CacheWrap {
 	return NewStore(store)
@@ -188,6 +211,9 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 	if err != nil {
 		panic(err)
 	}
+	if store.reads != nil {
+		parent = store.reads.recordIterator(parent, start, end, ascending)
+	}
 
 	return internal.NewCacheMergeIterator(parent, cache, ascending)
 }
@@ -32,7 +32,11 @@ type Store struct {
 	traceContext types.TraceContext
 }
 
-var _ types.CacheMultiStore = Store{}
+var (
+	_ types.CacheMultiStore            = Store{}
+	_ types.ReadTrackingMultiStore     = Store{}
+	_ types.ReadTrackedCacheMultiStore = Store{}
+)
 
 // NewFromKVStore creates a new Store object from a mapping of store keys to
 // CacheWrapper objects and a KVStore as the database. Each CacheWrapper store
@@ -40,9 +44,17 @@ var _ types.CacheMultiStore = Store{}
 func NewFromKVStore(
 	store types.KVStore, stores map[types.StoreKey]types.CacheWrapper,
 	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
+) Store {
+	return newFromKVStore(store, stores, keys, traceWriter, traceContext, cachekv.NewStore)
+}
+
+func newFromKVStore(
+	store types.KVStore, stores map[types.StoreKey]types.CacheWrapper,
+	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
+	newStore func(types.KVStore) *cachekv.Store,
 ) Store {
 	cms := Store{
-		db:           cachekv.NewStore(store),
+		db:           newStore(store),
 		stores:       make(map[types.StoreKey]types.CacheWrap, len(stores)),
 		keys:         keys,
 		traceWriter:  traceWriter,
@@ -57,7 +69,7 @@ func NewFromKVStore(
 
 			store = tracekv.NewStore(store.(types.KVStore), cms.traceWriter, tctx)
 		}
-		cms.stores[key] = cachekv.NewStore(store.(types.KVStore))
+		cms.stores[key] = newStore(store.(types.KVStore))
 	}
 
 	return cms
@@ -72,13 +84,13 @@ func NewStore(
 	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
 }
 
-func newCacheMultiStoreFromCMS(cms Store) Store {
+func newCacheMultiStoreFromCMS(cms Store, newStore func(types.KVStore) *cachekv.Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
 		stores[k] = v
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+	return newFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, newStore)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -139,7 +151,26 @@ func (cms Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 
 // Implements MultiStore.
 func (cms Store) CacheMultiStore() types.CacheMultiStore {
-	return newCacheMultiStoreFromCMS(cms)
+	return newCacheMultiStoreFromCMS(cms, cachekv.NewStore)
+}
+
+// CacheMultiStoreWithReadTracking implements types.ReadTrackingMultiStore.
+func (cms Store) CacheMultiStoreWithReadTracking() types.ReadTrackedCacheMultiStore {
+	return newCacheMultiStoreFromCMS(cms, cachekv.NewTrackingStore)
+}
+
+// ValidateReads implements types.ReadTrackedCacheMultiStore. It always returns true
+// if the store was not branched with CacheMultiStoreWithReadTracking.
+func (cms Store) ValidateReads() bool {
+	if !cms.db.(*cachekv.Store).ValidateReads() {
+		return false
+	}
+	for _, store := range cms.stores {
+		if !store.(*cachekv.Store).ValidateReads() {
+			return false
+		}
+	}
+	return true
 }
 
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
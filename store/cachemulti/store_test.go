@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/dbadapter"
 	"cosmossdk.io/store/types"
 )
 
@@ -22,3 +24,29 @@ func TestStoreGetKVStore(t *testing.T) {
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
 }
+
+func TestStoreValidateReads(t *testing.T) {
+	require := require.New(t)
+
+	key := types.NewKVStoreKey("abc")
+	parent := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		key: dbadapter.Store{DB: dbm.NewMemDB()},
+	}, nil, nil, nil)
+	parent.GetKVStore(key).Set([]byte("a"), []byte("1"))
+
+	tracked := parent.CacheMultiStoreWithReadTracking()
+	require.Equal([]byte("1"), tracked.GetKVStore(key).Get([]byte("a")))
+	tracked.GetKVStore(key).Set([]byte("b"), []byte("2"))
+
+	// the branches of the tracked store read through it
+	branch := tracked.CacheMultiStore()
+	require.Nil(branch.GetKVStore(key).Get([]byte("c")))
+	branch.Write()
+	require.True(tracked.ValidateReads())
+
+	parent.GetKVStore(key).Set([]byte("c"), []byte("3"))
+	require.False(tracked.ValidateReads())
+
+	// an untracked branch is always valid
+	require.True(parent.CacheMultiStore().(Store).ValidateReads())
+}
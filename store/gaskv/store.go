@@ -14,14 +14,22 @@ type Store struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.KVStore
+	storeName string
 }
 
 // NewStore returns a reference to a new GasKVStore.
 func NewStore(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig) *Store {
+	return NewStoreWithName(parent, "", gasMeter, gasConfig)
+}
+
+// NewStoreWithName returns a reference to a new GasKVStore, which attributes the gas
+// it consumes to the named store if the gas meter is a types.AttributingGasMeter.
+func NewStoreWithName(parent types.KVStore, storeName string, gasMeter types.GasMeter, gasConfig types.GasConfig) *Store {
 	kvs := &Store{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
 		parent:    parent,
+		storeName: storeName,
 	}
 	return kvs
 }
@@ -33,12 +41,12 @@ func (gs *Store) GetStoreType() types.StoreType {
 
 // Implements KVStore.
 func (gs *Store) Get(key []byte) (value []byte) {
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc, types.GasOperationRead)
 	value = gs.parent.Get(key)
 
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasReadPerByteDesc)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasReadPerByteDesc, types.GasOperationRead)
+	gs.consumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc, types.GasOperationRead)
 
 	return value
 }
@@ -47,23 +55,23 @@ func (gs *Store) Get(key []byte) (value []byte) {
 func (gs *Store) Set(key []byte, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc, types.GasOperationWrite)
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc, types.GasOperationWrite)
+	gs.consumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc, types.GasOperationWrite)
 	gs.parent.Set(key, value)
 }
 
 // Implements KVStore.
 func (gs *Store) Has(key []byte) bool {
-	gs.gasMeter.ConsumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
+	gs.consumeGas(gs.gasConfig.HasCost, types.GasHasDesc, types.GasOperationRead)
 	return gs.parent.Has(key)
 }
 
 // Implements KVStore.
 func (gs *Store) Delete(key []byte) {
 	// charge gas to prevent certain attack vectors even though space is being freed
-	gs.gasMeter.ConsumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+	gs.consumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc, types.GasOperationWrite)
 	gs.parent.Delete(key)
 }
 
@@ -100,23 +108,39 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = gs.parent.ReverseIterator(start, end)
 	}
 
-	gi := newGasIterator(gs.gasMeter, gs.gasConfig, parent)
-	gi.(*gasIterator).consumeSeekGas()
+	gi := newGasIterator(gs.gasMeter, gs.gasConfig, parent, gs.storeName)
+	gi.(*gasIterator).consumeSeekGas(types.GasOperationSeek)
 
 	return gi
 }
 
+// consumeGas consumes the gas from the gas meter, attributing it to the store and
+// operation if the meter supports it.
+func (gs *Store) consumeGas(amount types.Gas, descriptor string, operation types.GasOperation) {
+	consumeGas(gs.gasMeter, amount, descriptor, gs.storeName, operation)
+}
+
+func consumeGas(gasMeter types.GasMeter, amount types.Gas, descriptor, storeName string, operation types.GasOperation) {
+	if attributingGasMeter, ok := gasMeter.(types.AttributingGasMeter); ok {
+		attributingGasMeter.ConsumeStoreGas(amount, descriptor, storeName, operation)
+		return
+	}
+	gasMeter.ConsumeGas(amount, descriptor)
+}
+
 type gasIterator struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.Iterator
+	storeName string
 }
 
-func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, parent types.Iterator) types.Iterator {
+func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, parent types.Iterator, storeName string) types.Iterator {
 	return &gasIterator{
 		gasMeter:  gasMeter,
 		gasConfig: gasConfig,
 		parent:    parent,
+		storeName: storeName,
 	}
 }
 
@@ -134,7 +158,7 @@ func (gi *gasIterator) Valid() bool {
 // in the iterator. It incurs a flat gas cost for seeking and a variable gas
 // cost based on the current value's length if the iterator is valid.
 func (gi *gasIterator) Next() {
-	gi.consumeSeekGas()
+	gi.consumeSeekGas(types.GasOperationIter)
 	gi.parent.Next()
 }
 
@@ -163,14 +187,14 @@ func (gi *gasIterator) Error() error {
 }
 
 // consumeSeekGas consumes on each iteration step a flat gas cost and a variable gas cost
-// based on the current value's length.
-func (gi *gasIterator) consumeSeekGas() {
+// based on the current value's length, attributed to the provided operation.
+func (gi *gasIterator) consumeSeekGas(operation types.GasOperation) {
 	if gi.Valid() {
 		key := gi.Key()
 		value := gi.Value()
 
-		gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasValuePerByteDesc)
-		gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
+		consumeGas(gi.gasMeter, gi.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasValuePerByteDesc, gi.storeName, operation)
+		consumeGas(gi.gasMeter, gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc, gi.storeName, operation)
 	}
-	gi.gasMeter.ConsumeGas(gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
+	consumeGas(gi.gasMeter, gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc, gi.storeName, operation)
 }
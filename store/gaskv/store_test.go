@@ -118,3 +118,33 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreAttribution(t *testing.T) {
+	attribution := types.NewGasAttribution()
+	meter := types.NewAttributingGasMeter(types.NewGasMeter(100000), attribution)
+	bank := gaskv.NewStoreWithName(dbadapter.Store{DB: dbm.NewMemDB()}, "bank", meter, types.KVGasConfig())
+	staking := gaskv.NewStoreWithName(dbadapter.Store{DB: dbm.NewMemDB()}, "staking", meter, types.KVGasConfig())
+
+	bank.Set(keyFmt(1), valFmt(1))
+	require.Equal(t, valFmt(1), bank.Get(keyFmt(1)))
+	require.True(t, bank.Has(keyFmt(1)))
+	iterator := bank.Iterator(nil, nil)
+	iterator.Next()
+	require.NoError(t, iterator.Close())
+	staking.Delete(keyFmt(1))
+
+	require.Equal(t, []types.StoreGasConsumed{
+		{StoreName: "bank", Operation: types.GasOperationRead, Gas: 2072},
+		{StoreName: "bank", Operation: types.GasOperationWrite, Gas: 2720},
+		{StoreName: "bank", Operation: types.GasOperationIter, Gas: 102},
+		{StoreName: "bank", Operation: types.GasOperationSeek, Gas: 102},
+		{StoreName: "staking", Operation: types.GasOperationWrite, Gas: 1000},
+	}, attribution.Consumed())
+	require.Equal(t, types.Gas(5996), meter.GasConsumed())
+
+	// stores without a name consume gas as before when the meter does not attribute it
+	plainMeter := types.NewGasMeter(100000)
+	st := gaskv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, plainMeter, types.KVGasConfig())
+	st.Set(keyFmt(1), valFmt(1))
+	require.Equal(t, types.Gas(2720), plainMeter.GasConsumed())
+}
@@ -24,6 +24,9 @@ type Manager struct {
 	// we sync access to them to avoid soundness issues in the future if concurrency pattern changes.
 	pruneHeightsMx sync.Mutex
 	pruneHeights   []int64
+	// These are the heights taken by an asynchronous pruning which is not done yet.
+	// They are persisted with pruneHeights so that they are pruned again after a crash.
+	inFlightPruneHeights []int64
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleHeightSnapshot.
 	// Therefore, we sync access to pruneSnapshotHeights with this mutex.
@@ -83,7 +86,7 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 	defer m.pruneHeightsMx.Unlock()
 
 	// flush the updates to disk so that it is not lost if crash happens.
-	if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+	if err := m.flushPruningHeights(); err != nil {
 		return nil, err
 	}
 
@@ -95,6 +98,72 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 	return pruningHeights, nil
 }
 
+// TakePruningHeights returns all heights to be pruned by an asynchronous pruning and
+// resets the pruning heights. Unlike GetFlushAndResetPruningHeights, the returned heights
+// stay persisted until they are passed to MarkPruned, so that a crash before the
+// end of the pruning does not lose them.
+func (m *Manager) TakePruningHeights() ([]int64, error) {
+	if m.opts.GetPruningStrategy() == types.PruningNothing {
+		return []int64{}, nil
+	}
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	pruningHeights := make([]int64, len(m.pruneHeights))
+	copy(pruningHeights, m.pruneHeights)
+	m.inFlightPruneHeights = append(m.inFlightPruneHeights, pruningHeights...)
+	m.pruneHeights = m.pruneHeights[:0]
+
+	// flush the updates to disk so that it is not lost if crash happens.
+	if err := m.flushPruningHeights(); err != nil {
+		return nil, err
+	}
+
+	return pruningHeights, nil
+}
+
+// MarkPruned removes the heights returned by TakePruningHeights once they have been
+// pruned from the persisted pruning heights.
+func (m *Manager) MarkPruned(heights []int64) error {
+	if len(heights) == 0 {
+		return nil
+	}
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	pruned := make(map[int64]struct{}, len(heights))
+	for _, h := range heights {
+		pruned[h] = struct{}{}
+	}
+	inFlight := m.inFlightPruneHeights[:0]
+	for _, h := range m.inFlightPruneHeights {
+		if _, ok := pruned[h]; !ok {
+			inFlight = append(inFlight, h)
+		}
+	}
+	m.inFlightPruneHeights = inFlight
+
+	return m.flushPruningHeights()
+}
+
+// PendingPruningHeights returns the number of heights which are waiting to be pruned,
+// including the ones taken by an asynchronous pruning which is not done yet.
+func (m *Manager) PendingPruningHeights() int {
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	return len(m.pruneHeights) + len(m.inFlightPruneHeights)
+}
+
+// flushPruningHeights persists the heights to be pruned, including the ones being pruned,
+// pruneHeightsMx must be held by the caller.
+func (m *Manager) flushPruningHeights() error {
+	heights := make([]int64, 0, len(m.pruneHeights)+len(m.inFlightPruneHeights))
+	heights = append(heights, m.inFlightPruneHeights...)
+	heights = append(heights, m.pruneHeights...)
+	return m.db.SetSync(pruneHeightsKey, int64SliceToBytes(heights))
+}
+
 // HandleHeight determines if previousHeight height needs to be kept for pruning at the right interval prescribed by
 // the pruning strategy. Returns previousHeight, if it was kept to be pruned at the next call to Prune(), 0 otherwise.
 // previousHeight must be greater than 0 for the handling to take effect since valid heights start at 1 and 0 represents
@@ -128,7 +197,7 @@ func (m *Manager) HandleHeight(previousHeight int64) int64 {
 		}
 
 		// flush the updates to disk so that they are not lost if crash happens.
-		if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+		if err := m.flushPruningHeights(); err != nil {
 			panic(err)
 		}
 	}()
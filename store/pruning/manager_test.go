@@ -436,6 +436,44 @@ func TestFlushLoad(t *testing.T) {
 	}
 }
 
+func TestTakePruningHeights(t *testing.T) {
+	db := db.NewMemDB()
+	manager := pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(2, 10))
+
+	for h := int64(1); h <= 5; h++ {
+		manager.HandleHeight(h)
+	}
+	require.Equal(t, 3, manager.PendingPruningHeights())
+
+	heights, err := manager.TakePruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, heights)
+
+	// the taken heights stay pending and persisted with the new ones until they are pruned
+	manager.HandleHeight(6)
+	require.Equal(t, 4, manager.PendingPruningHeights())
+	loaded, err := pruning.LoadPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4}, loaded)
+
+	// a crash before the end of the pruning loads the taken heights again
+	restarted := pruning.NewManager(db, log.NewNopLogger())
+	restarted.SetOptions(types.NewCustomPruningOptions(2, 10))
+	require.NoError(t, restarted.LoadPruningHeights(db))
+	require.Equal(t, 4, restarted.PendingPruningHeights())
+
+	require.NoError(t, manager.MarkPruned(heights))
+	require.Equal(t, 1, manager.PendingPruningHeights())
+	loaded, err = pruning.LoadPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4}, loaded)
+
+	heights, err = manager.TakePruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{4}, heights)
+}
+
 func TestLoadPruningHeights(t *testing.T) {
 	var (
 		manager = pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
@@ -0,0 +1,152 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// KVDiffType is the type of change of a key between two versions of a store.
+type KVDiffType uint8
+
+const (
+	// KVDiffAdded is a key which exists only in the newer version.
+	KVDiffAdded KVDiffType = iota
+	// KVDiffRemoved is a key which exists only in the older version.
+	KVDiffRemoved
+	// KVDiffChanged is a key which exists in both the versions with different values.
+	KVDiffChanged
+)
+
+func (t KVDiffType) String() string {
+	switch t {
+	case KVDiffAdded:
+		return "added"
+	case KVDiffRemoved:
+		return "removed"
+	case KVDiffChanged:
+		return "changed"
+	default:
+		return fmt.Sprintf("KVDiffType(%d)", t)
+	}
+}
+
+// KVDiff is a key of a store which differs between two versions.
+type KVDiff struct {
+	Type KVDiffType
+	Key  []byte
+	// OldValue is the value at the older version, nil if the key was added.
+	OldValue []byte
+	// NewValue is the value at the newer version, nil if the key was removed.
+	NewValue []byte
+}
+
+// DiffVersions compares the IAVL stores at the from and to versions and calls onDiff,
+// in store name and key order, with every key which was added, removed or changed.
+// If storeNames is empty all the mounted IAVL stores are compared, stores whose root
+// hash is the same at both the versions are skipped without being walked.
+// The iteration stops with the first error returned by onDiff.
+func (rs *Store) DiffVersions(from, to int64, storeNames []string, onDiff func(storeName string, diff KVDiff) error) error {
+	if len(storeNames) == 0 {
+		for name, key := range rs.keysByName {
+			if rs.stores[key].GetStoreType() == types.StoreTypeIAVL {
+				storeNames = append(storeNames, name)
+			}
+		}
+	}
+	storeNames = append([]string(nil), storeNames...)
+	sort.Strings(storeNames)
+
+	for _, name := range storeNames {
+		key := rs.keysByName[name]
+		if key == nil {
+			return errorsmod.Wrapf(types.ErrUnknownRequest, "no such store: %s", name)
+		}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrUnknownRequest, "cannot diff non-IAVL store %q", name)
+		}
+
+		fromStore, err := store.GetImmutable(from)
+		if err != nil {
+			return errorsmod.Wrapf(err, "store %q at version %d", name, from)
+		}
+		toStore, err := store.GetImmutable(to)
+		if err != nil {
+			return errorsmod.Wrapf(err, "store %q at version %d", name, to)
+		}
+		if bytes.Equal(fromStore.LastCommitID().Hash, toStore.LastCommitID().Hash) {
+			continue
+		}
+
+		err = diffKVStores(fromStore, toStore, func(diff KVDiff) error {
+			return onDiff(name, diff)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffKVStores walks the two stores in key order at the same time and calls onDiff
+// with every key which differs between them.
+func diffKVStores(oldStore, newStore types.KVStore, onDiff func(diff KVDiff) error) (err error) {
+	oldIter := oldStore.Iterator(nil, nil)
+	defer func() {
+		if cerr := oldIter.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	newIter := newStore.Iterator(nil, nil)
+	defer func() {
+		if cerr := newIter.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	for oldIter.Valid() || newIter.Valid() {
+		var diff KVDiff
+		cmp := 0
+		switch {
+		case !newIter.Valid():
+			cmp = -1
+		case !oldIter.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(oldIter.Key(), newIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			diff = KVDiff{Type: KVDiffRemoved, Key: oldIter.Key(), OldValue: oldIter.Value()}
+			oldIter.Next()
+		case cmp > 0:
+			diff = KVDiff{Type: KVDiffAdded, Key: newIter.Key(), NewValue: newIter.Value()}
+			newIter.Next()
+		default:
+			oldValue, newValue := oldIter.Value(), newIter.Value()
+			diff = KVDiff{Type: KVDiffChanged, Key: oldIter.Key(), OldValue: oldValue, NewValue: newValue}
+			oldIter.Next()
+			newIter.Next()
+			if bytes.Equal(oldValue, newValue) {
+				continue
+			}
+		}
+
+		if err := onDiff(diff); err != nil {
+			return err
+		}
+	}
+
+	if err := oldIter.Error(); err != nil {
+		return err
+	}
+	return newIter.Error()
+}
@@ -0,0 +1,76 @@
+package rootmulti
+
+// pruneQueueSize is the number of prune requests which can wait for the pruning worker.
+// The heights to prune accumulate in the pruning manager until the worker takes them,
+// so a request which does not fit in the queue is served by the one already waiting.
+const pruneQueueSize = 1
+
+// requestPruning queues the pruning of the heights accumulated by the pruning manager
+// to the pruning worker, starting it if needed. It never blocks.
+func (rs *Store) requestPruning(version int64) {
+	if rs.pruneRequests == nil {
+		rs.pruneRequests = make(chan int64, pruneQueueSize)
+		rs.pruneDone = make(chan struct{})
+		go rs.pruningWorker(rs.pruneRequests, rs.pruneDone)
+	}
+
+	select {
+	case rs.pruneRequests <- version:
+	default:
+		rs.logger.Debug("pruning already pending", "height", version)
+	}
+}
+
+// pruningWorker prunes the heights of the pruning manager for every request until the
+// requests are closed.
+func (rs *Store) pruningWorker(requests <-chan int64, done chan<- struct{}) {
+	defer close(done)
+
+	for version := range requests {
+		rs.logger.Info("prune start", "height", version)
+		if err := rs.pruneTakenHeights(); err != nil {
+			// the heights stay persisted and are pruned again after a restart
+			rs.logger.Error("failed to prune store", "height", version, "err", err)
+		}
+		rs.logger.Info("prune end", "height", version)
+		rs.reportPruningLag()
+	}
+}
+
+// pruneTakenHeights takes the heights to prune from the pruning manager, and releases
+// them once they are deleted from the stores.
+func (rs *Store) pruneTakenHeights() error {
+	heights, err := rs.pruningManager.TakePruningHeights()
+	if err != nil {
+		return err
+	}
+	if len(heights) == 0 {
+		rs.logger.Debug("no heights to be pruned from pruning manager")
+		return nil
+	}
+
+	rs.logger.Debug("pruning store", "heights", heights)
+	if err := rs.deleteVersions(heights); err != nil {
+		return err
+	}
+	return rs.pruningManager.MarkPruned(heights)
+}
+
+// reportPruningLag reports the number of heights which are waiting to be pruned.
+func (rs *Store) reportPruningLag() {
+	rs.metrics.SetGauge(float32(rs.pruningManager.PendingPruningHeights()), "store", "pruning", "lag")
+}
+
+// Close stops the pruning worker once the pruning it is running and the queued one are
+// done. The heights which are still waiting are persisted by the pruning manager and
+// pruned after the store is loaded again. It must not be called concurrently with Commit.
+func (rs *Store) Close() error {
+	if rs.pruneRequests == nil {
+		return nil
+	}
+
+	close(rs.pruneRequests)
+	<-rs.pruneDone
+	rs.pruneRequests, rs.pruneDone = nil, nil
+	return nil
+}
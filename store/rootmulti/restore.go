@@ -0,0 +1,112 @@
+package rootmulti
+
+import (
+	"sync"
+
+	iavltree "github.com/cosmos/iavl"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/iavl"
+)
+
+// restoreNodeBufferSize is the number of nodes buffered for every store being imported.
+const restoreNodeBufferSize = 1024
+
+// storeImporters imports the nodes of multiple stores concurrently, every store
+// is imported by its own goroutine and at most concurrency stores are imported
+// at the same time. The first error stops all the imports.
+type storeImporters struct {
+	wg  sync.WaitGroup
+	sem chan struct{}
+
+	errOnce sync.Once
+	err     error
+	failed  chan struct{}
+}
+
+func newStoreImporters(concurrency int) *storeImporters {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &storeImporters{
+		sem:    make(chan struct{}, concurrency),
+		failed: make(chan struct{}),
+	}
+}
+
+// start starts importing a store at the provided height, once a concurrency slot is
+// available. It returns the channel the nodes of the store must be sent to through
+// add, which must be closed once all the nodes of the store have been sent.
+func (si *storeImporters) start(store *iavl.Store, height int64) (chan<- *iavltree.ExportNode, error) {
+	select {
+	case si.sem <- struct{}{}:
+	case <-si.failed:
+		return nil, si.err
+	}
+
+	importer, err := store.Import(height)
+	if err != nil {
+		<-si.sem
+		return nil, errorsmod.Wrap(err, "import failed")
+	}
+
+	nodes := make(chan *iavltree.ExportNode, restoreNodeBufferSize)
+	si.wg.Add(1)
+	go func() {
+		defer si.wg.Done()
+		defer func() { <-si.sem }()
+		defer importer.Close()
+
+		for {
+			select {
+			case node, ok := <-nodes:
+				if !ok {
+					if err := importer.Commit(); err != nil {
+						si.fail(errorsmod.Wrap(err, "IAVL commit failed"))
+					}
+					return
+				}
+				if err := importer.Add(node); err != nil {
+					si.fail(errorsmod.Wrap(err, "IAVL node import failed"))
+					return
+				}
+			case <-si.failed:
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// add sends the node to the importer of its store, it returns the import error,
+// if any import failed.
+func (si *storeImporters) add(nodes chan<- *iavltree.ExportNode, node *iavltree.ExportNode) error {
+	select {
+	case nodes <- node:
+		return nil
+	case <-si.failed:
+		return si.err
+	}
+}
+
+// wait waits for all the started imports to complete and returns the first import error.
+// All the channels returned by start must have been closed.
+func (si *storeImporters) wait() error {
+	si.wg.Wait()
+	return si.err
+}
+
+// abort stops the imports with the provided error and waits for them to exit,
+// it returns the first error which stopped the imports.
+func (si *storeImporters) abort(err error) error {
+	si.fail(err)
+	si.wg.Wait()
+	return si.err
+}
+
+func (si *storeImporters) fail(err error) {
+	si.errOnce.Do(func() {
+		si.err = err
+		close(si.failed)
+	})
+}
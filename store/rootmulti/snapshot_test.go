@@ -260,9 +260,107 @@ func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	}
 }
 
-func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys uint64) {
-	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
+func TestMultistoreSnapshotRestore_Concurrent(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 6, 100)
+	version := uint64(source.LastCommitID().Version)
+
+	for _, compression := range []snapshottypes.Compression{
+		snapshottypes.CompressionZlib, snapshottypes.CompressionZstd, snapshottypes.CompressionNone,
+	} {
+		for _, concurrency := range []int{1, 2, 8} {
+			t.Run(fmt.Sprintf("%v/%v", compression, concurrency), func(t *testing.T) {
+				target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+				for _, key := range source.StoreKeysByName() {
+					target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+				}
+				require.NoError(t, target.LoadLatestVersion())
+				target.SetRestoreConcurrency(concurrency)
+
+				chunks := make(chan io.ReadCloser, 100)
+				go func() {
+					streamWriter := snapshots.NewStreamWriterWithCompression(chunks, compression)
+					require.NotNil(t, streamWriter)
+					defer streamWriter.Close()
+					require.NoError(t, source.Snapshot(version, streamWriter))
+				}()
+
+				streamReader, err := snapshots.NewStreamReaderWithCompression(chunks, compression)
+				require.NoError(t, err)
+				_, err = target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+				require.NoError(t, err)
+
+				assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+				for _, key := range source.StoreKeysByName() {
+					assertStoresEqual(t,
+						source.GetStoreByName(key.Name()).(types.CommitKVStore),
+						target.GetStoreByName(key.Name()).(types.CommitKVStore),
+						"store %q not equal", key.Name())
+				}
+			})
+		}
+	}
+}
 
+func TestMultistoreSnapshotRestore_Errors(t *testing.T) {
+	storeItem := func(name string) *snapshottypes.SnapshotItem {
+		return &snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: name}},
+		}
+	}
+	nodeItem := func(key string, version int64) *snapshottypes.SnapshotItem {
+		return &snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{IAVL: &snapshottypes.SnapshotIAVLItem{
+				Key: []byte(key), Value: []byte{1}, Version: version,
+			}},
+		}
+	}
+
+	testcases := map[string]struct {
+		items     []*snapshottypes.SnapshotItem
+		expectErr string
+	}{
+		"node before store": {
+			[]*snapshottypes.SnapshotItem{nodeItem("a", 1)},
+			"received IAVL node item before store item",
+		},
+		"non-IAVL store": {
+			[]*snapshottypes.SnapshotItem{storeItem("iavl1"), nodeItem("a", 1), storeItem("trans1"), nodeItem("b", 1)},
+			"cannot import into non-IAVL store",
+		},
+		"invalid node": {
+			[]*snapshottypes.SnapshotItem{storeItem("iavl1"), nodeItem("a", 1), storeItem("iavl2"), nodeItem("b", 9), storeItem("iavl3")},
+			"IAVL node import failed",
+		},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		for _, concurrency := range []int{1, 4} {
+			t.Run(fmt.Sprintf("%v/%v", name, concurrency), func(t *testing.T) {
+				target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+				target.SetRestoreConcurrency(concurrency)
+
+				chunks := make(chan io.ReadCloser, 100)
+				go func() {
+					streamWriter := snapshots.NewStreamWriter(chunks)
+					defer streamWriter.Close()
+					for _, item := range tc.items {
+						if err := streamWriter.WriteMsg(item); err != nil {
+							return
+						}
+					}
+				}()
+
+				streamReader, err := snapshots.NewStreamReader(chunks)
+				require.NoError(t, err)
+				_, err = target.Restore(1, snapshottypes.CurrentFormat, streamReader)
+				require.ErrorContains(t, err, tc.expectErr)
+			})
+		}
+	}
+}
+
+func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys uint64, compression snapshottypes.Compression, concurrency int) {
+	b.Helper()
 	b.ReportAllocs()
 	b.StopTimer()
 	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), stores, storeKeys)
@@ -278,15 +376,17 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		err := target.LoadLatestVersion()
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
+		target.SetRestoreConcurrency(concurrency)
 
 		chunks := make(chan io.ReadCloser)
 		go func() {
-			writer := snapshots.NewStreamWriter(chunks)
+			writer := snapshots.NewStreamWriterWithCompression(chunks, compression)
 			require.NotNil(b, writer)
+			defer writer.Close()
 			err := source.Snapshot(version, writer)
 			require.NoError(b, err)
 		}()
-		reader, err := snapshots.NewStreamReader(chunks)
+		reader, err := snapshots.NewStreamReaderWithCompression(chunks, compression)
 		require.NoError(b, err)
 		_, err = target.Restore(version, snapshottypes.CurrentFormat, reader)
 		require.NoError(b, err)
@@ -302,10 +402,26 @@ func BenchmarkMultistoreSnapshot1M(b *testing.B) {
 	benchmarkMultistoreSnapshot(b, 10, 100000)
 }
 
+// BenchmarkMultistoreSnapshotRestore compares the sequential zlib restore with the
+// other compressions and the concurrent restore of the stores.
+func BenchmarkMultistoreSnapshotRestore(b *testing.B) {
+	for _, compression := range []snapshottypes.Compression{
+		snapshottypes.CompressionZlib, snapshottypes.CompressionZstd, snapshottypes.CompressionNone,
+	} {
+		for _, concurrency := range []int{1, 4} {
+			b.Run(fmt.Sprintf("%v/concurrency=%v", compression, concurrency), func(b *testing.B) {
+				benchmarkMultistoreSnapshotRestore(b, 10, 1000, compression, concurrency)
+			})
+		}
+	}
+}
+
 func BenchmarkMultistoreSnapshotRestore100K(b *testing.B) {
-	benchmarkMultistoreSnapshotRestore(b, 10, 10000)
+	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
+	benchmarkMultistoreSnapshotRestore(b, 10, 10000, snapshottypes.CompressionZlib, 1)
 }
 
 func BenchmarkMultistoreSnapshotRestore1M(b *testing.B) {
-	benchmarkMultistoreSnapshotRestore(b, 10, 100000)
+	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
+	benchmarkMultistoreSnapshotRestore(b, 10, 100000, snapshottypes.CompressionZlib, 1)
 }
@@ -65,9 +65,17 @@ type Store struct {
 	stores              map[types.StoreKey]types.CommitKVStore
 	keysByName          map[string]types.StoreKey
 	lazyLoading         bool
+	restoreConcurrency  int
 	initialVersion      int64
 	removalMap          map[types.StoreKey]bool
 
+	// asyncPruning set will prune the heights in a background worker instead of during Commit,
+	// pruneMtx serializes the deletion of the versions with the commit of the stores.
+	asyncPruning  bool
+	pruneMtx      sync.Mutex
+	pruneRequests chan int64
+	pruneDone     chan struct{}
+
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
 	traceContextMutex sync.Mutex
@@ -76,6 +84,9 @@ type Store struct {
 
 	listeners map[types.StoreKey][]types.WriteListener
 
+	// versionStore, if set, serves the versions of the IAVL stores which are pruned.
+	versionStore types.VersionStore
+
 	metrics metrics.StoreMetrics
 }
 
@@ -127,6 +138,12 @@ func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
 	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
 }
 
+// SetAsyncPruning enables or disables the pruning of the heights in a background worker
+// instead of during Commit. The worker is started by the first pruning and stopped by Close.
+func (rs *Store) SetAsyncPruning(enabled bool) {
+	rs.asyncPruning = enabled
+}
+
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
@@ -140,6 +157,13 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetRestoreConcurrency sets the maximum number of stores imported concurrently
+// while restoring a snapshot. The nodes of the different stores do not conflict,
+// so they can be imported in parallel. Values lower than 2 restore the stores sequentially.
+func (rs *Store) SetRestoreConcurrency(concurrency int) {
+	rs.restoreConcurrency = concurrency
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -303,7 +327,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		return err
 	}
 
-	return nil
+	return rs.syncVersionStore(ver)
 }
 
 func (rs *Store) getCommitID(infos map[string]types.StoreInfo, name string) types.CommitID {
@@ -450,6 +474,7 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	rs.pruneMtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
@@ -463,6 +488,7 @@ func (rs *Store) Commit() types.CommitID {
 	}
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
+	rs.pruneMtx.Unlock()
 
 	if err := rs.handlePruning(version); err != nil {
 		panic(err)
@@ -503,8 +529,10 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// iterating at past heights. The IAVL stores which have pruned the version
+// are served by the version store, if it has the version.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	useVersionStore := rs.versionStoreServes(version)
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
 		var cacheStore types.KVStore
@@ -512,12 +540,18 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		case types.StoreTypeIAVL:
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
+			iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+
+			// Fall back to the version store for the versions which are pruned.
+			if useVersionStore && !iavlStore.VersionExists(version) {
+				cacheStore = rs.versionStore.KVStoreAtVersion(key.Name(), version)
+				break
+			}
 
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
 			var err error
-			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
+			cacheStore, err = iavlStore.GetImmutable(version)
 			if err != nil {
 				return nil, err
 			}
@@ -577,9 +611,14 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 
 func (rs *Store) handlePruning(version int64) error {
 	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
+	defer rs.reportPruningLag()
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
 		return nil
 	}
+	if rs.asyncPruning {
+		rs.requestPruning(version)
+		return nil
+	}
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
 	return rs.PruneStores(true, nil)
@@ -609,18 +648,32 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 
 	rs.logger.Debug("pruning store", "heights", pruningHeights)
 
-	for key, store := range rs.stores {
-		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
+	return rs.deleteVersions(pruningHeights)
+}
 
+// deleteVersions deletes the heights from the IAVL stores. The stores are locked one
+// at a time so that a background pruning delays Commit by the deletion of at most
+// one store.
+func (rs *Store) deleteVersions(pruningHeights []int64) error {
+	rs.pruneMtx.Lock()
+	stores := make(map[types.StoreKey]*iavl.Store, len(rs.stores))
+	for key, store := range rs.stores {
 		// If the store is wrapped with an inter-block cache, we must first unwrap
 		// it to get the underlying IAVL store.
 		if store.GetStoreType() != types.StoreTypeIAVL {
 			continue
 		}
 
-		store = rs.GetCommitKVStore(key)
+		stores[key] = rs.GetCommitKVStore(key).(*iavl.Store)
+	}
+	rs.pruneMtx.Unlock()
+
+	for key, store := range stores {
+		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
 
-		err := store.(*iavl.Store).DeleteVersions(pruningHeights...)
+		rs.pruneMtx.Lock()
+		err := store.DeleteVersions(pruningHeights...)
+		rs.pruneMtx.Unlock()
 		if err == nil {
 			continue
 		}
@@ -844,7 +897,9 @@ func (rs *Store) Restore(
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
+	// Every store is imported by its own goroutine, up to restoreConcurrency stores at a time.
+	importers := newStoreImporters(rs.restoreConcurrency)
+	var nodes chan<- *iavltree.ExportNode
 	var snapshotItem snapshottypes.SnapshotItem
 loop:
 	for {
@@ -853,38 +908,33 @@ loop:
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+			return snapshottypes.SnapshotItem{}, importers.abort(errorsmod.Wrap(err, "invalid protobuf message"))
 		}
 
 		switch item := snapshotItem.Item.(type) {
 		case *snapshottypes.SnapshotItem_Store:
-			if importer != nil {
-				err = importer.Commit()
-				if err != nil {
-					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
-				}
-				importer.Close()
+			if nodes != nil {
+				close(nodes)
 			}
 			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
 			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
-			}
-			importer, err = store.Import(int64(height))
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
+				return snapshottypes.SnapshotItem{}, importers.abort(errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name))
 			}
-			defer importer.Close()
 			// Importer height must reflect the node height (which usually matches the block height, but not always)
 			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
+			nodes, err = importers.start(store, int64(height))
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, importers.abort(err)
+			}
 
 		case *snapshottypes.SnapshotItem_IAVL:
-			if importer == nil {
+			if nodes == nil {
 				rs.logger.Error("failed to restore; received IAVL node item before store item")
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
+				return snapshottypes.SnapshotItem{}, importers.abort(errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item"))
 			}
 			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
+				return snapshottypes.SnapshotItem{}, importers.abort(errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8))
 			}
 			node := &iavltree.ExportNode{
 				Key:     item.IAVL.Key,
@@ -900,9 +950,8 @@ loop:
 			if node.Height == 0 && node.Value == nil {
 				node.Value = []byte{}
 			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
+			if err := importers.add(nodes, node); err != nil {
+				return snapshottypes.SnapshotItem{}, importers.abort(err)
 			}
 
 		default:
@@ -910,12 +959,11 @@ loop:
 		}
 	}
 
-	if importer != nil {
-		err := importer.Commit()
-		if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
-		}
-		importer.Close()
+	if nodes != nil {
+		close(nodes)
+	}
+	if err := importers.wait(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
 	}
 
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
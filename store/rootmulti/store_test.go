@@ -104,6 +104,78 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestDiffVersions(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store1 := ms.GetStoreByName("store1").(types.KVStore)
+	store2 := ms.GetStoreByName("store2").(types.KVStore)
+	store3 := ms.GetStoreByName("store3").(types.KVStore)
+	store1.Set([]byte("a"), []byte("1"))
+	store1.Set([]byte("b"), []byte("2"))
+	store1.Set([]byte("c"), []byte("3"))
+	store2.Set([]byte("x"), []byte("1"))
+	store3.Set([]byte("y"), []byte("1"))
+	from := ms.Commit().Version
+
+	store1.Delete([]byte("a"))
+	store1.Set([]byte("b"), []byte("20"))
+	store1.Set([]byte("c"), []byte("3"))
+	store1.Set([]byte("d"), []byte("4"))
+	store3.Set([]byte("z"), []byte("1"))
+	to := ms.Commit().Version
+
+	type storeDiff struct {
+		store string
+		diff  KVDiff
+	}
+	collect := func(from, to int64, storeNames ...string) ([]storeDiff, error) {
+		var diffs []storeDiff
+		err := ms.DiffVersions(from, to, storeNames, func(storeName string, diff KVDiff) error {
+			diffs = append(diffs, storeDiff{storeName, diff})
+			return nil
+		})
+		return diffs, err
+	}
+
+	diffs, err := collect(from, to)
+	require.NoError(t, err)
+	require.Equal(t, []storeDiff{
+		{"store1", KVDiff{Type: KVDiffRemoved, Key: []byte("a"), OldValue: []byte("1")}},
+		{"store1", KVDiff{Type: KVDiffChanged, Key: []byte("b"), OldValue: []byte("2"), NewValue: []byte("20")}},
+		{"store1", KVDiff{Type: KVDiffAdded, Key: []byte("d"), NewValue: []byte("4")}},
+		{"store3", KVDiff{Type: KVDiffAdded, Key: []byte("z"), NewValue: []byte("1")}},
+	}, diffs)
+
+	// the diff is symmetric
+	diffs, err = collect(to, from, "store3")
+	require.NoError(t, err)
+	require.Equal(t, []storeDiff{
+		{"store3", KVDiff{Type: KVDiffRemoved, Key: []byte("z"), OldValue: []byte("1")}},
+	}, diffs)
+
+	// unchanged stores have no diff
+	diffs, err = collect(from, to, "store2")
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	// the first error stops the walk
+	errStop := fmt.Errorf("stop")
+	calls := 0
+	err = ms.DiffVersions(from, to, nil, func(string, KVDiff) error {
+		calls++
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 1, calls)
+
+	_, err = collect(from, to, "unknown")
+	require.ErrorIs(t, err, types.ErrUnknownRequest)
+	_, err = collect(from, to+1)
+	require.Error(t, err)
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -512,6 +584,79 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_AsyncPruning(t *testing.T) {
+	testCases := []struct {
+		name        string
+		numVersions int64
+		po          pruningtypes.PruningOptions
+		deleted     []int64
+		saved       []int64
+	}{
+		{"prune everything", 10, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything), []int64{1, 2, 3, 4, 5, 6, 7}, []int64{8, 9, 10}},
+		{"prune some; no batch", 10, pruningtypes.NewCustomPruningOptions(2, 1), []int64{1, 2, 3, 4, 6, 5, 7}, []int64{8, 9, 10}},
+		{"prune some; small batch", 9, pruningtypes.NewCustomPruningOptions(2, 3), []int64{1, 2, 3, 4, 5, 6}, []int64{7, 8, 9}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			ms := newMultiStoreWithMounts(db, tc.po)
+			ms.SetAsyncPruning(true)
+			require.NoError(t, ms.LoadLatestVersion())
+
+			key := ms.keysByName["store1"]
+			for i := int64(0); i < tc.numVersions; i++ {
+				ms.GetKVStore(key).Set([]byte("key"), []byte(fmt.Sprint(i)))
+				ms.Commit()
+			}
+			// the last commit is at a pruning interval, all the heights pending at that
+			// time are pruned once the queued pruning is done
+			require.NoError(t, ms.Close())
+
+			for _, v := range tc.saved {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.NoError(t, err, "expected no error when loading height: %d", v)
+			}
+
+			for _, v := range tc.deleted {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.Error(t, err, "expected error when loading height: %d", v)
+			}
+			require.Zero(t, ms.pruningManager.PendingPruningHeights())
+		})
+	}
+}
+
+func TestMultiStore_AsyncPruning_Restart(t *testing.T) {
+	db := dbm.NewMemDB()
+	po := pruningtypes.NewCustomPruningOptions(2, 100)
+	ms := newMultiStoreWithMounts(db, po)
+	ms.SetAsyncPruning(true)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	// the pruning interval is not reached, the heights are only persisted
+	require.NoError(t, ms.Close())
+	require.Equal(t, 7, ms.pruningManager.PendingPruningHeights())
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 11))
+	ms.SetAsyncPruning(true)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, 7, ms.pruningManager.PendingPruningHeights())
+
+	ms.Commit()
+	require.NoError(t, ms.Close())
+	require.Zero(t, ms.pruningManager.PendingPruningHeights())
+	for v := int64(1); v <= 8; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -0,0 +1,194 @@
+package rootmulti
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// SetVersionStore implements types.CommitMultiStore. The version store serves the versions
+// of the IAVL stores which are pruned, it must be set before the store is loaded. When the
+// version store is empty, the state of the loaded version is imported in it, when it is
+// behind, the changes of the versions it missed are written to it.
+func (rs *Store) SetVersionStore(store types.VersionStore) {
+	rs.versionStore = store
+}
+
+// versionStoreRecovery tells the operator how to recover from a version store which cannot
+// be synced with the multistore.
+const versionStoreRecovery = "stop the node and remove the version store, the streamers.versiondb.dir " +
+	"directory (data/versiondb by default), to import the state of the multistore again"
+
+// syncVersionStore brings the version store to the loaded version, importing the state of
+// the version in it if it is empty, or writing the changes of the versions it missed, e.g.
+// when the node stopped between the commit of the multistore and of the version store.
+func (rs *Store) syncVersionStore(version int64) error {
+	if rs.versionStore == nil {
+		return nil
+	}
+
+	latest, err := rs.versionStore.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case latest == version:
+		return nil
+	case latest == 0:
+		return rs.importVersionStore(version)
+	case latest < version:
+		return rs.catchUpVersionStore(latest, version)
+	default:
+		return fmt.Errorf("version store is at version %d, ahead of the multistore at version %d; %s",
+			latest, version, versionStoreRecovery)
+	}
+}
+
+// catchUpVersionStore writes the changes of the IAVL stores between the latest version of
+// the version store and the loaded version to the version store, one version at a time.
+// The changes are the diff of the stores between consecutive versions, which must not
+// have been pruned.
+func (rs *Store) catchUpVersionStore(latest, version int64) error {
+	rs.logger.Info("catching up the version store", "from", latest+1, "to", version)
+
+	prevStores := rs.committedIAVLStores(latest)
+	for v := latest + 1; v <= version; v++ {
+		stores := rs.committedIAVLStores(v)
+
+		names := make([]string, 0, len(stores))
+		for name := range stores {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var changeSet []*types.StoreKVPair
+		for _, name := range names {
+			pairs, err := rs.storeChangesAtVersion(name, v, !prevStores[name])
+			if err != nil {
+				return err
+			}
+			changeSet = append(changeSet, pairs...)
+		}
+
+		if err := rs.versionStore.PutAtVersion(v, changeSet); err != nil {
+			return err
+		}
+		prevStores = stores
+	}
+
+	return nil
+}
+
+// committedIAVLStores returns the names of the mounted IAVL stores which are in the
+// commit info of the version, none if the version was not committed.
+func (rs *Store) committedIAVLStores(version int64) map[string]bool {
+	stores := make(map[string]bool)
+
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil {
+		return stores
+	}
+	for _, info := range cInfo.StoreInfos {
+		key := rs.keysByName[info.Name]
+		if key != nil && rs.stores[key].GetStoreType() == types.StoreTypeIAVL && !rs.removalMap[key] {
+			stores[info.Name] = true
+		}
+	}
+
+	return stores
+}
+
+// storeChangesAtVersion returns the changes of the IAVL store with the name between the
+// version and the previous one, or all its pairs when it is added at the version.
+func (rs *Store) storeChangesAtVersion(name string, version int64, added bool) ([]*types.StoreKVPair, error) {
+	iavlStore := rs.GetCommitKVStore(rs.keysByName[name]).(*iavl.Store)
+	if !iavlStore.VersionExists(version) || (!added && !iavlStore.VersionExists(version-1)) {
+		return nil, fmt.Errorf("cannot catch up the version store at version %d: store %q is pruned; %s",
+			version, name, versionStoreRecovery)
+	}
+
+	newStore, err := iavlStore.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []*types.StoreKVPair
+	if added {
+		iter := newStore.Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			pairs = append(pairs, &types.StoreKVPair{StoreKey: name, Key: iter.Key(), Value: iter.Value()})
+		}
+		return pairs, iter.Error()
+	}
+
+	oldStore, err := iavlStore.GetImmutable(version - 1)
+	if err != nil {
+		return nil, err
+	}
+	err = diffKVStores(oldStore, newStore, func(diff KVDiff) error {
+		pairs = append(pairs, &types.StoreKVPair{
+			StoreKey: name,
+			Delete:   diff.Type == KVDiffRemoved,
+			Key:      diff.Key,
+			Value:    diff.NewValue,
+		})
+		return nil
+	})
+
+	return pairs, err
+}
+
+// importVersionStore imports the state of the IAVL stores at the version in the empty
+// version store.
+func (rs *Store) importVersionStore(version int64) error {
+	stores := make(map[string]types.Iterator)
+	defer func() {
+		for _, iter := range stores {
+			_ = iter.Close()
+		}
+	}()
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL || rs.removalMap[key] {
+			continue
+		}
+
+		// read the committed version, as the upgrades may have changed the working state
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+		if !iavlStore.VersionExists(version) {
+			continue
+		}
+		immutable, err := iavlStore.GetImmutable(version)
+		if err != nil {
+			return err
+		}
+		stores[key.Name()] = immutable.Iterator(nil, nil)
+	}
+
+	rs.logger.Info("importing the state in the version store", "version", version, "stores", len(stores))
+	return rs.versionStore.Import(version, stores)
+}
+
+// versionStoreServes returns whether the version store has the state of the version.
+func (rs *Store) versionStoreServes(version int64) bool {
+	if rs.versionStore == nil {
+		return false
+	}
+
+	earliest, err := rs.versionStore.GetEarliestVersion()
+	if err != nil {
+		rs.logger.Error("failed to read the earliest version of the version store", "err", err)
+		return false
+	}
+	latest, err := rs.versionStore.GetLatestVersion()
+	if err != nil {
+		rs.logger.Error("failed to read the latest version of the version store", "err", err)
+		return false
+	}
+
+	return earliest != 0 && earliest <= version && version <= latest
+}
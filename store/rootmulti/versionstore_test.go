@@ -0,0 +1,149 @@
+package rootmulti
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/iavl"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
+)
+
+func TestMultiStore_VersionStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+
+	versionStore := versiondb.NewStore(dbm.NewMemDB())
+	streamer := versiondb.NewStreamingService(versionStore, []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3}, log.NewNopLogger())
+	for key, listeners := range streamer.Listeners() {
+		ms.AddListeners(key, listeners)
+	}
+	ms.SetVersionStore(versionStore)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := context.Background()
+	for height := int64(1); height <= 10; height++ {
+		require.NoError(t, streamer.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+
+		ms.GetKVStore(testStoreKey1).Set([]byte("height"), []byte(fmt.Sprint(height)))
+		store2 := ms.GetKVStore(testStoreKey2)
+		store2.Set([]byte(fmt.Sprint(height)), []byte("set"))
+		store2.Delete([]byte(fmt.Sprint(height - 2)))
+		ms.Commit()
+
+		require.NoError(t, streamer.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+
+	// the pruned versions are served by the version store
+	require.False(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(5))
+	for _, version := range []int64{1, 5, 9, 10} {
+		cms, err := ms.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprint(version)), cms.GetKVStore(testStoreKey1).Get([]byte("height")))
+
+		var keys []string
+		iter := cms.GetKVStore(testStoreKey2).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, string(iter.Key()))
+		}
+		require.NoError(t, iter.Close())
+		expected := []string{fmt.Sprint(version - 1), fmt.Sprint(version)}
+		if version == 1 {
+			expected = expected[1:]
+		}
+		sort.Strings(expected)
+		require.Equal(t, expected, keys)
+	}
+
+	// the version store must be at the version of the multistore when it is loaded
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetVersionStore(versionStore)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	behind := versiondb.NewStore(dbm.NewMemDB())
+	require.NoError(t, behind.PutAtVersion(3, nil))
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetVersionStore(behind)
+	require.Error(t, ms.LoadLatestVersion())
+
+	// an empty version store imports the state of the loaded version
+	imported := versiondb.NewStore(dbm.NewMemDB())
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	ms.SetVersionStore(imported)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	earliest, err := imported.GetEarliestVersion()
+	require.NoError(t, err)
+	require.EqualValues(t, 10, earliest)
+	require.Equal(t, []byte("10"), imported.KVStoreAtVersion(testStoreKey1.Name(), 10).Get([]byte("height")))
+	require.Equal(t, []byte("set"), imported.KVStoreAtVersion(testStoreKey2.Name(), 10).Get([]byte("9")))
+	require.Nil(t, imported.KVStoreAtVersion(testStoreKey2.Name(), 10).Get([]byte("8")))
+
+	// the versions before the import are not served
+	_, err = ms.CacheMultiStoreWithVersion(5)
+	require.Error(t, err)
+}
+
+func TestMultiStore_VersionStoreCatchUp(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+
+	versionStore := versiondb.NewStore(dbm.NewMemDB())
+	streamer := versiondb.NewStreamingService(versionStore, []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3}, log.NewNopLogger())
+	for key, listeners := range streamer.Listeners() {
+		ms.AddListeners(key, listeners)
+	}
+	ms.SetVersionStore(versionStore)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the node stops before the version store commits the last two versions
+	ctx := context.Background()
+	for height := int64(1); height <= 5; height++ {
+		require.NoError(t, streamer.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+
+		ms.GetKVStore(testStoreKey1).Set([]byte("height"), []byte(fmt.Sprint(height)))
+		store2 := ms.GetKVStore(testStoreKey2)
+		store2.Set([]byte(fmt.Sprint(height)), []byte("set"))
+		store2.Delete([]byte(fmt.Sprint(height - 2)))
+		ms.Commit()
+
+		if height <= 3 {
+			require.NoError(t, streamer.ListenCommit(ctx, abci.ResponseCommit{}))
+		}
+	}
+	latest, err := versionStore.GetLatestVersion()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, latest)
+
+	// the missed versions are written when the multistore is loaded again
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetVersionStore(versionStore)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	latest, err = versionStore.GetLatestVersion()
+	require.NoError(t, err)
+	require.EqualValues(t, 5, latest)
+	for _, version := range []int64{4, 5} {
+		require.Equal(t, []byte(fmt.Sprint(version)), versionStore.KVStoreAtVersion(testStoreKey1.Name(), version).Get([]byte("height")))
+		store2 := versionStore.KVStoreAtVersion(testStoreKey2.Name(), version)
+		require.Equal(t, []byte("set"), store2.Get([]byte(fmt.Sprint(version))))
+		require.Equal(t, []byte("set"), store2.Get([]byte(fmt.Sprint(version-1))))
+		require.Nil(t, store2.Get([]byte(fmt.Sprint(version-2))))
+	}
+
+	// a version store ahead of the multistore cannot be synced
+	ahead := versiondb.NewStore(dbm.NewMemDB())
+	require.NoError(t, ahead.PutAtVersion(6, nil))
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetVersionStore(ahead)
+	require.ErrorContains(t, ms.LoadLatestVersion(), "streamers.versiondb.dir")
+}
@@ -0,0 +1,182 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// stateExportMetadataFile is the name of the file of a state export directory holding
+// the protobuf encoded types.Snapshot describing the export, the chunks of the export
+// are stored next to it in files named by their index.
+const stateExportMetadataFile = "metadata"
+
+// ExportState exports the state at the height, the stores of the multistore followed by
+// the extensions, to dir. The export uses the snapshot stream format: the chunks of the
+// stream, compressed with the compression of the snapshot options, are written next to
+// a metadata file holding their hashes. Unlike Create, the export is not saved in the
+// snapshot store, so it can be taken at any height which is not pruned, and copied to
+// another node to be imported with ImportState.
+func (m *Manager) ExportState(height uint64, dir string) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+	if height == 0 {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "cannot export height 0")
+	}
+	if err := ValidateCompression(m.opts.Compression); err != nil {
+		return nil, err
+	}
+
+	metadataPath := filepath.Join(dir, stateExportMetadataFile)
+	if _, err := os.Stat(metadataPath); err == nil {
+		return nil, errorsmod.Wrapf(storetypes.ErrConflict, "a state export already exists in %q", dir)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to create state export directory %q", dir)
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(height, m.opts.Compression, ch)
+	defer DrainChunks(ch)
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: types.CurrentFormat,
+		Metadata: types.Metadata{
+			Compression: m.opts.Compression,
+		},
+	}
+	index := uint32(0)
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	for chunkBody := range ch {
+		path := filepath.Join(dir, strconv.FormatUint(uint64(index), 10))
+		if err := saveChunkFile(path, chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
+			return nil, err
+		}
+		index++
+	}
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+
+	bz, err := proto.Marshal(snapshot)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to encode state export metadata")
+	}
+	if err := os.WriteFile(metadataPath, bz, 0o644); err != nil { //nolint:gosec // the export is meant to be shared
+		return nil, errorsmod.Wrap(err, "failed to write state export metadata")
+	}
+	return snapshot, nil
+}
+
+// LoadStateExport loads the metadata of the state export in dir.
+func LoadStateExport(dir string) (*types.Snapshot, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, stateExportMetadataFile))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to read state export metadata in %q", dir)
+	}
+
+	snapshot := &types.Snapshot{}
+	if err := proto.Unmarshal(bz, snapshot); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "failed to decode state export metadata: %v", err)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMetadata, "state export has %v chunk hashes, but %v chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+	return snapshot, nil
+}
+
+// ImportState restores the state export in dir into the multistore and the extensions, which
+// must be empty. The state is restored at the height of the export, or at the provided height
+// if it is not 0, e.g. to start a fork or a testnet at a later height than the exported one.
+// The chunks are verified against the hashes of the export metadata while they are restored.
+func (m *Manager) ImportState(dir string, height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	snapshot, err := LoadStateExport(dir)
+	if err != nil {
+		return nil, err
+	}
+	if height != 0 {
+		if height < snapshot.Height {
+			return nil, errorsmod.Wrapf(storetypes.ErrLogic,
+				"cannot import the state of height %v at the lower height %v", snapshot.Height, height)
+		}
+		snapshot.Height = height
+	}
+	if err := ValidRestoreHeight(snapshot.Format, snapshot.Height); err != nil {
+		return nil, err
+	}
+	if err := ValidateCompression(snapshot.Metadata.Compression); err != nil {
+		return nil, err
+	}
+
+	err = m.begin(opRestore)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	chunks := make(chan io.ReadCloser, chunkBufferSize)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(chunks)
+		for index, expected := range snapshot.Metadata.ChunkHashes {
+			chunk, err := loadChunkFile(filepath.Join(dir, strconv.Itoa(index)), expected)
+			if err != nil {
+				// the error is returned by the stream reader of the restore
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(errorsmod.Wrapf(err, "chunk %d", index))
+				chunk = pr
+			}
+
+			select {
+			case chunks <- chunk:
+			case <-done:
+				_ = chunk.Close()
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	if err := m.restoreSnapshot(*snapshot, chunks); err != nil {
+		return nil, errorsmod.Wrap(err, "state export import")
+	}
+	return snapshot, nil
+}
+
+// loadChunkFile loads the chunk file at path, checking its hash.
+func loadChunkFile(path string, expected []byte) (io.ReadCloser, error) {
+	chunk, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(chunk)
+	if !bytes.Equal(hash[:], expected) {
+		return nil, errorsmod.Wrapf(types.ErrChunkHashMismatch, "expected %x, got %x", expected, hash)
+	}
+	return io.NopCloser(bytes.NewReader(chunk)), nil
+}
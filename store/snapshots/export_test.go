@@ -0,0 +1,105 @@
+package snapshots_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestManager_ExportImportState(t *testing.T) {
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	source := snapshots.NewManager(setupStore(t), opts, &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}, nil, log.NewNopLogger())
+	sourceExt := newExtSnapshotter(10)
+	require.NoError(t, source.RegisterExtensions(sourceExt))
+
+	dir := filepath.Join(GetTempDir(t), "export")
+	exported, err := source.ExportState(5, dir)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, exported.Height)
+	require.Equal(t, types.CurrentFormat, exported.Format)
+	require.Len(t, exported.Metadata.ChunkHashes, int(exported.Chunks))
+
+	// the export is not saved in the snapshot store
+	list, err := source.List()
+	require.NoError(t, err)
+	for _, snapshot := range list {
+		require.NotEqual(t, exported.Height, snapshot.Height)
+	}
+
+	// nor can it overwrite another export
+	_, err = source.ExportState(5, dir)
+	require.ErrorIs(t, err, storetypes.ErrConflict)
+
+	loaded, err := snapshots.LoadStateExport(dir)
+	require.NoError(t, err)
+	require.Equal(t, exported, loaded)
+
+	newTarget := func() (*snapshots.Manager, *mockSnapshotter, *extSnapshotter) {
+		target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+		targetExtSnapshotter := newExtSnapshotter(0)
+		manager := snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+		require.NoError(t, manager.RegisterExtensions(targetExtSnapshotter))
+		return manager, target, targetExtSnapshotter
+	}
+
+	t.Run("exported height", func(t *testing.T) {
+		manager, target, targetExtSnapshotter := newTarget()
+		imported, err := manager.ImportState(dir, 0)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, imported.Height)
+		require.Equal(t, items, target.items)
+		require.Equal(t, sourceExt.state, targetExtSnapshotter.state)
+	})
+
+	t.Run("higher height", func(t *testing.T) {
+		manager, target, _ := newTarget()
+		imported, err := manager.ImportState(dir, 100)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, imported.Height)
+		require.Equal(t, items, target.items)
+	})
+
+	t.Run("lower height", func(t *testing.T) {
+		manager, target, _ := newTarget()
+		_, err := manager.ImportState(dir, 4)
+		require.ErrorIs(t, err, storetypes.ErrLogic)
+		require.Nil(t, target.items)
+	})
+
+	t.Run("corrupted chunk", func(t *testing.T) {
+		corrupted := filepath.Join(GetTempDir(t), "corrupted")
+		require.NoError(t, os.Mkdir(corrupted, 0o755))
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		for _, entry := range entries {
+			bz, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filepath.Join(corrupted, entry.Name()), bz, 0o600))
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(corrupted, "0"), []byte{1, 2, 3}, 0o600))
+
+		manager, _, _ := newTarget()
+		_, err = manager.ImportState(corrupted, 0)
+		require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+	})
+
+	t.Run("missing export", func(t *testing.T) {
+		manager, _, _ := newTarget()
+		_, err := manager.ImportState(GetTempDir(t), 0)
+		require.Error(t, err)
+	})
+}
@@ -63,6 +63,8 @@ const (
 	opRestore  operation = "restore"
 
 	chunkBufferSize = 4
+	// restoreItemBufferSize is the number of snapshot items decompressed ahead of their restoration.
+	restoreItemBufferSize = 1024
 
 	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
 )
@@ -180,17 +182,21 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if err := ValidateCompression(m.opts.Compression); err != nil {
+		return nil, err
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, m.opts.Compression, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.save(height, types.CurrentFormat, m.opts.Compression, ch)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
+func (m *Manager) createSnapshot(height uint64, compression types.Compression, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriterWithCompression(ch, compression)
 	if streamWriter == nil {
 		return
 	}
@@ -277,6 +283,9 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	if snapshot.Format != types.CurrentFormat {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	if err := ValidateCompression(snapshot.Metadata.Compression); err != nil {
+		return err
+	}
 	if snapshot.Height == 0 {
 		return errorsmod.Wrap(storetypes.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -311,13 +320,15 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 }
 
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
+// The stream is decompressed ahead of the restoration of its items, in a separate goroutine.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
 	var nextItem types.SnapshotItem
 
-	streamReader, err := NewStreamReader(chChunks)
+	sr, err := NewStreamReaderWithCompression(chChunks, snapshot.Metadata.Compression)
 	if err != nil {
 		return err
 	}
+	streamReader := newPrefetchReader(sr, restoreItemBufferSize)
 	defer streamReader.Close()
 
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
@@ -236,3 +236,68 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_Compression(t *testing.T) {
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+
+	for _, compression := range []types.Compression{types.CompressionZlib, types.CompressionZstd, types.CompressionNone} {
+		t.Run(compression.String(), func(t *testing.T) {
+			compressionOpts := opts
+			compressionOpts.Compression = compression
+
+			source := snapshots.NewManager(setupStore(t), compressionOpts, &mockSnapshotter{
+				items:         items,
+				prunedHeights: make(map[int64]struct{}),
+			}, nil, log.NewNopLogger())
+			extSnapshotter := newExtSnapshotter(10)
+			require.NoError(t, source.RegisterExtensions(extSnapshotter))
+
+			// the compression is recorded in the snapshot metadata
+			snapshot, err := source.Create(5)
+			require.NoError(t, err)
+			require.Equal(t, compression, snapshot.Metadata.Compression)
+
+			var chunks [][]byte
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				chunk, err := source.LoadChunk(snapshot.Height, snapshot.Format, i)
+				require.NoError(t, err)
+				chunks = append(chunks, chunk)
+			}
+
+			// and used to restore it
+			target := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+			targetExtSnapshotter := newExtSnapshotter(0)
+			manager := snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+			require.NoError(t, manager.RegisterExtensions(targetExtSnapshotter))
+			require.NoError(t, manager.Restore(*snapshot))
+			for i, chunk := range chunks {
+				done, err := manager.RestoreChunk(chunk)
+				require.NoError(t, err)
+				require.Equal(t, i == len(chunks)-1, done)
+			}
+			require.Equal(t, items, target.items)
+			require.Equal(t, extSnapshotter.state, targetExtSnapshotter.state)
+		})
+	}
+
+	// unknown compressions are rejected
+	invalidOpts := opts
+	invalidOpts.Compression = types.Compression(9)
+	manager := snapshots.NewManager(setupStore(t), invalidOpts, &mockSnapshotter{
+		prunedHeights: make(map[int64]struct{}),
+	}, nil, log.NewNopLogger())
+	_, err := manager.Create(5)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	err = manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Chunks:   1,
+		Metadata: types.Metadata{ChunkHashes: [][]byte{{1}}, Compression: types.Compression(9)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+}
@@ -224,6 +224,13 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, types.CompressionZlib, chunks)
+}
+
+// save saves a snapshot whose chunks stream is compressed using the provided compression.
+func (s *Store) save(
+	height uint64, format uint32, compression types.Compression, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -256,6 +263,9 @@ func (s *Store) Save(
 	snapshot := &types.Snapshot{
 		Height: height,
 		Format: format,
+		Metadata: types.Metadata{
+			Compression: compression,
+		},
 	}
 
 	dirCreated := false
@@ -289,9 +299,14 @@ func (s *Store) Save(
 // The hash of the chunk is appended to the snapshot's metadata,
 // and the overall snapshot hash is updated with the chunk content too.
 func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash) error {
+	return saveChunkFile(s.pathChunk(snapshot.Height, snapshot.Format, index), chunkBody, index, snapshot, chunkHasher, snapshotHasher)
+}
+
+// saveChunkFile saves the given chunkBody with the given index to the file at path,
+// updating the snapshot metadata and hash like saveChunk.
+func saveChunkFile(path string, chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash) error {
 	defer chunkBody.Close()
 
-	path := s.pathChunk(snapshot.Height, snapshot.Format, index)
 	chunkFile, err := os.Create(path)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create snapshot chunk file %q", path)
@@ -3,11 +3,16 @@ package snapshots
 import (
 	"bufio"
 	"compress/zlib"
+	"encoding/binary"
+	"fmt"
 	"io"
 
 	"cosmossdk.io/errors"
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/klauspost/compress/zstd"
+
+	"cosmossdk.io/store/snapshots/types"
 )
 
 const (
@@ -16,24 +21,33 @@ const (
 	snapshotBufferSize = int(snapshotChunkSize)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
+	// snapshotMaxZstdWindowSize bounds the memory used to decompress zstd streams.
+	snapshotMaxZstdWindowSize = 1 << 27
 )
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
-// Exported Items -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+// Exported Items -> delimited Protobuf -> compression -> buffer -> chunkWriter -> chan io.ReadCloser
 type StreamWriter struct {
 	chunkWriter *ChunkWriter
 	bufWriter   *bufio.Writer
-	zWriter     *zlib.Writer
+	zWriter     io.WriteCloser
 	protoWriter protoio.WriteCloser
 }
 
-// NewStreamWriter set up a stream pipeline to serialize snapshot DB records.
+// NewStreamWriter set up a stream pipeline to serialize snapshot DB records,
+// using zlib compression.
 func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
+	return NewStreamWriterWithCompression(ch, types.CompressionZlib)
+}
+
+// NewStreamWriterWithCompression set up a stream pipeline to serialize snapshot DB records,
+// using the provided compression.
+func NewStreamWriterWithCompression(ch chan<- io.ReadCloser, compression types.Compression) *StreamWriter {
 	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
 	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
-	zWriter, err := zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
+	zWriter, err := newCompressionWriter(bufWriter, compression)
 	if err != nil {
-		chunkWriter.CloseWithError(errors.Wrap(err, "zlib failure"))
+		chunkWriter.CloseWithError(err)
 		return nil
 	}
 	protoWriter := protoio.NewDelimitedWriter(zWriter)
@@ -69,19 +83,25 @@ func (sw *StreamWriter) CloseWithError(err error) {
 }
 
 // StreamReader set up a restore stream pipeline
-// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
+// chan io.ReadCloser -> chunkReader -> decompression -> delimited Protobuf -> ExportNode
 type StreamReader struct {
 	chunkReader *ChunkReader
 	zReader     io.ReadCloser
 	protoReader protoio.ReadCloser
 }
 
-// NewStreamReader set up a restore stream pipeline.
+// NewStreamReader set up a restore stream pipeline, for zlib compressed streams.
 func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
+	return NewStreamReaderWithCompression(chunks, types.CompressionZlib)
+}
+
+// NewStreamReaderWithCompression set up a restore stream pipeline, for streams
+// compressed with the provided compression.
+func NewStreamReaderWithCompression(chunks <-chan io.ReadCloser, compression types.Compression) (*StreamReader, error) {
 	chunkReader := NewChunkReader(chunks)
-	zReader, err := zlib.NewReader(chunkReader)
+	zReader, err := newCompressionReader(chunkReader, compression)
 	if err != nil {
-		return nil, errors.Wrap(err, "zlib failure")
+		return nil, err
 	}
 	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
 	return &StreamReader{
@@ -110,3 +130,150 @@ func (sr *StreamReader) Close() error {
 	}
 	return err
 }
+
+// ValidateCompression returns an error if the compression is not supported.
+func ValidateCompression(compression types.Compression) error {
+	switch compression {
+	case types.CompressionZlib, types.CompressionZstd, types.CompressionNone:
+		return nil
+	default:
+		return errors.Wrapf(types.ErrInvalidMetadata, "unknown compression %v", compression)
+	}
+}
+
+// newCompressionWriter returns a writer compressing the data written to w.
+// Do not change the compression parameters without new snapshot format, the
+// output must be uniform across nodes.
+func newCompressionWriter(w io.Writer, compression types.Compression) (io.WriteCloser, error) {
+	switch compression {
+	case types.CompressionZlib:
+		zWriter, err := zlib.NewWriterLevel(w, snapshotCompressionLevel)
+		if err != nil {
+			return nil, errors.Wrap(err, "zlib failure")
+		}
+		return zWriter, nil
+
+	case types.CompressionZstd:
+		// a single goroutine keeps the output deterministic
+		zWriter, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedDefault), zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, errors.Wrap(err, "zstd failure")
+		}
+		return zWriter, nil
+
+	case types.CompressionNone:
+		return nopWriteCloser{w}, nil
+
+	default:
+		return nil, ValidateCompression(compression)
+	}
+}
+
+// newCompressionReader returns a reader decompressing the data read from r.
+func newCompressionReader(r io.Reader, compression types.Compression) (io.ReadCloser, error) {
+	switch compression {
+	case types.CompressionZlib:
+		zReader, err := zlib.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "zlib failure")
+		}
+		return zReader, nil
+
+	case types.CompressionZstd:
+		zReader, err := zstd.NewReader(r, zstd.WithDecoderMaxWindow(snapshotMaxZstdWindowSize))
+		if err != nil {
+			return nil, errors.Wrap(err, "zstd failure")
+		}
+		return zReader.IOReadCloser(), nil
+
+	case types.CompressionNone:
+		return io.NopCloser(r), nil
+
+	default:
+		return nil, ValidateCompression(compression)
+	}
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+// prefetchReader reads ahead the delimited protobuf messages of a StreamReader in a
+// background goroutine, so that the decompression of the stream is pipelined with
+// the restoration of its items. The goroutine owns the StreamReader, which is
+// closed once the stream is exhausted, fails or the prefetchReader is closed.
+type prefetchReader struct {
+	msgs <-chan []byte
+	done chan struct{}
+	// err is the error which ended the stream, it is safe to read after msgs is closed.
+	err error
+}
+
+var _ protoio.ReadCloser = (*prefetchReader)(nil)
+
+// newPrefetchReader starts reading ahead up to size messages from the StreamReader.
+func newPrefetchReader(sr *StreamReader, size int) *prefetchReader {
+	msgs := make(chan []byte, size)
+	pr := &prefetchReader{
+		msgs: msgs,
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(msgs)
+		defer sr.Close()
+
+		reader := bufio.NewReader(sr.zReader)
+		for {
+			msg, err := readDelimited(reader, snapshotMaxItemSize)
+			if err != nil {
+				pr.err = err
+				return
+			}
+			select {
+			case msgs <- msg:
+			case <-pr.done:
+				pr.err = io.ErrClosedPipe
+				return
+			}
+		}
+	}()
+
+	return pr
+}
+
+// ReadMsg implements protoio.Reader interface
+func (pr *prefetchReader) ReadMsg(msg proto.Message) error {
+	bz, ok := <-pr.msgs
+	if !ok {
+		return pr.err
+	}
+	return proto.Unmarshal(bz, msg)
+}
+
+// Close implements io.Closer interface. It does not wait for the background goroutine
+// to exit, which happens as soon as its current read of the stream returns.
+func (pr *prefetchReader) Close() error {
+	select {
+	case <-pr.done:
+	default:
+		close(pr.done)
+	}
+	return nil
+}
+
+// readDelimited reads a varint length-prefixed message, like the protoio delimited reader.
+func readDelimited(r *bufio.Reader, maxSize int) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > uint64(maxSize) {
+		return nil, fmt.Errorf("message of size %d exceeds the maximum of %d", length, maxSize)
+	}
+	bz := make([]byte, length)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
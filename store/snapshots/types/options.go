@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // SnapshotOptions defines the snapshot strategy used when determining which
 // heights are snapshotted for state sync.
 type SnapshotOptions struct {
@@ -8,6 +10,10 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Compression defines the compression algorithm of the snapshots taken,
+	// it is recorded in the snapshot metadata. Defaults to zlib.
+	Compression Compression
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -16,3 +22,18 @@ func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 		KeepRecent: keepRecent,
 	}
 }
+
+// ParseCompression returns the compression named zlib, zstd or none, as set in
+// the snapshot-compression option of app.toml. An empty name selects zlib.
+func ParseCompression(name string) (Compression, error) {
+	switch name {
+	case "", "zlib":
+		return CompressionZlib, nil
+	case "zstd":
+		return CompressionZstd, nil
+	case "none":
+		return CompressionNone, nil
+	default:
+		return 0, fmt.Errorf("unknown snapshot compression %q, expected zlib, zstd or none", name)
+	}
+}
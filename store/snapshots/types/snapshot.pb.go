@@ -23,6 +23,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Compression defines the compression algorithm used for the snapshot chunks stream.
+//
+// Since: cosmos-sdk 0.48
+type Compression int32
+
+const (
+	// COMPRESSION_ZLIB defines zlib compression, the default for backwards compatibility.
+	CompressionZlib Compression = 0
+	// COMPRESSION_ZSTD defines zstd compression.
+	CompressionZstd Compression = 1
+	// COMPRESSION_NONE defines an uncompressed stream.
+	CompressionNone Compression = 2
+)
+
+var Compression_name = map[int32]string{
+	0: "COMPRESSION_ZLIB",
+	1: "COMPRESSION_ZSTD",
+	2: "COMPRESSION_NONE",
+}
+
+var Compression_value = map[string]int32{
+	"COMPRESSION_ZLIB": 0,
+	"COMPRESSION_ZSTD": 1,
+	"COMPRESSION_NONE": 2,
+}
+
+func (x Compression) String() string {
+	return proto.EnumName(Compression_name, int32(x))
+}
+
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{0}
+}
+
 // Snapshot contains Tendermint state sync snapshot info.
 type Snapshot struct {
 	Height   uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -103,6 +137,10 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// compression is the compression algorithm of the snapshot chunks stream.
+	//
+	// Since: cosmos-sdk 0.48
+	Compression Compression `protobuf:"varint,2,opt,name=compression,proto3,enum=cosmos.store.snapshots.v1.Compression" json:"compression,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +183,13 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetCompression() Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionZlib
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -485,6 +530,7 @@ func (m *SnapshotExtensionPayload) GetPayload() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.store.snapshots.v1.Compression", Compression_name, Compression_value)
 	proto.RegisterType((*Snapshot)(nil), "cosmos.store.snapshots.v1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v1.Metadata")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
@@ -499,38 +545,44 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xb4, 0x74, 0x2f, 0x41, 0xea, 0xac, 0x81, 0x02, 0x87, 0x2c, 0x84, 0x03, 0x91,
-	0x80, 0x94, 0x65, 0x1c, 0xb9, 0x50, 0x98, 0x94, 0x09, 0x90, 0x26, 0x4f, 0xe2, 0xc0, 0x65, 0xf2,
-	0x56, 0xd3, 0x44, 0x6d, 0xe2, 0xaa, 0xf6, 0x22, 0xfa, 0x2f, 0xf8, 0x23, 0xfc, 0x8f, 0x1d, 0x77,
-	0xe4, 0x34, 0x50, 0xfb, 0x47, 0x90, 0xed, 0x26, 0xa0, 0x6d, 0x45, 0xdb, 0xed, 0x7d, 0x2f, 0xdf,
-	0xf7, 0xf9, 0xf9, 0xcb, 0x33, 0x44, 0xa7, 0x5c, 0x14, 0x5c, 0xf4, 0x85, 0xe4, 0x33, 0xd6, 0x17,
-	0x25, 0x9d, 0x8a, 0x8c, 0x4b, 0xd1, 0xaf, 0x76, 0x1b, 0x10, 0x4f, 0x67, 0x5c, 0x72, 0xfc, 0xc8,
-	0x30, 0x63, 0xcd, 0x8c, 0x1b, 0x66, 0x5c, 0xed, 0x3e, 0xde, 0x1e, 0xf1, 0x11, 0xd7, 0xac, 0xbe,
-	0xaa, 0x8c, 0x20, 0xfc, 0x81, 0xa0, 0x7b, 0xb4, 0xa2, 0xe1, 0x87, 0xd0, 0xc9, 0x58, 0x3e, 0xca,
-	0xa4, 0x87, 0x02, 0x14, 0xd9, 0x64, 0x85, 0x54, 0xff, 0x2b, 0x9f, 0x15, 0x54, 0x7a, 0x1b, 0x01,
-	0x8a, 0xee, 0x93, 0x15, 0x52, 0xfd, 0xd3, 0xec, 0xac, 0x1c, 0x0b, 0xaf, 0x65, 0xfa, 0x06, 0x61,
-	0x0c, 0x76, 0x46, 0x45, 0xe6, 0xd9, 0x01, 0x8a, 0x5c, 0xa2, 0x6b, 0xbc, 0x0f, 0xdd, 0x82, 0x49,
-	0x3a, 0xa4, 0x92, 0x7a, 0xed, 0x00, 0x45, 0x4e, 0xf2, 0x34, 0x5e, 0x3b, 0x6c, 0xfc, 0x69, 0x45,
-	0x1d, 0xd8, 0xe7, 0x97, 0x3b, 0x16, 0x69, 0xa4, 0xe1, 0x4b, 0xe8, 0xd6, 0xdf, 0xf0, 0x13, 0x70,
-	0xf5, 0x81, 0xc7, 0xea, 0x00, 0x26, 0x3c, 0x14, 0xb4, 0x22, 0x97, 0x38, 0xba, 0x97, 0xea, 0x56,
-	0xf8, 0x6b, 0x03, 0xdc, 0xfa, 0x7a, 0x07, 0x92, 0x15, 0xf8, 0x3d, 0xb4, 0xf5, 0x71, 0xfa, 0x86,
-	0x4e, 0xf2, 0xe2, 0x3f, 0x33, 0xd4, 0xba, 0x23, 0xf5, 0x49, 0x89, 0x53, 0x8b, 0x18, 0x31, 0xfe,
-	0x00, 0x76, 0x4e, 0xab, 0x89, 0x8e, 0xc3, 0x49, 0x9e, 0xdf, 0xc2, 0xe4, 0xe0, 0xed, 0xe7, 0x8f,
-	0xca, 0x63, 0xd0, 0x5d, 0x5c, 0xee, 0xd8, 0x0a, 0xa5, 0x16, 0xd1, 0x26, 0xf8, 0x10, 0x36, 0xd9,
-	0x37, 0xc9, 0x4a, 0x91, 0xf3, 0x52, 0x07, 0xe9, 0x24, 0xaf, 0x6e, 0xe1, 0xb8, 0x5f, 0x6b, 0x54,
-	0x1e, 0xa9, 0x45, 0xfe, 0x9a, 0xe0, 0x13, 0xd8, 0x6a, 0xc0, 0xf1, 0x94, 0xce, 0x27, 0x9c, 0x0e,
-	0xf5, 0xcf, 0x70, 0x92, 0xbd, 0xbb, 0x38, 0x1f, 0x1a, 0x69, 0x6a, 0x91, 0x1e, 0xbb, 0xd2, 0x1b,
-	0x74, 0xc0, 0xce, 0x25, 0x2b, 0xc2, 0x67, 0xb0, 0x75, 0x2d, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c,
-	0xc8, 0x9b, 0x44, 0xd7, 0xe1, 0x04, 0x7a, 0x57, 0xc3, 0xc0, 0x3d, 0x68, 0x8d, 0xd9, 0x5c, 0xd3,
-	0x5c, 0xa2, 0x4a, 0xbc, 0x0d, 0xed, 0x8a, 0x4e, 0xce, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f,
-	0xee, 0x55, 0x6c, 0xd6, 0x04, 0xd4, 0x22, 0x35, 0xfc, 0x67, 0x65, 0xd5, 0xfd, 0xda, 0xf5, 0xca,
-	0x86, 0xef, 0xe0, 0xc1, 0x8d, 0x41, 0xdd, 0x34, 0xda, 0xba, 0xfd, 0x0e, 0x5f, 0x83, 0xb7, 0x2e,
-	0x13, 0x35, 0x52, 0x9d, 0xac, 0x19, 0xbf, 0x86, 0x83, 0x37, 0xe7, 0x0b, 0x1f, 0x5d, 0x2c, 0x7c,
-	0xf4, 0x7b, 0xe1, 0xa3, 0xef, 0x4b, 0xdf, 0xba, 0x58, 0xfa, 0xd6, 0xcf, 0xa5, 0x6f, 0x7d, 0x09,
-	0x4d, 0xf6, 0x62, 0x38, 0x8e, 0x73, 0x7e, 0xed, 0x35, 0xcb, 0xf9, 0x94, 0x89, 0x93, 0x8e, 0x7e,
-	0x97, 0x7b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0xe9, 0x8e, 0x10, 0xf4, 0x03, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0x35, 0x4e, 0x49, 0xcf, 0x06, 0xdc, 0xa3, 0x20, 0xd3, 0xc1, 0x35, 0x46, 0x02, 0xf3,
+	0x43, 0x0e, 0x4d, 0x19, 0x59, 0x48, 0x1b, 0xc9, 0x11, 0x6d, 0x5a, 0x5d, 0x10, 0x43, 0x97, 0xca,
+	0x49, 0x8e, 0xd8, 0x4a, 0xec, 0x8b, 0x72, 0xd7, 0x40, 0xfe, 0x03, 0xc4, 0xc4, 0xc0, 0xca, 0xc4,
+	0xcc, 0xff, 0xd1, 0xb1, 0x23, 0x53, 0x41, 0xc9, 0x3f, 0x82, 0xee, 0x1c, 0xa7, 0x51, 0xd2, 0x54,
+	0x65, 0xfb, 0xde, 0xe7, 0xf7, 0xde, 0x7d, 0xf7, 0xf2, 0xe5, 0xa0, 0xdb, 0xa4, 0x2c, 0xa6, 0xac,
+	0xc8, 0x38, 0xed, 0x93, 0x22, 0x4b, 0x82, 0x1e, 0x0b, 0x29, 0x67, 0xc5, 0xc1, 0xf6, 0x14, 0x78,
+	0xbd, 0x3e, 0xe5, 0x14, 0x3d, 0x4c, 0x99, 0x9e, 0x64, 0x7a, 0x53, 0xa6, 0x37, 0xd8, 0xde, 0xdc,
+	0x68, 0xd3, 0x36, 0x95, 0xac, 0xa2, 0xa8, 0x52, 0x81, 0xf3, 0x0b, 0xc0, 0x42, 0x7d, 0x42, 0x43,
+	0x0f, 0xe0, 0x6a, 0x48, 0xa2, 0x76, 0xc8, 0x4d, 0x60, 0x03, 0x57, 0xc5, 0x13, 0x24, 0xfa, 0x1f,
+	0x69, 0x3f, 0x0e, 0xb8, 0xb9, 0x62, 0x03, 0xf7, 0x36, 0x9e, 0x20, 0xd1, 0x6f, 0x86, 0xa7, 0x49,
+	0x87, 0x99, 0xb9, 0xb4, 0x9f, 0x22, 0x84, 0xa0, 0x1a, 0x06, 0x2c, 0x34, 0x55, 0x1b, 0xb8, 0x3a,
+	0x96, 0x35, 0xaa, 0xc0, 0x42, 0x4c, 0x78, 0xd0, 0x0a, 0x78, 0x60, 0xe6, 0x6d, 0xe0, 0x6a, 0xa5,
+	0xc7, 0xde, 0xd2, 0x61, 0xbd, 0x83, 0x09, 0xb5, 0xac, 0x9e, 0x5d, 0x6c, 0x29, 0x78, 0x2a, 0x75,
+	0x3e, 0xc1, 0x42, 0xf6, 0x0d, 0x3d, 0x82, 0xba, 0x3c, 0xf0, 0x44, 0x1c, 0x40, 0x98, 0x09, 0xec,
+	0x9c, 0xab, 0x63, 0x4d, 0xf6, 0x7c, 0xd9, 0x42, 0x3e, 0xd4, 0x9a, 0x34, 0xee, 0xf5, 0x09, 0x63,
+	0x11, 0x4d, 0xe4, 0xf8, 0x77, 0x4a, 0x4f, 0xae, 0x39, 0x78, 0xf7, 0x92, 0x8d, 0x67, 0xa5, 0xce,
+	0x9f, 0x15, 0xa8, 0x67, 0x41, 0x55, 0x39, 0x89, 0xd1, 0x1e, 0xcc, 0x4b, 0xbd, 0xcc, 0x4a, 0x2b,
+	0xbd, 0xbc, 0xc6, 0x34, 0xd3, 0xd5, 0xc5, 0x27, 0x21, 0xf6, 0x15, 0x9c, 0x8a, 0xd1, 0x3b, 0xa8,
+	0x46, 0xc1, 0xa0, 0x2b, 0x27, 0xd3, 0x4a, 0x2f, 0x6e, 0x60, 0x52, 0x7d, 0xfb, 0x61, 0x5f, 0x78,
+	0x94, 0x0b, 0xa3, 0x8b, 0x2d, 0x55, 0x20, 0x5f, 0xc1, 0xd2, 0x04, 0x1d, 0xc1, 0x35, 0xf2, 0x99,
+	0x93, 0x44, 0xde, 0x35, 0x27, 0x1d, 0x5f, 0xdd, 0xc0, 0xb1, 0x92, 0x69, 0x44, 0xb2, 0xbe, 0x82,
+	0x2f, 0x4d, 0x50, 0x03, 0xae, 0x4f, 0xc1, 0x49, 0x2f, 0x18, 0x76, 0x69, 0xd0, 0x92, 0x3f, 0xab,
+	0x56, 0xda, 0xf9, 0x1f, 0xe7, 0xa3, 0x54, 0xea, 0x2b, 0xd8, 0x20, 0x73, 0xbd, 0xf2, 0x2a, 0x54,
+	0x23, 0x4e, 0x62, 0xe7, 0x29, 0x5c, 0x5f, 0x08, 0x4a, 0xac, 0x52, 0x12, 0xc4, 0x69, 0xc8, 0x6b,
+	0x58, 0xd6, 0x4e, 0x17, 0x1a, 0xf3, 0x61, 0x20, 0x03, 0xe6, 0x3a, 0x64, 0x28, 0x69, 0x3a, 0x16,
+	0x25, 0xda, 0x80, 0xf9, 0x41, 0xd0, 0x3d, 0x25, 0x32, 0x5a, 0x1d, 0xa7, 0x00, 0x99, 0xf0, 0xd6,
+	0x80, 0xf4, 0xa7, 0x01, 0xe5, 0x70, 0x06, 0x67, 0x96, 0x5f, 0xdc, 0x2f, 0x9f, 0x2d, 0xbf, 0xb3,
+	0x0b, 0xef, 0x5f, 0x19, 0xd4, 0x55, 0xa3, 0x2d, 0xfb, 0xa7, 0x38, 0xaf, 0xa1, 0xb9, 0x2c, 0x13,
+	0x31, 0x52, 0x96, 0x6c, 0x3a, 0x7e, 0x06, 0x9f, 0x7f, 0x07, 0x50, 0x9b, 0x59, 0x48, 0xf4, 0x0c,
+	0x1a, 0xbb, 0x87, 0x07, 0x47, 0xb8, 0x52, 0xaf, 0x57, 0x0f, 0x6b, 0x27, 0xc7, 0xfb, 0xd5, 0xb2,
+	0xa1, 0x6c, 0xde, 0xfb, 0xfa, 0xc3, 0xbe, 0x3b, 0x43, 0x3b, 0xee, 0x46, 0x8d, 0x05, 0x6a, 0xfd,
+	0xfd, 0x9e, 0x01, 0x16, 0xa9, 0x8c, 0xb7, 0xe6, 0xa9, 0xb5, 0xc3, 0x5a, 0xc5, 0x58, 0x59, 0xa0,
+	0xd6, 0x68, 0x42, 0x36, 0xd5, 0x2f, 0x3f, 0x2d, 0xa5, 0xfc, 0xe6, 0x6c, 0x64, 0x81, 0xf3, 0x91,
+	0x05, 0xfe, 0x8e, 0x2c, 0xf0, 0x6d, 0x6c, 0x29, 0xe7, 0x63, 0x4b, 0xf9, 0x3d, 0xb6, 0x94, 0x63,
+	0x27, 0x5d, 0x09, 0xd6, 0xea, 0x78, 0x11, 0x5d, 0x78, 0xae, 0xf8, 0xb0, 0x47, 0x58, 0x63, 0x55,
+	0x3e, 0x3c, 0x3b, 0xff, 0x06, 0x00, 0xac, 0x60, 0x35, 0xd3, 0xd5, 0x04, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -608,6 +660,11 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compression != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -925,6 +982,9 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.Compression != 0 {
+		n += 1 + sovSnapshot(uint64(m.Compression))
+	}
 	return n
 }
 
@@ -1294,6 +1354,25 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
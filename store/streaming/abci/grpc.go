@@ -0,0 +1,90 @@
+package abci
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ Listener = &GRPCClient{}
+
+// GRPCClient is the Listener implementation used by the node to forward the
+// ABCI messages and state changes to the plugin.
+type GRPCClient struct {
+	client ABCIListenerServiceClient
+}
+
+// ListenBeginBlock implements Listener.
+func (m *GRPCClient) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	_, err := m.client.ListenBeginBlock(ctx, &ListenBeginBlockRequest{Req: &req, Res: &res})
+	return err
+}
+
+// ListenEndBlock implements Listener.
+func (m *GRPCClient) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	_, err := m.client.ListenEndBlock(ctx, &ListenEndBlockRequest{Req: &req, Res: &res})
+	return err
+}
+
+// ListenDeliverTx implements Listener.
+func (m *GRPCClient) ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	_, err := m.client.ListenDeliverTx(ctx, &ListenDeliverTxRequest{BlockHeight: blockHeight, Req: &req, Res: &res})
+	return err
+}
+
+// ListenCommit implements Listener.
+func (m *GRPCClient) ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	_, err := m.client.ListenCommit(ctx, &ListenCommitRequest{BlockHeight: blockHeight, Res: &res, ChangeSet: changeSet})
+	return err
+}
+
+var _ ABCIListenerServiceServer = &GRPCServer{}
+
+// GRPCServer is the gRPC server the plugin uses to receive the ABCI messages
+// and state changes, which are forwarded to the Impl Listener.
+type GRPCServer struct {
+	// Impl is the concrete Listener implementation of the plugin.
+	Impl Listener
+}
+
+// ListenBeginBlock implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenBeginBlock(ctx context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	if err := m.Impl.ListenBeginBlock(ctx, derefOrZero(req.Req), derefOrZero(req.Res)); err != nil {
+		return nil, err
+	}
+	return &ListenBeginBlockResponse{}, nil
+}
+
+// ListenEndBlock implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenEndBlock(ctx context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	if err := m.Impl.ListenEndBlock(ctx, derefOrZero(req.Req), derefOrZero(req.Res)); err != nil {
+		return nil, err
+	}
+	return &ListenEndBlockResponse{}, nil
+}
+
+// ListenDeliverTx implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenDeliverTx(ctx context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	if err := m.Impl.ListenDeliverTx(ctx, req.BlockHeight, derefOrZero(req.Req), derefOrZero(req.Res)); err != nil {
+		return nil, err
+	}
+	return &ListenDeliverTxResponse{}, nil
+}
+
+// ListenCommit implements ABCIListenerServiceServer.
+func (m *GRPCServer) ListenCommit(ctx context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	if err := m.Impl.ListenCommit(ctx, req.BlockHeight, derefOrZero(req.Res), req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenCommitResponse{}, nil
+}
+
+// derefOrZero returns the value pointed by v, or the zero value if v is nil.
+func derefOrZero[T any](v *T) (x T) {
+	if v == nil {
+		return
+	}
+	return *v
+}
@@ -0,0 +1,71 @@
+// Package abci contains the ABCIListener gRPC plugin used to stream ABCI messages
+// and state changes to an external process, using hashicorp/go-plugin.
+package abci
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/store/types"
+)
+
+// PluginName is the name of the ABCIListener plugin in the plugin map.
+const PluginName = "abci"
+
+// Handshake is a common handshake that is shared by the node and the plugin.
+// It is used as a UX feature to detect binaries which are not plugins, it is
+// not a security measure.
+var Handshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "ABCI_LISTENER_PLUGIN",
+	MagicCookieValue: "ef78114d-7bdf-411c-868f-347c99a78345",
+}
+
+// PluginMap is the map of the plugins which can be dispensed by the node.
+var PluginMap = map[string]plugin.Plugin{
+	PluginName: &ListenerGRPCPlugin{},
+}
+
+// Listener is the interface which the external ABCIListener plugins implement.
+// Unlike types.ABCIListener, the state changes of the block are delivered
+// together with the Commit response, along with the height of the block.
+type Listener interface {
+	// ListenBeginBlock receives the BeginBlock request and response of the block.
+	ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock receives the EndBlock request and response of the block.
+	ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx receives the DeliverTx request and response of every tx of the block.
+	ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit receives the Commit response and the state changes of the block.
+	ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error
+}
+
+var _ plugin.GRPCPlugin = &ListenerGRPCPlugin{}
+
+// ListenerGRPCPlugin is the implementation of plugin.GRPCPlugin, it serves
+// the Impl Listener on the plugin side and dispenses a gRPC client
+// implementing Listener on the node side.
+type ListenerGRPCPlugin struct {
+	// GRPCPlugin must still implement the Plugin interface
+	plugin.Plugin
+	// Impl is the concrete implementation, only used by the plugin.
+	Impl Listener
+}
+
+// GRPCServer registers the Listener implementation on the plugin gRPC server.
+func (p *ListenerGRPCPlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	RegisterABCIListenerServiceServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+// GRPCClient returns the Listener gRPC client used by the node.
+func (p *ListenerGRPCPlugin) GRPCClient(
+	_ context.Context,
+	_ *plugin.GRPCBroker,
+	c *grpc.ClientConn,
+) (interface{}, error) {
+	return &GRPCClient{client: NewABCIListenerServiceClient(c)}, nil
+}
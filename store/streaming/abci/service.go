@@ -0,0 +1,187 @@
+package abci
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/hashicorp/go-plugin"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that forwards
+// the ABCI messages and the state changes of every block to a Listener, usually
+// the gRPC client of an external plugin process.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	listener       Listener                // the listener the ABCI messages and the state changes are forwarded to
+	logger         log.Logger
+
+	currentBlockNumber int64
+
+	// stopNodeOnErr, if true, will propagate the listener errors, which stops
+	// the node to ensure eventual consistency of the streamed output, otherwise,
+	// any errors are logged and ignored which could yield data loss.
+	stopNodeOnErr bool
+
+	// closer, if not nil, is called when the service is closed, e.g. to kill the plugin process.
+	closer func()
+}
+
+// NewStreamingService creates a new StreamingService which forwards the ABCI messages and
+// the state changes of the provided store keys to the listener. closer, if not nil, is
+// called when the service is closed.
+func NewStreamingService(
+	listener Listener,
+	storeKeys []types.StoreKey,
+	logger log.Logger,
+	stopNodeOnErr bool,
+	closer func(),
+) *StreamingService {
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	return &StreamingService{
+		storeListeners: listeners,
+		listener:       listener,
+		logger:         logger,
+		stopNodeOnErr:  stopNodeOnErr,
+		closer:         closer,
+	}
+}
+
+// NewPluginStreamingService launches the ABCIListener plugin binary found at pluginPath
+// and returns a StreamingService which forwards the ABCI messages and the state changes
+// of the provided store keys to it over gRPC. The plugin process is killed when the
+// service is closed.
+func NewPluginStreamingService(
+	pluginPath string,
+	storeKeys []types.StoreKey,
+	logger log.Logger,
+	stopNodeOnErr bool,
+) (*StreamingService, error) {
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          PluginMap,
+		Cmd:              exec.Command(pluginPath), //nolint:gosec // the plugin path is provided by the node operator
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Managed:          true,
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("failed to start the streaming plugin %s: %w", pluginPath, err)
+	}
+
+	raw, err := rpcClient.Dispense(PluginName)
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("failed to dispense the streaming plugin %s: %w", pluginPath, err)
+	}
+
+	listener, ok := raw.(Listener)
+	if !ok {
+		client.Kill()
+		return nil, fmt.Errorf("streaming plugin %s does not implement the abci Listener: %T", pluginPath, raw)
+	}
+
+	return NewStreamingService(listener, storeKeys, logger, stopNodeOnErr, client.Kill), nil
+}
+
+// Serve serves the provided Listener as an ABCIListener plugin, it must be called
+// by the main function of the plugin binary and blocks until the node exits.
+func Serve(listener Listener) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: map[string]plugin.Plugin{
+			PluginName: &ListenerGRPCPlugin{Impl: listener},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(s.storeListeners))
+	for _, listener := range s.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It forwards the BeginBlock
+// request and response to the listener and sets the current block number.
+func (s *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.currentBlockNumber = req.Header.Height
+	return s.handleErr("BeginBlock", s.listener.ListenBeginBlock(ctx, req, res))
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It forwards the DeliverTx
+// request and response to the listener.
+func (s *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return s.handleErr("DeliverTx", s.listener.ListenDeliverTx(ctx, s.currentBlockNumber, req, res))
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It forwards the EndBlock
+// request and response to the listener.
+func (s *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return s.handleErr("EndBlock", s.listener.ListenEndBlock(ctx, req, res))
+}
+
+// ListenCommit satisfies the ABCIListener interface. It forwards the Commit response
+// to the listener, together with the state changes of the block.
+func (s *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	var changeSet []*types.StoreKVPair
+	for _, listener := range s.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			changeSet = append(changeSet, &cache[i])
+		}
+	}
+
+	return s.handleErr("Commit", s.listener.ListenCommit(ctx, s.currentBlockNumber, res, changeSet))
+}
+
+// handleErr logs the listener error, which is returned only when stopNodeOnErr is set.
+func (s *StreamingService) handleErr(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	s.logger.Error(fmt.Sprintf("Listen %s failed", method), "height", s.currentBlockNumber, "err", err)
+	if s.stopNodeOnErr {
+		return err
+	}
+
+	return nil
+}
+
+// Stream satisfies the StreamingService interface. It performs a no-op.
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+// Close satisfies the StreamingService interface. It kills the plugin process, if any.
+func (s *StreamingService) Close() error {
+	if s.closer != nil {
+		s.closer()
+	}
+
+	return nil
+}
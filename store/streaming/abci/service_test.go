@@ -0,0 +1,133 @@
+package abci
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/types"
+)
+
+// mockListener is an in-process stand-in of an ABCIListener plugin, which
+// records the messages it receives.
+type mockListener struct {
+	err error
+
+	beginBlockReqs []abci.RequestBeginBlock
+	endBlockReqs   []abci.RequestEndBlock
+	deliverTxReqs  []abci.RequestDeliverTx
+	deliverTxRess  []abci.ResponseDeliverTx
+	deliverHeights []int64
+	commitHeights  []int64
+	commitRess     []abci.ResponseCommit
+	changeSets     [][]*types.StoreKVPair
+}
+
+func (m *mockListener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	m.beginBlockReqs = append(m.beginBlockReqs, req)
+	return m.err
+}
+
+func (m *mockListener) ListenEndBlock(_ context.Context, req abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	m.endBlockReqs = append(m.endBlockReqs, req)
+	return m.err
+}
+
+func (m *mockListener) ListenDeliverTx(_ context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	m.deliverHeights = append(m.deliverHeights, blockHeight)
+	m.deliverTxReqs = append(m.deliverTxReqs, req)
+	m.deliverTxRess = append(m.deliverTxRess, res)
+	return m.err
+}
+
+func (m *mockListener) ListenCommit(_ context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	m.commitHeights = append(m.commitHeights, blockHeight)
+	m.commitRess = append(m.commitRess, res)
+	m.changeSets = append(m.changeSets, changeSet)
+	return m.err
+}
+
+// dispense serves the listener as an in-process plugin and returns the gRPC client dispensed by the node.
+func dispense(t *testing.T, listener Listener) Listener {
+	t.Helper()
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		PluginName: &ListenerGRPCPlugin{Impl: listener},
+	})
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense(PluginName)
+	require.NoError(t, err)
+	grpcListener, ok := raw.(Listener)
+	require.True(t, ok)
+	require.IsType(t, &GRPCClient{}, grpcListener)
+	return grpcListener
+}
+
+func TestStreamingService(t *testing.T) {
+	ctx := context.Background()
+	key1, key2 := types.NewKVStoreKey("key1"), types.NewKVStoreKey("key2")
+	mock := &mockListener{}
+
+	closed := false
+	service := NewStreamingService(dispense(t, mock), []types.StoreKey{key2, key1}, log.NewNopLogger(), true, func() { closed = true })
+
+	listeners := service.Listeners()
+	require.Len(t, listeners, 2)
+	listeners[key1][0].OnWrite(key1, []byte("k1"), []byte("v1"), false)
+	listeners[key2][0].OnWrite(key2, []byte("k2"), nil, true)
+
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: cmtproto.Header{Height: 5}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{Code: 1, Log: "failed"}))
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 5}, abci.ResponseEndBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{Data: []byte{1}}))
+
+	require.Len(t, mock.beginBlockReqs, 1)
+	require.Equal(t, int64(5), mock.beginBlockReqs[0].Header.Height)
+	require.Equal(t, []int64{5}, mock.deliverHeights)
+	require.Equal(t, []byte("tx"), mock.deliverTxReqs[0].Tx)
+	require.Equal(t, uint32(1), mock.deliverTxRess[0].Code)
+	require.Equal(t, "failed", mock.deliverTxRess[0].Log)
+	require.Equal(t, int64(5), mock.endBlockReqs[0].Height)
+	require.Equal(t, []int64{5}, mock.commitHeights)
+	require.Equal(t, []byte{1}, mock.commitRess[0].Data)
+
+	// the change set is ordered by store key name
+	require.Len(t, mock.changeSets[0], 2)
+	require.Equal(t, types.StoreKVPair{StoreKey: "key1", Key: []byte("k1"), Value: []byte("v1")}, *mock.changeSets[0][0])
+	require.Equal(t, types.StoreKVPair{StoreKey: "key2", Delete: true, Key: []byte("k2")}, *mock.changeSets[0][1])
+
+	// the state cache is reset after commit
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}))
+	require.Empty(t, mock.changeSets[1])
+
+	require.NoError(t, service.Close())
+	require.True(t, closed)
+}
+
+func TestStreamingServiceStopNodeOnErr(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockListener{err: errors.New("indexer unavailable")}
+	grpcListener := dispense(t, mock)
+
+	// errors are propagated when stopNodeOnErr is set
+	service := NewStreamingService(grpcListener, nil, log.NewNopLogger(), true, nil)
+	require.ErrorContains(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}), "indexer unavailable")
+	require.ErrorContains(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}), "indexer unavailable")
+	require.ErrorContains(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}), "indexer unavailable")
+	require.ErrorContains(t, service.ListenCommit(ctx, abci.ResponseCommit{}), "indexer unavailable")
+	require.NoError(t, service.Close())
+
+	// errors are logged and ignored otherwise
+	service = NewStreamingService(grpcListener, nil, log.NewNopLogger(), false, nil)
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}))
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}))
+	require.Len(t, mock.commitHeights, 2)
+}
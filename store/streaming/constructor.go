@@ -8,9 +8,12 @@ import (
 	"sync"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/streaming/abci"
 	"cosmossdk.io/store/streaming/file"
 	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cast"
 )
 
@@ -29,6 +32,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
+	VersionDB
 )
 
 // Streaming option keys
@@ -39,7 +44,16 @@ const (
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
 
+	OptStreamersGRPCPlugin          = "streamers.grpc.plugin"
+	OptStreamersGRPCStopNodeOnError = "streamers.grpc.stop-node-on-error"
+
+	OptStreamersVersionDBKeys = "streamers.versiondb.keys"
+	OptStreamersVersionDBDir  = "streamers.versiondb.dir"
+
 	OptStoreStreamers = "store.streamers"
+
+	// optAppDBBackend is the db backend of the application, also used by the version store.
+	optAppDBBackend = "app-db-backend"
 )
 
 // ServiceTypeFromString returns the streaming.ServiceType corresponding to the
@@ -49,6 +63,12 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "grpc", "g":
+		return GRPC
+
+	case "versiondb":
+		return VersionDB
+
 	default:
 		return Unknown
 	}
@@ -60,6 +80,12 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case GRPC:
+		return "grpc"
+
+	case VersionDB:
+		return "versiondb"
+
 	default:
 		return "unknown"
 	}
@@ -68,7 +94,9 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:      NewFileStreamingService,
+	GRPC:      NewGRPCStreamingService,
+	VersionDB: NewVersionDBStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -116,6 +144,69 @@ func NewFileStreamingService(
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, logger, outputMetadata, stopNodeOnErr, fsync)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a StreamingService which forwards the ABCI messages and the state
+// changes to an external ABCIListener plugin process over gRPC.
+func NewGRPCStreamingService(
+	opts AppOptions,
+	keys []types.StoreKey,
+	_ types.Codec,
+	logger log.Logger,
+	homePath string,
+) (types.StreamingService, error) {
+	pluginPath := cast.ToString(opts.Get(OptStreamersGRPCPlugin))
+	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersGRPCStopNodeOnError))
+
+	if pluginPath == "" {
+		return nil, fmt.Errorf("the %s option is required by the grpc streaming service", OptStreamersGRPCPlugin)
+	}
+
+	// relative path is based on node home directory.
+	if !path.IsAbs(pluginPath) {
+		pluginPath = path.Join(homePath, pluginPath)
+	}
+
+	return abci.NewPluginStreamingService(pluginPath, keys, logger, stopNodeOnErr)
+}
+
+// NewVersionDBStreamingService is the streaming.ServiceConstructor function for
+// creating a StreamingService which writes the state changes of every block to a
+// versiondb.Store, serving the queries of the heights pruned from the multistore.
+// The store is saved in the db of the app-db-backend in the directory of the
+// streamers.versiondb.dir option, data/versiondb of the node home by default.
+func NewVersionDBStreamingService(
+	opts AppOptions,
+	keys []types.StoreKey,
+	_ types.Codec,
+	logger log.Logger,
+	homePath string,
+) (types.StreamingService, error) {
+	// the version store must have the whole state of the multistore
+	if !types.SliceContains(cast.ToStringSlice(opts.Get(OptStreamersVersionDBKeys)), "*") {
+		return nil, fmt.Errorf("the versiondb streaming service requires %s to be [\"*\"]", OptStreamersVersionDBKeys)
+	}
+
+	dir := cast.ToString(opts.Get(OptStreamersVersionDBDir))
+	if dir == "" {
+		dir = path.Join("data", "versiondb")
+	}
+	// relative path is based on node home directory.
+	if !path.IsAbs(dir) {
+		dir = path.Join(homePath, dir)
+	}
+
+	backend := dbm.BackendType(cast.ToString(opts.Get(optAppDBBackend)))
+	if backend == "" {
+		backend = dbm.GoLevelDBBackend
+	}
+	db, err := dbm.NewDB("versiondb", backend, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return versiondb.NewStreamingService(versiondb.NewStore(db), keys, logger), nil
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the
 // BaseApp using the provided AppOptions, codec, and keys. It returns the
 // WaitGroup and quit channel used to synchronize with the streaming services
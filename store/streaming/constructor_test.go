@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/store/streaming"
 	"cosmossdk.io/store/streaming/file"
 	"cosmossdk.io/store/types"
+	"cosmossdk.io/store/versiondb"
 )
 
 type fakeOptions struct{}
@@ -45,6 +46,41 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.NoError(t, err)
+
+	// the plugin option is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller, log.NewNopLogger(), "path/to/data")
+	require.ErrorContains(t, err, streaming.OptStreamersGRPCPlugin)
+}
+
+func TestVersionDBStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("versiondb")
+	require.NoError(t, err)
+
+	// the version store must listen to every store
+	_, err = constructor(mockOptions, mockKeys, testMarshaller, log.NewNopLogger(), "path/to/data")
+	require.ErrorContains(t, err, streaming.OptStreamersVersionDBKeys)
+
+	opts := mapOptions{
+		streaming.OptStreamersVersionDBKeys: []string{"*"},
+		streaming.OptStreamersVersionDBDir:  t.TempDir(),
+		"app-db-backend":                    "memdb",
+	}
+	serv, err := constructor(opts, mockKeys, testMarshaller, log.NewNopLogger(), "path/to/data")
+	require.NoError(t, err)
+	require.IsType(t, &versiondb.StreamingService{}, serv)
+	require.Len(t, serv.Listeners(), len(mockKeys))
+	require.NoError(t, serv.Close())
+}
+
+type mapOptions map[string]interface{}
+
+func (o mapOptions) Get(key string) interface{} {
+	return o[key]
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	encCdc := types.NewTestCodec()
 	keys := types.NewKVStoreKeys("mockKey1", "mockKey2")
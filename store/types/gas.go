@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"math"
+	"sort"
 )
 
 // Gas consumption descriptors.
@@ -217,6 +218,122 @@ func (g *infiniteGasMeter) String() string {
 	return fmt.Sprintf("InfiniteGasMeter:\n  consumed: %d", g.consumed)
 }
 
+// GasOperation is the kind of store operation consuming gas.
+type GasOperation uint8
+
+const (
+	// GasOperationRead is a Get or Has of a key.
+	GasOperationRead GasOperation = iota
+	// GasOperationWrite is a Set or Delete of a key.
+	GasOperationWrite
+	// GasOperationIter is an iterator moving to the next key.
+	GasOperationIter
+	// GasOperationSeek is an iterator being created and seeking its first key.
+	GasOperationSeek
+)
+
+func (op GasOperation) String() string {
+	switch op {
+	case GasOperationRead:
+		return "read"
+	case GasOperationWrite:
+		return "write"
+	case GasOperationIter:
+		return "iter"
+	case GasOperationSeek:
+		return "seek"
+	default:
+		return fmt.Sprintf("GasOperation(%d)", uint8(op))
+	}
+}
+
+// StoreGasConsumed is the gas consumed by an operation on a store.
+type StoreGasConsumed struct {
+	StoreName string
+	Operation GasOperation
+	Gas       Gas
+}
+
+type storeGasKey struct {
+	storeName string
+	operation GasOperation
+}
+
+// GasAttribution records the gas consumed by every store and operation.
+// It is not safe for concurrent use.
+type GasAttribution struct {
+	consumed map[storeGasKey]Gas
+}
+
+// NewGasAttribution returns a new empty GasAttribution.
+func NewGasAttribution() *GasAttribution {
+	return &GasAttribution{consumed: make(map[storeGasKey]Gas)}
+}
+
+// Add attributes the gas amount to the store and operation, the totals saturate
+// at the maximum amount of gas instead of overflowing.
+func (a *GasAttribution) Add(storeName string, operation GasOperation, amount Gas) {
+	key := storeGasKey{storeName: storeName, operation: operation}
+	total, overflow := addUint64Overflow(a.consumed[key], amount)
+	if overflow {
+		total = math.MaxUint64
+	}
+	a.consumed[key] = total
+}
+
+// Consumed returns the gas consumed by every store and operation, ordered by
+// store name and operation.
+func (a *GasAttribution) Consumed() []StoreGasConsumed {
+	consumed := make([]StoreGasConsumed, 0, len(a.consumed))
+	for key, gas := range a.consumed {
+		consumed = append(consumed, StoreGasConsumed{StoreName: key.storeName, Operation: key.operation, Gas: gas})
+	}
+	sort.Slice(consumed, func(i, j int) bool {
+		if consumed[i].StoreName != consumed[j].StoreName {
+			return consumed[i].StoreName < consumed[j].StoreName
+		}
+		return consumed[i].Operation < consumed[j].Operation
+	})
+	return consumed
+}
+
+// AttributingGasMeter is a GasMeter which also attributes the gas consumed by the
+// stores to the store and the operation consuming it.
+type AttributingGasMeter interface {
+	GasMeter
+	// ConsumeStoreGas consumes the gas like ConsumeGas, attributing it to the store and operation.
+	ConsumeStoreGas(amount Gas, descriptor string, storeName string, operation GasOperation)
+	// GasAttribution returns the attribution the gas is recorded into.
+	GasAttribution() *GasAttribution
+}
+
+type attributingGasMeter struct {
+	GasMeter
+	attribution *GasAttribution
+}
+
+// NewAttributingGasMeter returns an AttributingGasMeter consuming the gas from the parent
+// meter and recording its attribution into the provided GasAttribution, which can be
+// shared by the meters replacing each other during the execution of a transaction.
+func NewAttributingGasMeter(parent GasMeter, attribution *GasAttribution) AttributingGasMeter {
+	return &attributingGasMeter{
+		GasMeter:    parent,
+		attribution: attribution,
+	}
+}
+
+// ConsumeStoreGas records the gas before consuming it from the parent meter, so that the
+// gas which runs the meter out of gas is attributed too.
+func (g *attributingGasMeter) ConsumeStoreGas(amount Gas, descriptor string, storeName string, operation GasOperation) {
+	g.attribution.Add(storeName, operation, amount)
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// GasAttribution returns the attribution the gas is recorded into.
+func (g *attributingGasMeter) GasAttribution() *GasAttribution {
+	return g.attribution
+}
+
 // GasConfig defines gas cost for each operation on KVStores
 type GasConfig struct {
 	HasCost          Gas
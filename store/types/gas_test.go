@@ -82,6 +82,38 @@ func TestGasMeter(t *testing.T) {
 	}
 }
 
+func TestGasAttribution(t *testing.T) {
+	t.Parallel()
+	attribution := NewGasAttribution()
+	require.Empty(t, attribution.Consumed())
+
+	meter := NewAttributingGasMeter(NewGasMeter(100), attribution)
+	meter.ConsumeStoreGas(10, "write", "bank", GasOperationWrite)
+	meter.ConsumeStoreGas(20, "read", "acc", GasOperationRead)
+	meter.ConsumeStoreGas(5, "write", "bank", GasOperationWrite)
+	meter.ConsumeGas(7, "not attributed")
+	require.Equal(t, Gas(42), meter.GasConsumed())
+
+	// the gas running the meter out of gas is attributed too
+	require.Panics(t, func() { meter.ConsumeStoreGas(100, "read", "bank", GasOperationRead) })
+	require.True(t, meter.IsPastLimit())
+
+	// a meter replacing another one keeps recording into the same attribution
+	NewAttributingGasMeter(NewInfiniteGasMeter(), meter.GasAttribution()).ConsumeStoreGas(1, "seek", "acc", GasOperationSeek)
+
+	attribution.Add("acc", GasOperationIter, math.MaxUint64)
+	attribution.Add("acc", GasOperationIter, 1)
+
+	require.Equal(t, []StoreGasConsumed{
+		{StoreName: "acc", Operation: GasOperationRead, Gas: 20},
+		{StoreName: "acc", Operation: GasOperationIter, Gas: math.MaxUint64},
+		{StoreName: "acc", Operation: GasOperationSeek, Gas: 1},
+		{StoreName: "bank", Operation: GasOperationRead, Gas: 100},
+		{StoreName: "bank", Operation: GasOperationWrite, Gas: 15},
+	}, attribution.Consumed())
+	require.Equal(t, "seek", GasOperationSeek.String())
+}
+
 func TestAddUint64Overflow(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
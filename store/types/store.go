@@ -138,6 +138,26 @@ type CacheMultiStore interface {
 	Write() // Writes operations to underlying KVStore
 }
 
+// ReadTrackingMultiStore is a MultiStore which can be branched into a CacheMultiStore
+// recording the values it reads from the MultiStore.
+type ReadTrackingMultiStore interface {
+	MultiStore
+
+	// CacheMultiStoreWithReadTracking branches the MultiStore into a store recording
+	// its reads.
+	CacheMultiStoreWithReadTracking() ReadTrackedCacheMultiStore
+}
+
+// ReadTrackedCacheMultiStore is a CacheMultiStore recording the values it reads from
+// its parent, so that it can be checked to be valid against a changed parent.
+type ReadTrackedCacheMultiStore interface {
+	CacheMultiStore
+
+	// ValidateReads returns true if the parent still holds all the values read
+	// through the store.
+	ValidateReads() bool
+}
+
 // CommitMultiStore is an interface for a MultiStore without cache capabilities.
 type CommitMultiStore interface {
 	Committer
@@ -203,6 +223,45 @@ type CommitMultiStore interface {
 
 	// SetMetrics sets the metrics for the KVStore
 	SetMetrics(metrics metrics.StoreMetrics)
+
+	// SetRestoreConcurrency sets the maximum number of stores imported concurrently
+	// while restoring a snapshot.
+	SetRestoreConcurrency(concurrency int)
+
+	// SetAsyncPruning enables/disables the pruning of the heights in a background worker
+	// instead of during Commit.
+	SetAsyncPruning(enabled bool)
+
+	// Close stops the background workers of the store, waiting for the running work to finish.
+	Close() error
+
+	// SetVersionStore sets the version store serving the queries of the versions which are
+	// no longer available in the KVStores.
+	SetVersionStore(store VersionStore)
+}
+
+// VersionStore is an append-only store of the versions of the KVStores of a multistore,
+// written from the changes of every version. It serves the state of the past versions
+// independently of the pruning of the multistore.
+type VersionStore interface {
+	// GetLatestVersion returns the latest version written to the store, 0 if it is empty.
+	GetLatestVersion() (int64, error)
+
+	// GetEarliestVersion returns the first version written to the store, 0 if it is empty.
+	GetEarliestVersion() (int64, error)
+
+	// PutAtVersion writes the changes of the KVStores at the version, which must not be
+	// lower than the latest version.
+	PutAtVersion(version int64, changeSet []*StoreKVPair) error
+
+	// Import writes the full state of the KVStores at the version to the empty store, the
+	// iterators return the pairs of the KVStores by name. The version is recorded once all
+	// the pairs are written, so an interrupted import can be run again.
+	Import(version int64, stores map[string]Iterator) error
+
+	// KVStoreAtVersion returns a read-only KVStore of the state of the KVStore with the
+	// name at the version.
+	KVStoreAtVersion(storeKey string, version int64) KVStore
 }
 
 //---------subsp-------------------------------
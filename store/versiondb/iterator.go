@@ -0,0 +1,127 @@
+package versiondb
+
+import (
+	"bytes"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.Iterator = (*versionIterator)(nil)
+
+// versionIterator iterates over the keys of a store at a version. The changes of a key
+// are next to each other in the db, it returns the latest change up to the version of
+// every key which is set at the version.
+type versionIterator struct {
+	iter       dbm.Iterator
+	prefix     []byte
+	start, end []byte
+	version    int64
+
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+func newVersionIterator(iter dbm.Iterator, prefix, start, end []byte, version int64) *versionIterator {
+	vi := &versionIterator{
+		iter:    iter,
+		prefix:  prefix,
+		start:   start,
+		end:     end,
+		version: version,
+	}
+	vi.next()
+	return vi
+}
+
+// next moves to the next key set at the version.
+func (vi *versionIterator) next() {
+	vi.valid = false
+	for vi.iter.Valid() {
+		key, value, found, err := vi.nextKey()
+		if err != nil {
+			vi.err = err
+			return
+		}
+		if found && value != nil {
+			vi.key, vi.value, vi.valid = key, value, true
+			return
+		}
+	}
+	vi.err = vi.iter.Error()
+}
+
+// nextKey consumes the changes of the current key of the db iterator, and returns the
+// latest one up to the version, found is false if the key has no change up to it.
+func (vi *versionIterator) nextKey() (key, value []byte, found bool, err error) {
+	key, _, err = parseDataKey(vi.prefix, vi.iter.Key())
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	var latest int64
+	for ; vi.iter.Valid(); vi.iter.Next() {
+		changeKey, version, err := parseDataKey(vi.prefix, vi.iter.Key())
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if !bytes.Equal(changeKey, key) {
+			break
+		}
+		if version > vi.version || (found && version < latest) {
+			continue
+		}
+		value, err = decodeValue(vi.iter.Value())
+		if err != nil {
+			return nil, nil, false, err
+		}
+		latest, found = version, true
+	}
+	return key, value, found, nil
+}
+
+// Domain implements types.Iterator.
+func (vi *versionIterator) Domain() (start, end []byte) {
+	return vi.start, vi.end
+}
+
+// Valid implements types.Iterator.
+func (vi *versionIterator) Valid() bool {
+	return vi.valid
+}
+
+// Next implements types.Iterator.
+func (vi *versionIterator) Next() {
+	if !vi.valid {
+		panic("iterator is invalid")
+	}
+	vi.next()
+}
+
+// Key implements types.Iterator.
+func (vi *versionIterator) Key() []byte {
+	if !vi.valid {
+		panic("iterator is invalid")
+	}
+	return vi.key
+}
+
+// Value implements types.Iterator.
+func (vi *versionIterator) Value() []byte {
+	if !vi.valid {
+		panic("iterator is invalid")
+	}
+	return vi.value
+}
+
+// Error implements types.Iterator.
+func (vi *versionIterator) Error() error {
+	return vi.err
+}
+
+// Close implements types.Iterator.
+func (vi *versionIterator) Close() error {
+	return vi.iter.Close()
+}
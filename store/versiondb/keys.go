@@ -0,0 +1,115 @@
+package versiondb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// The data of the store is saved under
+//
+//	prefixData | uvarint(len(storeKey)) | storeKey | escape(key) | keyTerminator | version
+//
+// with the version big endian encoded. The escaping of the keys preserves their order,
+// and no escaped key is a prefix of another one, so the changes of a key are sorted by
+// version next to each other, and the keys are sorted as in the KVStore.
+var (
+	prefixData     = []byte{'d'}
+	keyLatest      = []byte("m/latest")
+	keyEarliest    = []byte("m/earliest")
+	escapedZero    = []byte{0x00, 0xff}
+	keyTerminator  = []byte{0x00, 0x01}
+	errInvalidData = errors.New("invalid version store data key")
+	errKeyEmpty    = errors.New("key cannot be empty")
+)
+
+const versionLength = 8
+
+// The values are prefixed by a byte telling if the key is set or deleted.
+const (
+	valueDeleted byte = iota
+	valueSet
+)
+
+// storePrefix returns the prefix of the data of the store.
+func storePrefix(storeKey string) []byte {
+	prefix := make([]byte, 0, len(prefixData)+binary.MaxVarintLen64+len(storeKey))
+	prefix = append(prefix, prefixData...)
+	prefix = binary.AppendUvarint(prefix, uint64(len(storeKey)))
+	return append(prefix, storeKey...)
+}
+
+// escapeKey appends the escaped key to dst.
+func escapeKey(dst, key []byte) []byte {
+	for _, b := range key {
+		if b == 0 {
+			dst = append(dst, escapedZero...)
+		} else {
+			dst = append(dst, b)
+		}
+	}
+	return dst
+}
+
+// dataKey returns the key of the value of the key of the store at the version.
+func dataKey(prefix, key []byte, version int64) []byte {
+	bz := make([]byte, 0, len(prefix)+len(key)+len(keyTerminator)+versionLength)
+	bz = append(bz, prefix...)
+	bz = escapeKey(bz, key)
+	bz = append(bz, keyTerminator...)
+	return binary.BigEndian.AppendUint64(bz, uint64(version))
+}
+
+// parseDataKey returns the key and the version of a data key of the store with the prefix.
+func parseDataKey(prefix, bz []byte) ([]byte, int64, error) {
+	if !bytes.HasPrefix(bz, prefix) || len(bz) < len(prefix)+len(keyTerminator)+versionLength {
+		return nil, 0, errInvalidData
+	}
+	escaped := bz[len(prefix) : len(bz)-versionLength]
+	version := int64(binary.BigEndian.Uint64(bz[len(bz)-versionLength:]))
+
+	key := make([]byte, 0, len(escaped))
+	for i := 0; i < len(escaped); i++ {
+		if escaped[i] != 0 {
+			key = append(key, escaped[i])
+			continue
+		}
+		if i+1 >= len(escaped) {
+			return nil, 0, errInvalidData
+		}
+		switch escaped[i+1] {
+		case escapedZero[1]:
+			key = append(key, 0)
+			i++
+		case keyTerminator[1]:
+			if i+2 != len(escaped) {
+				return nil, 0, errInvalidData
+			}
+			return key, version, nil
+		default:
+			return nil, 0, errInvalidData
+		}
+	}
+	return nil, 0, errInvalidData
+}
+
+// encodeValue returns the stored value of a change.
+func encodeValue(value []byte, deleted bool) []byte {
+	if deleted {
+		return []byte{valueDeleted}
+	}
+	bz := make([]byte, 0, len(value)+1)
+	bz = append(bz, valueSet)
+	return append(bz, value...)
+}
+
+// decodeValue returns the value of a stored change, nil if the key is deleted.
+func decodeValue(bz []byte) ([]byte, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("invalid version store value")
+	}
+	if bz[0] == valueDeleted {
+		return nil, nil
+	}
+	return bz[1:], nil
+}
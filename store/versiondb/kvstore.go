@@ -0,0 +1,77 @@
+package versiondb
+
+import (
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = (*KVStore)(nil)
+
+// KVStore is a read-only KVStore of the state of a store at a version of the version
+// store. Any mutable operation results in a panic.
+type KVStore struct {
+	store    *Store
+	storeKey string
+	version  int64
+}
+
+// GetStoreType implements types.Store.
+func (s *KVStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements types.Store.
+func (s *KVStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements types.Store.
+func (s *KVStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements types.KVStore.
+func (s *KVStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+	value, err := s.store.GetAtVersion(s.storeKey, key, s.version)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements types.KVStore.
+func (s *KVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements types.KVStore, it panics as the store is read-only.
+func (s *KVStore) Set(_, _ []byte) {
+	panic("cannot write to a version store")
+}
+
+// Delete implements types.KVStore, it panics as the store is read-only.
+func (s *KVStore) Delete(_ []byte) {
+	panic("cannot delete from a version store")
+}
+
+// Iterator implements types.KVStore.
+func (s *KVStore) Iterator(start, end []byte) types.Iterator {
+	iter, err := s.store.IteratorAtVersion(s.storeKey, start, end, s.version)
+	if err != nil {
+		panic(err)
+	}
+	return iter
+}
+
+// ReverseIterator implements types.KVStore.
+func (s *KVStore) ReverseIterator(start, end []byte) types.Iterator {
+	iter, err := s.store.ReverseIteratorAtVersion(s.storeKey, start, end, s.version)
+	if err != nil {
+		panic(err)
+	}
+	return iter
+}
@@ -0,0 +1,69 @@
+package versiondb
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	"cosmossdk.io/store/types"
+)
+
+var _ streamingabci.Listener = (*Listener)(nil)
+
+// Listener is a streaming Listener writing the state changes of every block to the
+// version store, at the height of the block.
+type Listener struct {
+	store *Store
+}
+
+// NewListener returns a Listener writing the state changes to the store.
+func NewListener(store *Store) *Listener {
+	return &Listener{store: store}
+}
+
+// ListenBeginBlock implements abci.Listener. It performs a no-op.
+func (l *Listener) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements abci.Listener. It performs a no-op.
+func (l *Listener) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements abci.Listener. It performs a no-op.
+func (l *Listener) ListenDeliverTx(context.Context, int64, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements abci.Listener. It writes the state changes of the block.
+func (l *Listener) ListenCommit(_ context.Context, blockHeight int64, _ abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	return l.store.PutAtVersion(blockHeight, changeSet)
+}
+
+// StreamingService is the streaming service feeding the version store with the state
+// changes of the blocks. The store must listen to every KVStore of the multistore.
+type StreamingService struct {
+	*streamingabci.StreamingService
+
+	store *Store
+}
+
+// NewStreamingService returns a streaming service writing the state changes of the
+// store keys to the store, the store is closed with the service. The errors of the
+// store are returned, stopping the node, as the store must not miss a block.
+func NewStreamingService(store *Store, storeKeys []types.StoreKey, logger log.Logger) *StreamingService {
+	return &StreamingService{
+		StreamingService: streamingabci.NewStreamingService(
+			NewListener(store), storeKeys, logger, true, func() { _ = store.Close() },
+		),
+		store: store,
+	}
+}
+
+// VersionStore returns the version store fed by the service.
+func (s *StreamingService) VersionStore() types.VersionStore {
+	return s.store
+}
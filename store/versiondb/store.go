@@ -0,0 +1,210 @@
+package versiondb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/types"
+)
+
+// importBatchSize is the number of pairs written in a batch by Import.
+const importBatchSize = 10000
+
+var _ types.VersionStore = (*Store)(nil)
+
+// Store is an append-only store of the versions of the KVStores of a multistore. Every
+// version saves the changes of the KVStores, and a key is read at a version from its
+// latest change up to the version, so every past version can be queried without keeping
+// the IAVL trees of the multistore.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns a version store saving its data in the db.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// GetLatestVersion implements types.VersionStore.
+func (s *Store) GetLatestVersion() (int64, error) {
+	return s.getVersion(keyLatest)
+}
+
+// GetEarliestVersion implements types.VersionStore.
+func (s *Store) GetEarliestVersion() (int64, error) {
+	return s.getVersion(keyEarliest)
+}
+
+func (s *Store) getVersion(key []byte) (int64, error) {
+	bz, err := s.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != versionLength {
+		return 0, fmt.Errorf("invalid version store metadata %q", key)
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// PutAtVersion implements types.VersionStore. The later changes of a key in the change
+// set override the earlier ones.
+func (s *Store) PutAtVersion(version int64, changeSet []*types.StoreKVPair) error {
+	latest, err := s.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version <= 0 || version < latest {
+		return fmt.Errorf("cannot write version %d to the version store at version %d", version, latest)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	prefixes := make(map[string][]byte)
+	for _, pair := range changeSet {
+		prefix, ok := prefixes[pair.StoreKey]
+		if !ok {
+			prefix = storePrefix(pair.StoreKey)
+			prefixes[pair.StoreKey] = prefix
+		}
+		if err := batch.Set(dataKey(prefix, pair.Key, version), encodeValue(pair.Value, pair.Delete)); err != nil {
+			return err
+		}
+	}
+	if err := s.setVersions(batch, version, latest == 0); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Import implements types.VersionStore.
+func (s *Store) Import(version int64, stores map[string]types.Iterator) error {
+	latest, err := s.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version <= 0 || latest != 0 {
+		return fmt.Errorf("cannot import version %d to the version store at version %d", version, latest)
+	}
+
+	names := make([]string, 0, len(stores))
+	for name := range stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// the batches are written as they fill up, the versions are only recorded by the last one
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+
+	size := 0
+	for _, name := range names {
+		prefix := storePrefix(name)
+		iter := stores[name]
+		for ; iter.Valid(); iter.Next() {
+			if err := batch.Set(dataKey(prefix, iter.Key(), version), encodeValue(iter.Value(), false)); err != nil {
+				return err
+			}
+			if size++; size < importBatchSize {
+				continue
+			}
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			_ = batch.Close()
+			batch, size = s.db.NewBatch(), 0
+		}
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.setVersions(batch, version, true); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// setVersions records the version as the latest one, and the earliest one if it is the
+// first version of the store.
+func (s *Store) setVersions(batch dbm.Batch, version int64, first bool) error {
+	bz := binary.BigEndian.AppendUint64(nil, uint64(version))
+	if err := batch.Set(keyLatest, bz); err != nil {
+		return err
+	}
+	if first {
+		return batch.Set(keyEarliest, bz)
+	}
+	return nil
+}
+
+// GetAtVersion returns the value of the key of the store at the version, nil if it is
+// not set.
+func (s *Store) GetAtVersion(storeKey string, key []byte, version int64) ([]byte, error) {
+	prefix := storePrefix(storeKey)
+	iter, err := s.db.ReverseIterator(dataKey(prefix, key, 0), dataKey(prefix, key, version+1))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, iter.Error()
+	}
+	return decodeValue(iter.Value())
+}
+
+// IteratorAtVersion returns an iterator over the keys of the store in the domain
+// [start, end) at the version.
+func (s *Store) IteratorAtVersion(storeKey string, start, end []byte, version int64) (types.Iterator, error) {
+	return s.iteratorAtVersion(storeKey, start, end, version, false)
+}
+
+// ReverseIteratorAtVersion returns an iterator over the keys of the store in the domain
+// [start, end) at the version, in reverse order.
+func (s *Store) ReverseIteratorAtVersion(storeKey string, start, end []byte, version int64) (types.Iterator, error) {
+	return s.iteratorAtVersion(storeKey, start, end, version, true)
+}
+
+func (s *Store) iteratorAtVersion(storeKey string, start, end []byte, version int64, reverse bool) (types.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+
+	// the changes of the keys in [start, end) are all in [escape(start), escape(end))
+	prefix := storePrefix(storeKey)
+	dbStart, dbEnd := prefix, types.PrefixEndBytes(prefix)
+	if start != nil {
+		dbStart = escapeKey(append([]byte{}, prefix...), start)
+	}
+	if end != nil {
+		dbEnd = escapeKey(append([]byte{}, prefix...), end)
+	}
+
+	var (
+		iter dbm.Iterator
+		err  error
+	)
+	if reverse {
+		iter, err = s.db.ReverseIterator(dbStart, dbEnd)
+	} else {
+		iter, err = s.db.Iterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newVersionIterator(iter, prefix, start, end, version), nil
+}
+
+// KVStoreAtVersion implements types.VersionStore.
+func (s *Store) KVStoreAtVersion(storeKey string, version int64) types.KVStore {
+	return &KVStore{store: s, storeKey: storeKey, version: version}
+}
+
+// Close closes the db of the store.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
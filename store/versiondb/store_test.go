@@ -0,0 +1,197 @@
+package versiondb
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/types"
+)
+
+func set(storeKey string, key, value []byte) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey, Key: key, Value: value}
+}
+
+func del(storeKey string, key []byte) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey, Key: key, Delete: true}
+}
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	store := NewStore(dbm.NewMemDB())
+	require.NoError(t, store.PutAtVersion(2, []*types.StoreKVPair{
+		set("acc", []byte("a"), []byte("a2")),
+		set("acc", []byte("b"), []byte("b2")),
+		set("acc", []byte{'a', 0}, []byte("a0-2")),
+		set("bank", []byte("a"), []byte("bank")),
+	}))
+	require.NoError(t, store.PutAtVersion(3, []*types.StoreKVPair{
+		set("acc", []byte("a"), []byte("a3")),
+		del("acc", []byte("b")),
+		set("acc", []byte("c"), []byte("c3")),
+	}))
+	require.NoError(t, store.PutAtVersion(5, []*types.StoreKVPair{
+		set("acc", []byte("b"), []byte("b5")),
+		set("acc", []byte("c"), []byte("c5")),
+		// the last change of a key in the change set wins
+		set("acc", []byte("c"), []byte("c5-last")),
+	}))
+	return store
+}
+
+func TestStore_Versions(t *testing.T) {
+	store := NewStore(dbm.NewMemDB())
+	latest, err := store.GetLatestVersion()
+	require.NoError(t, err)
+	require.Zero(t, latest)
+
+	require.NoError(t, store.PutAtVersion(2, nil))
+	require.NoError(t, store.PutAtVersion(4, nil))
+	// the latest version can be written again, e.g. after a crash
+	require.NoError(t, store.PutAtVersion(4, nil))
+	require.Error(t, store.PutAtVersion(3, nil))
+	require.Error(t, store.Import(5, nil))
+
+	latest, err = store.GetLatestVersion()
+	require.NoError(t, err)
+	require.EqualValues(t, 4, latest)
+	earliest, err := store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, earliest)
+}
+
+func TestStore_GetAtVersion(t *testing.T) {
+	store := newTestStore(t)
+
+	testCases := []struct {
+		storeKey string
+		key      string
+		version  int64
+		expected []byte
+	}{
+		{"acc", "a", 1, nil},
+		{"acc", "a", 2, []byte("a2")},
+		{"acc", "a", 3, []byte("a3")},
+		{"acc", "a", 10, []byte("a3")},
+		{"acc", "a\x00", 4, []byte("a0-2")},
+		{"acc", "b", 2, []byte("b2")},
+		{"acc", "b", 3, nil},
+		{"acc", "b", 4, nil},
+		{"acc", "b", 5, []byte("b5")},
+		{"acc", "c", 5, []byte("c5-last")},
+		{"acc", "d", 5, nil},
+		{"bank", "a", 5, []byte("bank")},
+		{"bank", "b", 5, nil},
+		{"ac", "ca", 5, nil},
+	}
+	for _, tc := range testCases {
+		value, err := store.GetAtVersion(tc.storeKey, []byte(tc.key), tc.version)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, value, "%s/%q at %d", tc.storeKey, tc.key, tc.version)
+
+		kvStore := store.KVStoreAtVersion(tc.storeKey, tc.version)
+		require.Equal(t, tc.expected, kvStore.Get([]byte(tc.key)))
+		require.Equal(t, tc.expected != nil, kvStore.Has([]byte(tc.key)))
+	}
+}
+
+func TestStore_IteratorAtVersion(t *testing.T) {
+	store := newTestStore(t)
+
+	collect := func(iter types.Iterator) []string {
+		defer iter.Close()
+
+		var pairs []string
+		for ; iter.Valid(); iter.Next() {
+			pairs = append(pairs, string(iter.Key())+"="+string(iter.Value()))
+		}
+		require.NoError(t, iter.Error())
+		return pairs
+	}
+
+	testCases := []struct {
+		name       string
+		version    int64
+		start, end []byte
+		expected   []string
+	}{
+		{"before the first version", 1, nil, nil, nil},
+		{"first version", 2, nil, nil, []string{"a=a2", "a\x00=a0-2", "b=b2"}},
+		{"deleted key", 4, nil, nil, []string{"a=a3", "a\x00=a0-2", "c=c3"}},
+		{"recreated key", 5, nil, nil, []string{"a=a3", "a\x00=a0-2", "b=b5", "c=c5-last"}},
+		{"start", 5, []byte("a\x00"), nil, []string{"a\x00=a0-2", "b=b5", "c=c5-last"}},
+		{"end", 5, nil, []byte("b"), []string{"a=a3", "a\x00=a0-2"}},
+		{"end with zero byte", 5, nil, []byte("a\x00"), []string{"a=a3"}},
+		{"domain", 5, []byte("a\x00"), []byte("c"), []string{"a\x00=a0-2", "b=b5"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kvStore := store.KVStoreAtVersion("acc", tc.version)
+			require.Equal(t, tc.expected, collect(kvStore.Iterator(tc.start, tc.end)))
+
+			var reversed []string
+			for i := len(tc.expected) - 1; i >= 0; i-- {
+				reversed = append(reversed, tc.expected[i])
+			}
+			require.Equal(t, reversed, collect(kvStore.ReverseIterator(tc.start, tc.end)))
+		})
+	}
+
+	// the other stores are not iterated
+	require.Equal(t, []string{"a=bank"}, collect(store.KVStoreAtVersion("bank", 5).Iterator(nil, nil)))
+}
+
+func TestStore_Import(t *testing.T) {
+	state := dbm.NewMemDB()
+	require.NoError(t, state.Set([]byte("a"), []byte("1")))
+	require.NoError(t, state.Set([]byte("b"), []byte("2")))
+	iter, err := state.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	store := NewStore(dbm.NewMemDB())
+	require.NoError(t, store.Import(7, map[string]types.Iterator{"acc": iter}))
+
+	earliest, err := store.GetEarliestVersion()
+	require.NoError(t, err)
+	require.EqualValues(t, 7, earliest)
+	latest, err := store.GetLatestVersion()
+	require.NoError(t, err)
+	require.EqualValues(t, 7, latest)
+
+	require.Nil(t, store.KVStoreAtVersion("acc", 6).Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.KVStoreAtVersion("acc", 7).Get([]byte("b")))
+
+	require.NoError(t, store.PutAtVersion(8, []*types.StoreKVPair{del("acc", []byte("a"))}))
+	require.Nil(t, store.KVStoreAtVersion("acc", 8).Get([]byte("a")))
+	require.Equal(t, []byte("1"), store.KVStoreAtVersion("acc", 7).Get([]byte("a")))
+}
+
+func TestKVStore_ReadOnly(t *testing.T) {
+	kvStore := newTestStore(t).KVStoreAtVersion("acc", 5)
+	require.Panics(t, func() { kvStore.Set([]byte("a"), []byte("b")) })
+	require.Panics(t, func() { kvStore.Delete([]byte("a")) })
+
+	// the cache wrap can be written and discarded
+	cache := kvStore.CacheWrap().(types.KVStore)
+	cache.Set([]byte("a"), []byte("cached"))
+	require.Equal(t, []byte("cached"), cache.Get([]byte("a")))
+	require.Equal(t, []byte("a3"), kvStore.Get([]byte("a")))
+}
+
+func TestDataKey(t *testing.T) {
+	prefix := storePrefix("acc")
+	for _, key := range [][]byte{{}, {0}, {0, 0}, {1, 0, 0xff}, []byte("key")} {
+		parsedKey, version, err := parseDataKey(prefix, dataKey(prefix, key, 42))
+		require.NoError(t, err)
+		require.Equal(t, key, parsedKey)
+		require.EqualValues(t, 42, version)
+	}
+
+	_, _, err := parseDataKey(prefix, append(prefix, 0, 2, 0, 0, 0, 0, 0, 0, 0, 1))
+	require.Error(t, err)
+	_, _, err = parseDataKey(storePrefix("bank"), dataKey(prefix, []byte("key"), 1))
+	require.Error(t, err)
+}
@@ -322,7 +322,7 @@ func TestGRPCValidatorDelegations(t *testing.T) {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 11985, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 12561, false)
 }
 
 func TestGRPCValidatorUnbondingDelegations(t *testing.T) {
@@ -401,7 +401,7 @@ func TestGRPCDelegation(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4635, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4827, false)
 }
 
 func TestGRPCUnbondingDelegation(t *testing.T) {
@@ -474,7 +474,7 @@ func TestGRPCDelegatorDelegations(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4238, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4430, false)
 }
 
 func TestGRPCDelegatorValidator(t *testing.T) {
@@ -653,7 +653,7 @@ func TestGRPCPool(t *testing.T) {
 
 	f = initDeterministicFixture(t) // reset
 	getStaticValidator(f, t)
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6185, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6377, false)
 }
 
 func TestGRPCRedelegations(t *testing.T) {
@@ -727,12 +727,15 @@ func TestGRPCParams(t *testing.T) {
 
 	rapid.Check(t, func(rt *rapid.T) {
 		params := stakingtypes.Params{
-			BondDenom:         rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(rt, "bond-denom"),
-			UnbondingTime:     durationGenerator().Draw(rt, "duration"),
-			MaxValidators:     rapid.Uint32Min(1).Draw(rt, "max-validators"),
-			MaxEntries:        rapid.Uint32Min(1).Draw(rt, "max-entries"),
-			HistoricalEntries: rapid.Uint32Min(1).Draw(rt, "historical-entries"),
-			MinCommissionRate: sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
+			BondDenom:                 rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(rt, "bond-denom"),
+			UnbondingTime:             durationGenerator().Draw(rt, "duration"),
+			MaxValidators:             rapid.Uint32Min(1).Draw(rt, "max-validators"),
+			MaxEntries:                rapid.Uint32Min(1).Draw(rt, "max-entries"),
+			HistoricalEntries:         rapid.Uint32Min(1).Draw(rt, "historical-entries"),
+			MinCommissionRate:         sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
+			ValidatorBondFactor:       sdk.NewDecWithPrec(rapid.Int64Range(-1, 1000).Draw(rt, "validator-bond-factor"), 0),
+			GlobalLiquidStakingCap:    sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "global-liquid-staking-cap"), 2),
+			ValidatorLiquidStakingCap: sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "validator-liquid-staking-cap"), 2),
 		}
 
 		err := f.stakingKeeper.SetParams(f.ctx, params)
@@ -742,16 +745,19 @@ func TestGRPCParams(t *testing.T) {
 	})
 
 	params := stakingtypes.Params{
-		BondDenom:         "denom",
-		UnbondingTime:     time.Hour,
-		MaxValidators:     85,
-		MaxEntries:        5,
-		HistoricalEntries: 5,
-		MinCommissionRate: sdk.NewDecWithPrec(5, 2),
+		BondDenom:                 "denom",
+		UnbondingTime:             time.Hour,
+		MaxValidators:             85,
+		MaxEntries:                5,
+		HistoricalEntries:         5,
+		MinCommissionRate:         sdk.NewDecWithPrec(5, 2),
+		ValidatorBondFactor:       sdk.NewDec(250),
+		GlobalLiquidStakingCap:    sdk.NewDecWithPrec(25, 2),
+		ValidatorLiquidStakingCap: sdk.NewDecWithPrec(5, 1),
 	}
 
 	err := f.stakingKeeper.SetParams(f.ctx, params)
 	assert.NilError(t, err)
 
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1114, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1303, false)
 }
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupLiquidStakingTest returns an app with a new unbonded validator without
+// any delegation, so that its shares are exchanged for tokens one to one, and
+// funded accounts.
+func setupLiquidStakingTest(t *testing.T) (sdk.Context, *keeper.Keeper, bankkeeper.Keeper, types.MsgServer, sdk.ValAddress, []sdk.AccAddress) {
+	var (
+		stakingKeeper *keeper.Keeper
+		bankKeeper    bankkeeper.Keeper
+	)
+	app, err := simtestutil.SetupWithConfiguration(
+		configurator.NewAppConfig(
+			configurator.BankModule(),
+			configurator.TxModule(),
+			configurator.StakingModule(),
+			configurator.ParamsModule(),
+			configurator.ConsensusModule(),
+			configurator.AuthModuleWithTokenizeShares(),
+		),
+		simtestutil.DefaultStartUpConfig(),
+		&stakingKeeper, &bankKeeper)
+	assert.NilError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(10000))
+	valAddr := sdk.ValAddress(addrs[2])
+	keeper.TestingUpdateValidator(stakingKeeper, ctx, testutil.NewValidator(t, valAddr, PKs[0]), true)
+
+	return ctx, stakingKeeper, bankKeeper, keeper.NewMsgServerImpl(stakingKeeper), valAddr, addrs[:2]
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	ctx, stakingKeeper, bankKeeper, msgServer, valAddr, addrs := setupLiquidStakingTest(t)
+	bondDenom := stakingKeeper.BondDenom(ctx)
+	delAddr, ownerAddr := addrs[0], addrs[1]
+
+	_, err := msgServer.Delegate(ctx, types.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	assert.NilError(t, err)
+	delegation, found := stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+	delegatedShares := delegation.Shares
+
+	// only the bond denom can be tokenized
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin("stake2", 400), ownerAddr))
+	assert.ErrorContains(t, err, "invalid coin denomination")
+
+	// the delegation cannot be exceeded
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1001), ownerAddr))
+	assert.ErrorContains(t, err, "invalid shares amount")
+
+	res, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 400), ownerAddr))
+	assert.NilError(t, err)
+
+	record, found := stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, found)
+	assert.Equal(t, ownerAddr.String(), record.Owner)
+	assert.Equal(t, record.GetShareTokenDenom(), res.Amount.Denom)
+	assert.DeepEqual(t, res.Amount, bankKeeper.GetBalance(ctx, delAddr, res.Amount.Denom))
+	assert.Equal(t, uint64(1), stakingKeeper.GetLastTokenizeShareRecordID(ctx))
+
+	delegation, found = stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+	recordDelegation, found := stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, delegatedShares, delegation.Shares.Add(recordDelegation.Shares))
+	assert.DeepEqual(t, recordDelegation.Shares, stakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	assert.DeepEqual(t, math.NewInt(400), stakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the share tokens are freely transferable, so anyone holding them can redeem
+	half := sdk.NewCoin(res.Amount.Denom, res.Amount.Amount.QuoRaw(2))
+	assert.NilError(t, bankKeeper.SendCoins(ctx, delAddr, ownerAddr, sdk.NewCoins(half)))
+
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(ownerAddr, res.Amount))
+	assert.Assert(t, err != nil)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(ownerAddr, half))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewInt64Coin(bondDenom, 200), redeemRes.Amount)
+	assert.Assert(t, bankKeeper.GetBalance(ctx, ownerAddr, half.Denom).IsZero())
+
+	ownerDelegation, found := stakingKeeper.GetDelegation(ctx, ownerAddr, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDec(200), ownerDelegation.Shares)
+	assert.DeepEqual(t, math.NewInt(200), stakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming the remaining supply removes the record
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr, half))
+	assert.NilError(t, err)
+
+	_, found = stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, !found)
+	_, found = stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	assert.Assert(t, !found)
+	assert.Assert(t, stakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+	assert.Assert(t, bankKeeper.GetSupply(ctx, half.Denom).IsZero())
+
+	delegation, found = stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, delegatedShares.Sub(ownerDelegation.Shares), delegation.Shares)
+}
+
+func TestValidatorBondLiquidStakingCap(t *testing.T) {
+	ctx, stakingKeeper, _, msgServer, valAddr, addrs := setupLiquidStakingTest(t)
+	bondDenom := stakingKeeper.BondDenom(ctx)
+	bondAddr, delAddr := addrs[0], addrs[1]
+
+	params := stakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = math.LegacyNewDec(2)
+	assert.NilError(t, stakingKeeper.SetParams(ctx, params))
+
+	_, err := msgServer.Delegate(ctx, types.NewMsgDelegate(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100)))
+	assert.NilError(t, err)
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	assert.NilError(t, err)
+
+	// nothing can be tokenized without a validator bond
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), delAddr))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	_, err = msgServer.ValidatorBond(ctx, types.NewMsgValidatorBond(bondAddr, valAddr))
+	assert.NilError(t, err)
+
+	// a validator bond cannot be tokenized
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 10), bondAddr))
+	assert.ErrorIs(t, err, types.ErrValidatorBondNotTokenizable)
+
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 201), delAddr))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 200), delAddr))
+	assert.NilError(t, err)
+
+	// the validator bond backs the tokenized shares and cannot be unbonded
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1)))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+}
@@ -100,19 +100,30 @@ func BankModule() ModuleOption {
 }
 
 func AuthModule() ModuleOption {
+	return authModule()
+}
+
+// AuthModuleWithTokenizeShares is AuthModule with the minter and burner
+// permissions of the staking module account, which mints the tokens of the
+// tokenized delegation shares and burns them when they are redeemed.
+func AuthModuleWithTokenizeShares() ModuleOption {
+	return authModule(&authmodulev1.ModuleAccountPermission{Account: "staking", Permissions: []string{"minter", "burner"}})
+}
+
+func authModule(extraPermissions ...*authmodulev1.ModuleAccountPermission) ModuleOption {
 	return func(config *appConfig) {
 		config.moduleConfigs["auth"] = &appv1alpha1.ModuleConfig{
 			Name: "auth",
 			Config: appconfig.WrapAny(&authmodulev1.Module{
 				Bech32Prefix: "cosmos",
-				ModuleAccountPermissions: []*authmodulev1.ModuleAccountPermission{
+				ModuleAccountPermissions: append([]*authmodulev1.ModuleAccountPermission{
 					{Account: "fee_collector"},
 					{Account: "distribution"},
 					{Account: "mint", Permissions: []string{"minter"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "gov", Permissions: []string{"burner"}},
-				},
+				}, extraPermissions...),
 			}),
 		}
 	}
@@ -273,6 +273,11 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// StoreGas is the gas consumed by every store and operation, ordered by store name
+	// and operation. It is only set by simulations and when gas attribution is enabled.
+	//
+	// Since: cosmos-sdk 0.48
+	StoreGas []StoreGasInfo `protobuf:"bytes,3,rep,name=store_gas,json=storeGas,proto3" json:"store_gas"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -321,6 +326,78 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetStoreGas() []StoreGasInfo {
+	if m != nil {
+		return m.StoreGas
+	}
+	return nil
+}
+
+// StoreGasInfo defines the gas consumed by an operation on a store.
+//
+// Since: cosmos-sdk 0.48
+type StoreGasInfo struct {
+	// StoreName is the name of the store key.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// Operation is the store operation consuming the gas: read, write, iter or seek.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Gas is the amount of gas consumed.
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *StoreGasInfo) Reset()      { *m = StoreGasInfo{} }
+func (*StoreGasInfo) ProtoMessage() {}
+func (*StoreGasInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+}
+func (m *StoreGasInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreGasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreGasInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreGasInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreGasInfo.Merge(m, src)
+}
+func (m *StoreGasInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreGasInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreGasInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreGasInfo proto.InternalMessageInfo
+
+func (m *StoreGasInfo) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *StoreGasInfo) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *StoreGasInfo) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be
@@ -342,7 +419,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+	return fileDescriptor_4e37629bc7eb0df8, []int{6}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +458,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+	return fileDescriptor_4e37629bc7eb0df8, []int{7}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +506,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{7}
+	return fileDescriptor_4e37629bc7eb0df8, []int{8}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,7 +563,7 @@ type TxMsgData struct {
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
 func (*TxMsgData) ProtoMessage() {}
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{8}
+	return fileDescriptor_4e37629bc7eb0df8, []int{9}
 }
 func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +626,7 @@ type SearchTxsResult struct {
 func (m *SearchTxsResult) Reset()      { *m = SearchTxsResult{} }
 func (*SearchTxsResult) ProtoMessage() {}
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{9}
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
 }
 func (m *SearchTxsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +716,7 @@ type SearchBlocksResult struct {
 func (m *SearchBlocksResult) Reset()      { *m = SearchBlocksResult{} }
 func (*SearchBlocksResult) ProtoMessage() {}
 func (*SearchBlocksResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{10}
+	return fileDescriptor_4e37629bc7eb0df8, []int{11}
 }
 func (m *SearchBlocksResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,6 +793,7 @@ func init() {
 	proto.RegisterType((*StringEvent)(nil), "cosmos.base.abci.v1beta1.StringEvent")
 	proto.RegisterType((*Attribute)(nil), "cosmos.base.abci.v1beta1.Attribute")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.base.abci.v1beta1.GasInfo")
+	proto.RegisterType((*StoreGasInfo)(nil), "cosmos.base.abci.v1beta1.StoreGasInfo")
 	proto.RegisterType((*Result)(nil), "cosmos.base.abci.v1beta1.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.base.abci.v1beta1.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
@@ -729,68 +807,71 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0x1b, 0xb7,
-	0x13, 0xd5, 0x6a, 0x37, 0x2b, 0x8b, 0xb2, 0x7e, 0xf9, 0x81, 0x30, 0x6c, 0x3a, 0x4d, 0x25, 0x55,
-	0x49, 0x01, 0xa1, 0x40, 0x57, 0x88, 0x13, 0x14, 0x4d, 0x4e, 0x89, 0xdc, 0x7f, 0x06, 0x92, 0x1e,
-	0xd6, 0x0a, 0x0a, 0xf4, 0x22, 0x50, 0x12, 0x43, 0x2d, 0xac, 0x5d, 0x0a, 0x4b, 0xca, 0x96, 0x6f,
-	0xbd, 0xb5, 0xc7, 0x9e, 0x7a, 0xee, 0xb5, 0xfd, 0x24, 0x39, 0xf4, 0xe0, 0xa3, 0x0f, 0x81, 0xdb,
-	0xda, 0xb7, 0x7e, 0x8a, 0x62, 0x86, 0xd4, 0x9f, 0xd4, 0x95, 0x9b, 0x93, 0x87, 0x6f, 0x86, 0xd4,
-	0xbc, 0x37, 0x6f, 0x49, 0x93, 0x7b, 0x03, 0xa5, 0x53, 0xa5, 0xdb, 0x7d, 0xae, 0x45, 0x9b, 0xf7,
-	0x07, 0x49, 0xfb, 0xf8, 0x41, 0x5f, 0x18, 0xfe, 0x00, 0x17, 0xd1, 0x24, 0x57, 0x46, 0x51, 0x66,
-	0x8b, 0x22, 0x28, 0x8a, 0x10, 0x77, 0x45, 0x77, 0xb6, 0xa4, 0x92, 0x0a, 0x8b, 0xda, 0x10, 0xd9,
-	0xfa, 0x3b, 0xef, 0x19, 0x91, 0x0d, 0x45, 0x9e, 0x26, 0x99, 0xb1, 0x67, 0x9a, 0xd3, 0x89, 0xd0,
-	0x2e, 0x79, 0x77, 0x25, 0x89, 0x78, 0xbb, 0x3f, 0x56, 0x83, 0x23, 0x97, 0xdd, 0x95, 0x4a, 0xc9,
-	0xb1, 0x68, 0xe3, 0xaa, 0x3f, 0x7d, 0xd5, 0xe6, 0xd9, 0xa9, 0x4d, 0x35, 0x7f, 0xf3, 0x09, 0xe9,
-	0xce, 0x62, 0xa1, 0x27, 0x2a, 0xd3, 0x82, 0x6e, 0x93, 0x70, 0x24, 0x12, 0x39, 0x32, 0xcc, 0x6b,
-	0x78, 0x2d, 0x3f, 0x76, 0x2b, 0xda, 0x24, 0xa1, 0x99, 0x8d, 0xb8, 0x1e, 0xb1, 0x62, 0xc3, 0x6b,
-	0x95, 0x3b, 0xe4, 0xf2, 0xa2, 0x1e, 0x76, 0x67, 0x5f, 0x71, 0x3d, 0x8a, 0x5d, 0x86, 0xde, 0x25,
-	0xe5, 0x81, 0x1a, 0x0a, 0x3d, 0xe1, 0x03, 0xc1, 0x7c, 0x28, 0x8b, 0x97, 0x00, 0xa5, 0x24, 0x80,
-	0x05, 0x0b, 0x1a, 0x5e, 0xab, 0x1a, 0x63, 0x0c, 0xd8, 0x90, 0x1b, 0xce, 0x6e, 0x61, 0x31, 0xc6,
-	0x74, 0x87, 0x94, 0x72, 0x7e, 0xd2, 0x1b, 0x2b, 0xc9, 0x42, 0x84, 0xc3, 0x9c, 0x9f, 0x3c, 0x57,
-	0x92, 0xbe, 0x24, 0xc1, 0x58, 0x49, 0xcd, 0x4a, 0x0d, 0xbf, 0x55, 0xd9, 0x6b, 0x45, 0xeb, 0xe4,
-	0x8b, 0x9e, 0x75, 0xf6, 0x0f, 0x5e, 0x08, 0xad, 0xb9, 0x14, 0xcf, 0x95, 0xec, 0xec, 0xbc, 0xbe,
-	0xa8, 0x17, 0x7e, 0xfd, 0xbd, 0x7e, 0xfb, 0x6d, 0x5c, 0xc7, 0x78, 0x1c, 0xf4, 0x90, 0x64, 0xaf,
-	0x14, 0xdb, 0xb0, 0x3d, 0x40, 0x4c, 0xdf, 0x27, 0x44, 0x72, 0xdd, 0x3b, 0xe1, 0x99, 0x11, 0x43,
-	0x56, 0x46, 0x25, 0xca, 0x92, 0xeb, 0x6f, 0x10, 0xa0, 0xbb, 0x64, 0x03, 0xd2, 0x53, 0x2d, 0x86,
-	0x8c, 0x60, 0xb2, 0x24, 0xb9, 0x7e, 0xa9, 0xc5, 0x90, 0xde, 0x27, 0x45, 0x33, 0x63, 0x95, 0x86,
-	0xd7, 0xaa, 0xec, 0x6d, 0x45, 0x56, 0xf6, 0x68, 0x2e, 0x7b, 0xf4, 0x2c, 0x3b, 0x8d, 0x8b, 0x66,
-	0x06, 0x4a, 0x99, 0x24, 0x15, 0xda, 0xf0, 0x74, 0xc2, 0x36, 0xad, 0x52, 0x0b, 0x80, 0x3e, 0x22,
-	0xa1, 0x38, 0x16, 0x99, 0xd1, 0xac, 0x8a, 0x54, 0xb7, 0xa3, 0xe5, 0x70, 0x2d, 0xd3, 0xcf, 0x21,
-	0xdd, 0x09, 0x80, 0x58, 0xec, 0x6a, 0x9f, 0x04, 0x3f, 0xfc, 0x5c, 0x2f, 0x34, 0x7f, 0xf1, 0xc8,
-	0xff, 0xde, 0xe6, 0x49, 0x3f, 0x22, 0xe5, 0x54, 0xcb, 0x5e, 0x92, 0x0d, 0xc5, 0x0c, 0xa7, 0x5a,
-	0xed, 0x54, 0xff, 0xba, 0xa8, 0x2f, 0xc1, 0x78, 0x23, 0xd5, 0xf2, 0x00, 0x22, 0xfa, 0x7f, 0xe2,
-	0x83, 0xf0, 0x38, 0xe3, 0x18, 0x42, 0x7a, 0xb8, 0x68, 0xc6, 0xc7, 0x66, 0x3e, 0x5c, 0xaf, 0xfb,
-	0xa1, 0xc9, 0x93, 0x4c, 0xda, 0xde, 0xb6, 0x9c, 0xe8, 0x9b, 0x2b, 0xa0, 0x5e, 0xf6, 0xfa, 0xdd,
-	0x9b, 0x86, 0xd7, 0xcc, 0x49, 0x65, 0x25, 0x0b, 0x83, 0x00, 0xe7, 0x62, 0x8b, 0xe5, 0x18, 0x63,
-	0x7a, 0x40, 0x08, 0x37, 0x26, 0x4f, 0xfa, 0x53, 0x23, 0x34, 0x2b, 0x62, 0x07, 0xf7, 0x6e, 0x98,
-	0xfc, 0xbc, 0xd6, 0x69, 0xb3, 0xb2, 0xd9, 0xfd, 0xe6, 0x43, 0x52, 0x5e, 0x14, 0x01, 0xdb, 0x23,
-	0x71, 0xea, 0x7e, 0x10, 0x42, 0xba, 0x45, 0x6e, 0x1d, 0xf3, 0xf1, 0x54, 0x38, 0x05, 0xec, 0xa2,
-	0xb9, 0x4f, 0x4a, 0x5f, 0x72, 0x7d, 0x70, 0xdd, 0x19, 0xb0, 0x33, 0x58, 0xe7, 0x8c, 0x22, 0x26,
-	0xe7, 0xce, 0x80, 0xc9, 0x84, 0xb1, 0xd0, 0xd3, 0xb1, 0xa1, 0xdb, 0xce, 0xf6, 0xb0, 0x7d, 0xb3,
-	0x53, 0x64, 0x9e, 0xb3, 0xfe, 0x75, 0xf5, 0x1f, 0xfd, 0x43, 0xfd, 0x77, 0xb2, 0x02, 0x7d, 0x4c,
-	0xaa, 0x30, 0xdc, 0xdc, 0x7d, 0xd4, 0x9a, 0x05, 0xb8, 0xf9, 0xdf, 0xfd, 0xb8, 0x99, 0x6a, 0x39,
-	0xff, 0xfc, 0xe7, 0x2e, 0xfa, 0xc9, 0x23, 0xf4, 0x30, 0x49, 0xa7, 0x63, 0x6e, 0x12, 0x95, 0x2d,
-	0x2e, 0x87, 0x2f, 0x2c, 0x3b, 0xfc, 0x5c, 0x3c, 0xb4, 0xf8, 0x07, 0xeb, 0x67, 0xe1, 0x14, 0xeb,
-	0x6c, 0x40, 0x6b, 0x67, 0x17, 0x75, 0x0f, 0xa5, 0x40, 0x11, 0x3f, 0x25, 0x61, 0x8e, 0x4a, 0x20,
-	0xd5, 0xca, 0x5e, 0x63, 0xfd, 0x29, 0x56, 0xb1, 0xd8, 0xd5, 0x37, 0x9f, 0x92, 0xd2, 0x0b, 0x2d,
-	0x3f, 0x03, 0xb1, 0x76, 0x09, 0xd8, 0xb6, 0xb7, 0x62, 0x99, 0x52, 0xaa, 0x65, 0x17, 0x5c, 0x33,
-	0xbf, 0x56, 0xe0, 0xf4, 0x4d, 0xab, 0xed, 0x93, 0x10, 0xc6, 0xcf, 0xbc, 0xe6, 0xf7, 0x1e, 0x29,
-	0x77, 0x67, 0xf3, 0x43, 0x1e, 0x2f, 0x26, 0xe1, 0xdf, 0xcc, 0xc6, 0x6d, 0x58, 0x19, 0xd6, 0x35,
-	0x91, 0x8b, 0xef, 0x2e, 0x32, 0x5a, 0xf1, 0x8d, 0x47, 0x6e, 0x1f, 0x0a, 0x9e, 0x0f, 0x46, 0xdd,
-	0x99, 0x76, 0xce, 0xa8, 0x93, 0x8a, 0x51, 0x86, 0x8f, 0x7b, 0x03, 0x35, 0xcd, 0x8c, 0xf3, 0x17,
-	0x41, 0x68, 0x1f, 0x10, 0x30, 0xa8, 0x4d, 0x59, 0x77, 0xd9, 0x05, 0x6c, 0x9b, 0x70, 0x29, 0x7a,
-	0xd9, 0x34, 0xed, 0x8b, 0x1c, 0xef, 0xde, 0x20, 0x26, 0x00, 0x7d, 0x8d, 0x08, 0xd8, 0x16, 0x0b,
-	0xf0, 0x24, 0xbc, 0x82, 0x83, 0xb8, 0x0c, 0x48, 0x17, 0x00, 0x38, 0x75, 0x9c, 0xa4, 0x89, 0xc1,
-	0x8b, 0x38, 0x88, 0xed, 0x82, 0x7e, 0x42, 0x7c, 0x33, 0xd3, 0x2c, 0x44, 0x5e, 0xf7, 0xd7, 0x6b,
-	0xb3, 0x7c, 0x3e, 0x62, 0xd8, 0xe0, 0xe8, 0x9d, 0x83, 0x87, 0x90, 0x5e, 0x07, 0x5e, 0xa2, 0x1b,
-	0x18, 0xfa, 0xeb, 0x19, 0xfa, 0x37, 0x30, 0xf4, 0xff, 0x83, 0xa1, 0xbf, 0x96, 0xa1, 0x3f, 0x67,
-	0xd8, 0x26, 0x21, 0x3e, 0x93, 0x73, 0x92, 0x3b, 0xab, 0x9f, 0x97, 0x7d, 0x5e, 0xb1, 0xf9, 0xd8,
-	0x95, 0x59, 0x6a, 0x9d, 0xa7, 0xe7, 0x7f, 0xd6, 0x0a, 0xaf, 0x2f, 0x6b, 0xde, 0xd9, 0x65, 0xcd,
-	0xfb, 0xe3, 0xb2, 0xe6, 0xfd, 0x78, 0x55, 0x2b, 0x9c, 0x5d, 0xd5, 0x0a, 0xe7, 0x57, 0xb5, 0xc2,
-	0xb7, 0x4d, 0x99, 0x98, 0xd1, 0xb4, 0x1f, 0x0d, 0x54, 0xda, 0x76, 0xff, 0x07, 0xd8, 0x3f, 0x1f,
-	0xeb, 0xe1, 0x91, 0x7d, 0x9c, 0xfb, 0x21, 0xba, 0xe3, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4d, 0x51, 0x55, 0xd9, 0x29, 0x08, 0x00, 0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x7a, 0xdd, 0x75, 0x76, 0xec, 0x50, 0x34, 0x8a, 0x9a, 0x4d, 0x29, 0xb6, 0x71, 0x0b,
+	0xb2, 0x90, 0x58, 0xab, 0x69, 0x85, 0x68, 0x4f, 0xad, 0xcb, 0xaf, 0x48, 0x6d, 0x0f, 0x1b, 0x57,
+	0x48, 0x48, 0xc8, 0x1a, 0xdb, 0xd3, 0xf1, 0x2a, 0xde, 0x1d, 0x6b, 0x67, 0x9c, 0xd8, 0x37, 0x6e,
+	0x70, 0x42, 0x9c, 0x38, 0x73, 0x85, 0xbf, 0xa4, 0x07, 0x0e, 0x39, 0xe6, 0x50, 0x05, 0x48, 0x6e,
+	0xfc, 0x15, 0xe8, 0xbd, 0x99, 0xb5, 0x5d, 0x22, 0x9b, 0x9e, 0x3c, 0xf3, 0xbd, 0xb7, 0xb3, 0xef,
+	0xfb, 0xde, 0xf7, 0x3c, 0x4b, 0x6e, 0x0f, 0xa4, 0x4a, 0xa4, 0x6a, 0xf7, 0x99, 0xe2, 0x6d, 0xd6,
+	0x1f, 0xc4, 0xed, 0xe3, 0xbb, 0x7d, 0xae, 0xd9, 0x5d, 0xdc, 0x84, 0x93, 0x4c, 0x6a, 0x49, 0x03,
+	0x93, 0x14, 0x42, 0x52, 0x88, 0xb8, 0x4d, 0xba, 0xb9, 0x23, 0xa4, 0x90, 0x98, 0xd4, 0x86, 0x95,
+	0xc9, 0xbf, 0xf9, 0x9e, 0xe6, 0xe9, 0x90, 0x67, 0x49, 0x9c, 0x6a, 0x73, 0xa6, 0x9e, 0x4f, 0xb8,
+	0xb2, 0xc1, 0x5b, 0x2b, 0x41, 0xc4, 0xdb, 0xfd, 0xb1, 0x1c, 0x1c, 0xd9, 0xe8, 0x9e, 0x90, 0x52,
+	0x8c, 0x79, 0x1b, 0x77, 0xfd, 0xe9, 0xcb, 0x36, 0x4b, 0xe7, 0x26, 0xd4, 0xfc, 0xc3, 0x25, 0xa4,
+	0x3b, 0x8b, 0xb8, 0x9a, 0xc8, 0x54, 0x71, 0x7a, 0x83, 0x78, 0x23, 0x1e, 0x8b, 0x91, 0x0e, 0x9c,
+	0x86, 0xd3, 0x72, 0x23, 0xbb, 0xa3, 0x4d, 0xe2, 0xe9, 0xd9, 0x88, 0xa9, 0x51, 0x50, 0x6c, 0x38,
+	0x2d, 0xbf, 0x43, 0x2e, 0xce, 0xeb, 0x5e, 0x77, 0xf6, 0x35, 0x53, 0xa3, 0xc8, 0x46, 0xe8, 0x2d,
+	0xe2, 0x0f, 0xe4, 0x90, 0xab, 0x09, 0x1b, 0xf0, 0xc0, 0x85, 0xb4, 0x68, 0x09, 0x50, 0x4a, 0x4a,
+	0xb0, 0x09, 0x4a, 0x0d, 0xa7, 0xb5, 0x1d, 0xe1, 0x1a, 0xb0, 0x21, 0xd3, 0x2c, 0xb8, 0x86, 0xc9,
+	0xb8, 0xa6, 0xbb, 0xa4, 0x9c, 0xb1, 0x93, 0xde, 0x58, 0x8a, 0xc0, 0x43, 0xd8, 0xcb, 0xd8, 0xc9,
+	0x53, 0x29, 0xe8, 0x0b, 0x52, 0x1a, 0x4b, 0xa1, 0x82, 0x72, 0xc3, 0x6d, 0x55, 0xf6, 0x5b, 0xe1,
+	0x3a, 0xf9, 0xc2, 0xc7, 0x9d, 0x27, 0x07, 0xcf, 0xb8, 0x52, 0x4c, 0xf0, 0xa7, 0x52, 0x74, 0x76,
+	0x5f, 0x9d, 0xd7, 0x0b, 0xbf, 0xff, 0x59, 0xbf, 0xfe, 0x26, 0xae, 0x22, 0x3c, 0x0e, 0x6a, 0x88,
+	0xd3, 0x97, 0x32, 0xd8, 0x32, 0x35, 0xc0, 0x9a, 0xbe, 0x4f, 0x88, 0x60, 0xaa, 0x77, 0xc2, 0x52,
+	0xcd, 0x87, 0x81, 0x8f, 0x4a, 0xf8, 0x82, 0xa9, 0x6f, 0x10, 0xa0, 0x7b, 0x64, 0x0b, 0xc2, 0x53,
+	0xc5, 0x87, 0x01, 0xc1, 0x60, 0x59, 0x30, 0xf5, 0x42, 0xf1, 0x21, 0xbd, 0x43, 0x8a, 0x7a, 0x16,
+	0x54, 0x1a, 0x4e, 0xab, 0xb2, 0xbf, 0x13, 0x1a, 0xd9, 0xc3, 0x5c, 0xf6, 0xf0, 0x71, 0x3a, 0x8f,
+	0x8a, 0x7a, 0x06, 0x4a, 0xe9, 0x38, 0xe1, 0x4a, 0xb3, 0x64, 0x12, 0x54, 0x8d, 0x52, 0x0b, 0x80,
+	0xde, 0x27, 0x1e, 0x3f, 0xe6, 0xa9, 0x56, 0xc1, 0x36, 0x52, 0xbd, 0x11, 0x2e, 0x9b, 0x6b, 0x98,
+	0x7e, 0x01, 0xe1, 0x4e, 0x09, 0x88, 0x45, 0x36, 0xf7, 0x61, 0xe9, 0xc7, 0x5f, 0xeb, 0x85, 0xe6,
+	0x6f, 0x0e, 0x79, 0xe7, 0x4d, 0x9e, 0xf4, 0x63, 0xe2, 0x27, 0x4a, 0xf4, 0xe2, 0x74, 0xc8, 0x67,
+	0xd8, 0xd5, 0xed, 0xce, 0xf6, 0x3f, 0xe7, 0xf5, 0x25, 0x18, 0x6d, 0x25, 0x4a, 0x1c, 0xc0, 0x8a,
+	0xbe, 0x4b, 0x5c, 0x10, 0x1e, 0x7b, 0x1c, 0xc1, 0x92, 0x1e, 0x2e, 0x8a, 0x71, 0xb1, 0x98, 0x0f,
+	0xd7, 0xeb, 0x7e, 0xa8, 0xb3, 0x38, 0x15, 0xa6, 0xb6, 0x1d, 0x2b, 0x7a, 0x75, 0x05, 0x54, 0xcb,
+	0x5a, 0xbf, 0x7f, 0xdd, 0x70, 0x9a, 0x19, 0xa9, 0xac, 0x44, 0xa1, 0x11, 0xe0, 0x5c, 0x2c, 0xd1,
+	0x8f, 0x70, 0x4d, 0x0f, 0x08, 0x61, 0x5a, 0x67, 0x71, 0x7f, 0xaa, 0xb9, 0x0a, 0x8a, 0x58, 0xc1,
+	0xed, 0x0d, 0x9d, 0xcf, 0x73, 0xad, 0x36, 0x2b, 0x0f, 0xdb, 0x77, 0xde, 0x23, 0xfe, 0x22, 0x09,
+	0xd8, 0x1e, 0xf1, 0xb9, 0x7d, 0x21, 0x2c, 0xe9, 0x0e, 0xb9, 0x76, 0xcc, 0xc6, 0x53, 0x6e, 0x15,
+	0x30, 0x9b, 0xe6, 0x4f, 0x0e, 0x29, 0x7f, 0xc5, 0xd4, 0xc1, 0x55, 0x6b, 0xc0, 0xa3, 0xa5, 0x75,
+	0xd6, 0x28, 0x62, 0x70, 0x61, 0x8d, 0x03, 0xe2, 0x2b, 0x2d, 0x33, 0xde, 0x13, 0x2c, 0x17, 0xf3,
+	0xa3, 0x4d, 0x62, 0xca, 0x8c, 0xdb, 0x97, 0x5a, 0x36, 0x5b, 0xca, 0x62, 0xcd, 0xef, 0x48, 0x75,
+	0x35, 0x0e, 0x45, 0x99, 0xa3, 0x53, 0x96, 0xe4, 0x02, 0x9a, 0x97, 0x3d, 0x67, 0x09, 0x07, 0xbb,
+	0xc9, 0x09, 0xcf, 0x98, 0x8e, 0x65, 0x6a, 0x99, 0x2d, 0x01, 0x50, 0xc1, 0x54, 0x04, 0xd5, 0xc2,
+	0x12, 0x4c, 0xe4, 0x45, 0x5c, 0x4d, 0xc7, 0x9a, 0xde, 0xb0, 0x13, 0x0a, 0x67, 0x56, 0x3b, 0xc5,
+	0xc0, 0xb1, 0x53, 0x7a, 0xd5, 0x28, 0xf7, 0xff, 0x63, 0x94, 0xb7, 0x72, 0x2d, 0x7d, 0x40, 0xb6,
+	0xc1, 0x87, 0x99, 0xfd, 0xff, 0x51, 0x41, 0xa9, 0xe1, 0xae, 0x1d, 0x9d, 0x6a, 0xa2, 0x44, 0xfe,
+	0x4f, 0x95, 0x1b, 0xfe, 0x17, 0x87, 0xd0, 0xc3, 0x38, 0x99, 0x8e, 0x91, 0x4c, 0x1e, 0xa5, 0x5f,
+	0x9a, 0x3e, 0xe0, 0x64, 0x3b, 0x38, 0x8d, 0x1f, 0xac, 0xd7, 0x3a, 0x97, 0x79, 0x0b, 0x4a, 0x3b,
+	0x3d, 0xaf, 0x3b, 0xd8, 0x34, 0x54, 0xf6, 0x33, 0xe2, 0x65, 0xa8, 0x04, 0x52, 0xad, 0xec, 0x37,
+	0xd6, 0x9f, 0x62, 0x14, 0x8b, 0x6c, 0x7e, 0xf3, 0x11, 0x29, 0x3f, 0x53, 0xe2, 0x73, 0x10, 0x6b,
+	0x8f, 0xc0, 0x84, 0xf5, 0x56, 0xdc, 0x5d, 0x4e, 0x94, 0xe8, 0xce, 0x27, 0xcb, 0x7f, 0x40, 0x38,
+	0xbd, 0x6a, 0xb4, 0x7d, 0xe8, 0x81, 0x53, 0x03, 0xa7, 0xf9, 0x83, 0x43, 0xfc, 0xee, 0x2c, 0x3f,
+	0xe4, 0xc1, 0xa2, 0x13, 0xee, 0x66, 0x36, 0xf6, 0x81, 0x95, 0x66, 0x5d, 0x11, 0xb9, 0xf8, 0xf6,
+	0x22, 0xe3, 0xd4, 0xbc, 0x76, 0xc8, 0xf5, 0x43, 0xce, 0xb2, 0xc1, 0xa8, 0x3b, 0x53, 0xd6, 0x19,
+	0x75, 0x52, 0xd1, 0x52, 0xb3, 0x71, 0x6f, 0x20, 0xa7, 0xa9, 0xb6, 0x93, 0x40, 0x10, 0x7a, 0x02,
+	0x08, 0xcc, 0x92, 0x09, 0x99, 0x39, 0x30, 0x1b, 0x78, 0x6c, 0xc2, 0x04, 0xef, 0xa5, 0xd3, 0xa4,
+	0xcf, 0x33, 0xeb, 0x3a, 0x02, 0xd0, 0x73, 0x44, 0xc0, 0xcb, 0x98, 0x80, 0x27, 0xe1, 0x6d, 0x51,
+	0x8a, 0x7c, 0x40, 0xba, 0x00, 0xc0, 0xa9, 0xe3, 0x38, 0x89, 0x35, 0xde, 0x19, 0xa5, 0xc8, 0x6c,
+	0xe8, 0xa7, 0xc4, 0xd5, 0x33, 0x15, 0x78, 0xc8, 0xeb, 0xce, 0x7a, 0x6d, 0x96, 0x37, 0x5d, 0x04,
+	0x0f, 0x58, 0x7a, 0x67, 0xe0, 0x21, 0xa4, 0xd7, 0x81, 0x4b, 0x73, 0x03, 0x43, 0x77, 0x3d, 0x43,
+	0x77, 0x03, 0x43, 0xf7, 0x7f, 0x18, 0xba, 0x6b, 0x19, 0xba, 0x39, 0xc3, 0x36, 0xf1, 0xf0, 0x46,
+	0xcf, 0x49, 0xee, 0xae, 0x8e, 0x97, 0xf9, 0x12, 0xc0, 0xe2, 0x23, 0x9b, 0x66, 0xa8, 0x75, 0x1e,
+	0x9d, 0xfd, 0x5d, 0x2b, 0xbc, 0xba, 0xa8, 0x39, 0xa7, 0x17, 0x35, 0xe7, 0xaf, 0x8b, 0x9a, 0xf3,
+	0xf3, 0x65, 0xad, 0x70, 0x7a, 0x59, 0x2b, 0x9c, 0x5d, 0xd6, 0x0a, 0xdf, 0x36, 0x45, 0xac, 0x47,
+	0xd3, 0x7e, 0x38, 0x90, 0x49, 0xdb, 0x7e, 0xb2, 0x98, 0x9f, 0x4f, 0xd4, 0xf0, 0xc8, 0x7c, 0x47,
+	0xf4, 0x3d, 0x74, 0xc7, 0xbd, 0x7f, 0x07, 0x00, 0x5c, 0x7d, 0xaf, 0x32, 0xd4, 0x08, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -1068,6 +1149,20 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StoreGas) > 0 {
+		for iNdEx := len(m.StoreGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1081,6 +1176,48 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StoreGasInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreGasInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreGasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1545,6 +1682,32 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovAbci(uint64(m.GasUsed))
 	}
+	if len(m.StoreGas) > 0 {
+		for _, e := range m.StoreGas {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreGasInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovAbci(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -2663,6 +2826,173 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreGas = append(m.StoreGas, StoreGasInfo{})
+			if err := m.StoreGas[len(m.StoreGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreGasInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreGasInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreGasInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
@@ -4,8 +4,17 @@ import (
 	"testing"
 
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
 )
 
 func TestIntValue(t *testing.T) {
 	colltest.TestValueCodec(t, IntValue, NewInt(10005994859))
 }
+
+func TestLegacyDecValue(t *testing.T) {
+	colltest.TestValueCodec(t, LegacyDecValue, math.LegacyNewDecWithPrec(-133, 2))
+}
+
+func TestCoinValue(t *testing.T) {
+	colltest.TestValueCodec(t, CoinValue, NewInt64Coin("atom", 1000))
+}
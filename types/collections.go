@@ -1,6 +1,12 @@
 package types
 
 import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/math"
@@ -28,6 +34,31 @@ var (
 
 	// IntValue represents a collections.ValueCodec to work with Int.
 	IntValue collcodec.ValueCodec[math.Int] = intValueCodec{}
+
+	// LegacyDecValue represents a collections.ValueCodec to work with LegacyDec.
+	LegacyDecValue collcodec.ValueCodec[math.LegacyDec] = legacyDecValueCodec{}
+
+	// CoinValue represents a collections.ValueCodec to work with Coin.
+	CoinValue collcodec.ValueCodec[Coin] = coinValueCodec{}
+
+	// TimeKey represents a collections.KeyCodec to work with time.Time.
+	// The time is encoded as the big endian seconds since the unix epoch,
+	// with the MSB toggled, followed by the big endian nanoseconds, which
+	// retains ordering. The encoding has a fixed size of 12 bytes, so it
+	// can be used in any part of a multipart key. Times are decoded in UTC.
+	TimeKey collcodec.KeyCodec[time.Time] = timeKeyCodec{}
+
+	// IntKey represents a collections.KeyCodec to work with Int.
+	// The encoding retains ordering, it is composed of one byte representing
+	// the sign and the length of the absolute value, followed by the big endian
+	// bytes of the absolute value, which are complemented if the number is negative.
+	// The encoding is self delimiting, so it can be used in any part of a multipart key.
+	IntKey collcodec.KeyCodec[math.Int] = intKeyCodec{}
+
+	// LegacyDecKey represents a collections.KeyCodec to work with LegacyDec.
+	// It encodes the underlying integer, scaled by 10^LegacyPrecision, following
+	// the same semantics as IntKey.
+	LegacyDecKey collcodec.KeyCodec[math.LegacyDec] = legacyDecKeyCodec{}
 )
 
 type addressUnion interface {
@@ -122,3 +153,296 @@ func (i intValueCodec) Stringify(value Int) string {
 func (i intValueCodec) ValueType() string {
 	return "math.Int"
 }
+
+type legacyDecValueCodec struct{}
+
+func (i legacyDecValueCodec) Encode(value math.LegacyDec) ([]byte, error) {
+	return value.Marshal()
+}
+
+func (i legacyDecValueCodec) Decode(b []byte) (math.LegacyDec, error) {
+	v := new(math.LegacyDec)
+	err := v.Unmarshal(b)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return *v, nil
+}
+
+func (i legacyDecValueCodec) EncodeJSON(value math.LegacyDec) ([]byte, error) {
+	return value.MarshalJSON()
+}
+
+func (i legacyDecValueCodec) DecodeJSON(b []byte) (math.LegacyDec, error) {
+	v := new(math.LegacyDec)
+	err := v.UnmarshalJSON(b)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return *v, nil
+}
+
+func (i legacyDecValueCodec) Stringify(value math.LegacyDec) string {
+	return value.String()
+}
+
+func (i legacyDecValueCodec) ValueType() string {
+	return "math.LegacyDec"
+}
+
+type coinValueCodec struct{}
+
+func (c coinValueCodec) Encode(value Coin) ([]byte, error) {
+	return value.Marshal()
+}
+
+func (c coinValueCodec) Decode(b []byte) (Coin, error) {
+	v := new(Coin)
+	err := v.Unmarshal(b)
+	if err != nil {
+		return Coin{}, err
+	}
+	return *v, nil
+}
+
+func (c coinValueCodec) EncodeJSON(value Coin) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func (c coinValueCodec) DecodeJSON(b []byte) (Coin, error) {
+	v := new(Coin)
+	err := json.Unmarshal(b, v)
+	if err != nil {
+		return Coin{}, err
+	}
+	return *v, nil
+}
+
+func (c coinValueCodec) Stringify(value Coin) string {
+	return value.String()
+}
+
+func (c coinValueCodec) ValueType() string {
+	return "sdk.Coin"
+}
+
+const timeKeySize = 12
+
+type timeKeyCodec struct{}
+
+func (t timeKeyCodec) Encode(buffer []byte, key time.Time) (int, error) {
+	binary.BigEndian.PutUint64(buffer, uint64(key.Unix()))
+	buffer[0] ^= 0x80
+	binary.BigEndian.PutUint32(buffer[8:], uint32(key.Nanosecond()))
+	return timeKeySize, nil
+}
+
+func (t timeKeyCodec) Decode(buffer []byte) (int, time.Time, error) {
+	if len(buffer) < timeKeySize {
+		return 0, time.Time{}, fmt.Errorf("%w: invalid buffer size, wanted: %d", collcodec.ErrEncoding, timeKeySize)
+	}
+	secs := binary.BigEndian.Uint64(buffer) ^ (1 << 63)
+	nanos := binary.BigEndian.Uint32(buffer[8:])
+	return timeKeySize, time.Unix(int64(secs), int64(nanos)).UTC(), nil
+}
+
+func (t timeKeyCodec) Size(_ time.Time) int { return timeKeySize }
+
+func (t timeKeyCodec) EncodeJSON(value time.Time) ([]byte, error) {
+	return value.UTC().MarshalJSON()
+}
+
+func (t timeKeyCodec) DecodeJSON(b []byte) (time.Time, error) {
+	var v time.Time
+	err := v.UnmarshalJSON(b)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return v.UTC(), nil
+}
+
+func (t timeKeyCodec) Stringify(key time.Time) string {
+	return key.UTC().Format(time.RFC3339Nano)
+}
+
+func (t timeKeyCodec) KeyType() string {
+	return "time.Time"
+}
+
+func (t timeKeyCodec) EncodeNonTerminal(buffer []byte, key time.Time) (int, error) {
+	return t.Encode(buffer, key)
+}
+
+func (t timeKeyCodec) DecodeNonTerminal(buffer []byte) (int, time.Time, error) {
+	return t.Decode(buffer)
+}
+
+func (t timeKeyCodec) SizeNonTerminal(key time.Time) int {
+	return t.Size(key)
+}
+
+type intKeyCodec struct{}
+
+func (i intKeyCodec) Encode(buffer []byte, key math.Int) (int, error) {
+	if key.IsNil() {
+		return 0, fmt.Errorf("%w: cannot encode nil Int", collcodec.ErrEncoding)
+	}
+	return encodeBigIntKey(buffer, key.BigInt())
+}
+
+func (i intKeyCodec) Decode(buffer []byte) (int, math.Int, error) {
+	read, bi, err := decodeBigIntKey(buffer)
+	if err != nil {
+		return 0, math.Int{}, err
+	}
+	if bi.BitLen() > math.MaxBitLen {
+		return 0, math.Int{}, fmt.Errorf("%w: integer out of range, maximum bit length is %d", collcodec.ErrEncoding, math.MaxBitLen)
+	}
+	return read, math.NewIntFromBigInt(bi), nil
+}
+
+func (i intKeyCodec) Size(key math.Int) int {
+	if key.IsNil() {
+		return 0
+	}
+	return bigIntKeySize(key.BigInt())
+}
+
+func (i intKeyCodec) EncodeJSON(value math.Int) ([]byte, error) {
+	return IntValue.EncodeJSON(value)
+}
+
+func (i intKeyCodec) DecodeJSON(b []byte) (math.Int, error) {
+	return IntValue.DecodeJSON(b)
+}
+
+func (i intKeyCodec) Stringify(key math.Int) string {
+	return key.String()
+}
+
+func (i intKeyCodec) KeyType() string {
+	return "math.Int"
+}
+
+func (i intKeyCodec) EncodeNonTerminal(buffer []byte, key math.Int) (int, error) {
+	return i.Encode(buffer, key)
+}
+
+func (i intKeyCodec) DecodeNonTerminal(buffer []byte) (int, math.Int, error) {
+	return i.Decode(buffer)
+}
+
+func (i intKeyCodec) SizeNonTerminal(key math.Int) int {
+	return i.Size(key)
+}
+
+type legacyDecKeyCodec struct{}
+
+func (d legacyDecKeyCodec) Encode(buffer []byte, key math.LegacyDec) (int, error) {
+	if key.IsNil() {
+		return 0, fmt.Errorf("%w: cannot encode nil LegacyDec", collcodec.ErrEncoding)
+	}
+	return encodeBigIntKey(buffer, key.BigInt())
+}
+
+func (d legacyDecKeyCodec) Decode(buffer []byte) (int, math.LegacyDec, error) {
+	read, bi, err := decodeBigIntKey(buffer)
+	if err != nil {
+		return 0, math.LegacyDec{}, err
+	}
+	return read, math.LegacyNewDecFromBigIntWithPrec(bi, math.LegacyPrecision), nil
+}
+
+func (d legacyDecKeyCodec) Size(key math.LegacyDec) int {
+	if key.IsNil() {
+		return 0
+	}
+	return bigIntKeySize(key.BigInt())
+}
+
+func (d legacyDecKeyCodec) EncodeJSON(value math.LegacyDec) ([]byte, error) {
+	return LegacyDecValue.EncodeJSON(value)
+}
+
+func (d legacyDecKeyCodec) DecodeJSON(b []byte) (math.LegacyDec, error) {
+	return LegacyDecValue.DecodeJSON(b)
+}
+
+func (d legacyDecKeyCodec) Stringify(key math.LegacyDec) string {
+	return key.String()
+}
+
+func (d legacyDecKeyCodec) KeyType() string {
+	return "math.LegacyDec"
+}
+
+func (d legacyDecKeyCodec) EncodeNonTerminal(buffer []byte, key math.LegacyDec) (int, error) {
+	return d.Encode(buffer, key)
+}
+
+func (d legacyDecKeyCodec) DecodeNonTerminal(buffer []byte) (int, math.LegacyDec, error) {
+	return d.Decode(buffer)
+}
+
+func (d legacyDecKeyCodec) SizeNonTerminal(key math.LegacyDec) int {
+	return d.Size(key)
+}
+
+// bigIntKeyZero is the header byte of the zero value in the big.Int key encoding.
+// Positive numbers have a header greater than bigIntKeyZero, by the length of their
+// absolute value, negative numbers have a header smaller than bigIntKeyZero, by the
+// length of their absolute value.
+const (
+	bigIntKeyZero      = 0x80
+	bigIntKeyMaxLength = 0x7f
+)
+
+// encodeBigIntKey encodes the big.Int retaining ordering.
+func encodeBigIntKey(buffer []byte, i *big.Int) (int, error) {
+	abs := i.Bytes()
+	if len(abs) > bigIntKeyMaxLength {
+		return 0, fmt.Errorf("%w: integer too big, maximum size is %d bytes, got %d", collcodec.ErrEncoding, bigIntKeyMaxLength, len(abs))
+	}
+	if i.Sign() >= 0 {
+		buffer[0] = byte(bigIntKeyZero + len(abs))
+		copy(buffer[1:], abs)
+		return 1 + len(abs), nil
+	}
+	buffer[0] = byte(bigIntKeyZero - len(abs))
+	for j, b := range abs {
+		buffer[1+j] = ^b
+	}
+	return 1 + len(abs), nil
+}
+
+// decodeBigIntKey decodes a big.Int encoded with encodeBigIntKey.
+func decodeBigIntKey(buffer []byte) (int, *big.Int, error) {
+	if len(buffer) < 1 {
+		return 0, nil, fmt.Errorf("%w: invalid buffer size, wanted at least 1", collcodec.ErrEncoding)
+	}
+	header := int(buffer[0])
+	negative := header < bigIntKeyZero
+	length := header - bigIntKeyZero
+	if negative {
+		length = bigIntKeyZero - header
+	}
+	if length > bigIntKeyMaxLength || len(buffer) < 1+length {
+		return 0, nil, fmt.Errorf("%w: invalid buffer size, wanted at least %d", collcodec.ErrEncoding, 1+length)
+	}
+	abs := make([]byte, length)
+	copy(abs, buffer[1:1+length])
+	if negative {
+		for j := range abs {
+			abs[j] = ^abs[j]
+		}
+	}
+	i := new(big.Int).SetBytes(abs)
+	if negative {
+		i.Neg(i)
+	}
+	return 1 + length, i, nil
+}
+
+func bigIntKeySize(i *big.Int) int {
+	return 1 + len(i.Bytes())
+}
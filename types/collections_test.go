@@ -1,9 +1,17 @@
 package types
 
 import (
+	"bytes"
+	"math/big"
+	"sort"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
 
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
 )
 
 func TestCollectionsCorrectness(t *testing.T) {
@@ -18,4 +26,90 @@ func TestCollectionsCorrectness(t *testing.T) {
 	t.Run("ConsAddress", func(t *testing.T) {
 		colltest.TestKeyCodec(t, ConsAddressKey, ConsAddress{0x32, 0x0, 0x0, 0x3})
 	})
+
+	t.Run("Time", func(t *testing.T) {
+		colltest.TestKeyCodec(t, TimeKey, time.Date(2023, 3, 15, 10, 30, 0, 500, time.UTC))
+		colltest.TestKeyCodec(t, TimeKey, time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC))
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		colltest.TestKeyCodec(t, IntKey, math.NewInt(0))
+		colltest.TestKeyCodec(t, IntKey, math.NewInt(-1000))
+		colltest.TestKeyCodec(t, IntKey, math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 255)))
+	})
+
+	t.Run("LegacyDec", func(t *testing.T) {
+		colltest.TestKeyCodec(t, LegacyDecKey, math.LegacyNewDecWithPrec(-15, 1))
+		colltest.TestKeyCodec(t, LegacyDecKey, math.LegacyZeroDec())
+	})
+}
+
+func TestCollectionsKeyCodecOrdering(t *testing.T) {
+	t.Run("Time", func(t *testing.T) {
+		rapid.Check(t, func(t *rapid.T) {
+			times := rapid.SliceOfN(rapid.Custom(func(t *rapid.T) time.Time {
+				return time.Unix(
+					rapid.Int64Range(-62135596800, 253402300799).Draw(t, "seconds"),
+					rapid.Int64Range(0, 999_999_999).Draw(t, "nanos"),
+				).UTC()
+			}), 1, 1_000).Draw(t, "times")
+			sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+			assertKeysOrdering(t, times, func(b []byte) time.Time {
+				_, v, err := TimeKey.Decode(b)
+				require.NoError(t, err)
+				return v
+			}, TimeKey.Size, TimeKey.Encode)
+		})
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		rapid.Check(t, func(t *rapid.T) {
+			ints := rapid.SliceOfN(rapid.Custom(genInt), 1, 1_000).Draw(t, "ints")
+			sort.Slice(ints, func(i, j int) bool { return ints[i].LT(ints[j]) })
+			assertKeysOrdering(t, ints, func(b []byte) math.Int {
+				_, v, err := IntKey.Decode(b)
+				require.NoError(t, err)
+				return v
+			}, IntKey.Size, IntKey.Encode)
+		})
+	})
+
+	t.Run("LegacyDec", func(t *testing.T) {
+		rapid.Check(t, func(t *rapid.T) {
+			decs := rapid.SliceOfN(rapid.Custom(func(t *rapid.T) math.LegacyDec {
+				return math.LegacyNewDecFromIntWithPrec(genInt(t), rapid.Int64Range(0, math.LegacyPrecision).Draw(t, "prec"))
+			}), 1, 1_000).Draw(t, "decs")
+			sort.Slice(decs, func(i, j int) bool { return decs[i].LT(decs[j]) })
+			assertKeysOrdering(t, decs, func(b []byte) math.LegacyDec {
+				_, v, err := LegacyDecKey.Decode(b)
+				require.NoError(t, err)
+				return v
+			}, LegacyDecKey.Size, LegacyDecKey.Encode)
+		})
+	})
+}
+
+// genInt generates a random Int with an absolute value of at most 192 bits,
+// so that it can also be used to build a LegacyDec.
+func genInt(t *rapid.T) math.Int {
+	abs := rapid.SliceOfN(rapid.Byte(), 0, 24).Draw(t, "abs")
+	i := new(big.Int).SetBytes(abs)
+	if rapid.Bool().Draw(t, "negative") {
+		i.Neg(i)
+	}
+	return math.NewIntFromBigInt(i)
+}
+
+// assertKeysOrdering asserts that the bytes representations of the sorted keys
+// are sorted too, and that they decode back to the original keys.
+func assertKeysOrdering[T interface{ String() string }](t *rapid.T, sorted []T, decode func([]byte) T, size func(T) int, encode func([]byte, T) (int, error)) {
+	var previous []byte
+	for _, key := range sorted {
+		current := make([]byte, size(key))
+		_, err := encode(current, key)
+		require.NoError(t, err)
+		require.True(t, bytes.Compare(previous, current) <= 0, "keys ordering is not retained")
+		require.Equal(t, key.String(), decode(current).String())
+		previous = current
+	}
 }
@@ -0,0 +1,187 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// ErrNoMatchingLane is returned when inserting a transaction matched by no lane
+// of a LaneMempool.
+var ErrNoMatchingLane = errors.New("no lane matches tx")
+
+// LaneMatchFn returns true if the transaction belongs to a lane. It must be
+// deterministic, as it is used to verify the proposals of other validators.
+type LaneMatchFn func(tx sdk.Tx) bool
+
+// Lane is a partition of a LaneMempool. The transactions matched by the lane are
+// stored and ordered by its own mempool, and may use up to MaxBlockSpace of the
+// bytes and gas of a block.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+
+	// Match returns true if the transaction belongs to the lane. A nil Match
+	// matches every transaction, which is used by a default lane.
+	Match LaneMatchFn
+
+	// Mempool stores the transactions of the lane and defines their order.
+	Mempool Mempool
+
+	// MaxBlockSpace is the share of the MaxTxBytes and of the max gas of a block
+	// which may be used by the transactions of the lane, in (0, 1].
+	MaxBlockSpace math.LegacyDec
+}
+
+// Matches returns true if the transaction belongs to the lane.
+func (l Lane) Matches(tx sdk.Tx) bool {
+	return l.Match == nil || l.Match(tx)
+}
+
+// Limit returns the share of the lane of a block limit.
+func (l Lane) Limit(blockLimit int64) int64 {
+	return l.MaxBlockSpace.MulInt64(blockLimit).TruncateInt64()
+}
+
+// Validate returns an error if the lane is not well-formed.
+func (l Lane) Validate() error {
+	if l.Name == "" {
+		return errors.New("lane name cannot be empty")
+	}
+	if l.Mempool == nil {
+		return fmt.Errorf("lane %s: mempool cannot be nil", l.Name)
+	}
+	if l.MaxBlockSpace.IsNil() || !l.MaxBlockSpace.IsPositive() || l.MaxBlockSpace.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("lane %s: max block space must be in (0, 1], got %s", l.Name, l.MaxBlockSpace)
+	}
+
+	return nil
+}
+
+// LaneMempool is a mempool partitioned in lanes. A transaction belongs to the
+// first lane matching it, in the order of the lanes, which is also the order of
+// the lanes in the blocks: the transactions of a lane are selected before the
+// ones of the next lanes, in the order of the mempool of the lane.
+//
+// The block space of the lanes is enforced by LaneProposalHandler in baseapp.
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool returns a mempool made of the lanes, in order of priority. It
+// panics if a lane is not valid or if two lanes have the same name.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	if len(lanes) == 0 {
+		panic("lane mempool must have at least one lane")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		if err := lane.Validate(); err != nil {
+			panic(err)
+		}
+		if names[lane.Name] {
+			panic(fmt.Sprintf("duplicate lane %s", lane.Name))
+		}
+		names[lane.Name] = true
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+// Lanes returns the lanes of the mempool, in order of priority.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the lane of the transaction, -1 if no lane
+// matches it.
+func (mp *LaneMempool) LaneIndex(tx sdk.Tx) int {
+	for i, lane := range mp.lanes {
+		if lane.Matches(tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert inserts the transaction in the mempool of its lane.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrNoMatchingLane
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of the lanes, in order of
+// priority of the lanes.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	return mp.selectFrom(ctx, txs, 0)
+}
+
+// selectFrom returns an iterator starting with the first non-empty lane from
+// the lane at index i.
+func (mp *LaneMempool) selectFrom(ctx context.Context, txs [][]byte, i int) Iterator {
+	for ; i < len(mp.lanes); i++ {
+		if it := mp.lanes[i].Mempool.Select(ctx, txs); it != nil {
+			return &laneIterator{mp: mp, ctx: ctx, txs: txs, lane: i, it: it}
+		}
+	}
+
+	return nil
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	var count int
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the transaction from the mempool of its lane.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+// laneIterator iterates over the transactions of the lanes of a LaneMempool.
+type laneIterator struct {
+	mp   *LaneMempool
+	ctx  context.Context
+	txs  [][]byte
+	lane int
+	it   Iterator
+}
+
+// Next implements Iterator.
+func (i *laneIterator) Next() Iterator {
+	if next := i.it.Next(); next != nil {
+		return &laneIterator{mp: i.mp, ctx: i.ctx, txs: i.txs, lane: i.lane, it: next}
+	}
+
+	return i.mp.selectFrom(i.ctx, i.txs, i.lane+1)
+}
+
+// Tx implements Iterator.
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.it.Tx()
+}
@@ -0,0 +1,96 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	oracle, gov := accounts[0].Address, accounts[1].Address
+
+	fromAddress := func(address sdk.AccAddress) mempool.LaneMatchFn {
+		return func(tx sdk.Tx) bool { return tx.(testTx).address.Equals(address) }
+	}
+	newLanes := func() []mempool.Lane {
+		return []mempool.Lane{
+			{Name: "oracle", Match: fromAddress(oracle), Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1)},
+			{Name: "gov", Match: fromAddress(gov), Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1)},
+			{Name: "default", Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyOneDec()},
+		}
+	}
+
+	mp := mempool.NewLaneMempool(newLanes()...)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	// the transactions are selected by lane, whatever the insertion order
+	txs := []testTx{
+		{id: 0, address: accounts[2].Address, nonce: 0},
+		{id: 1, address: gov, nonce: 0},
+		{id: 2, address: oracle, nonce: 0},
+		{id: 3, address: accounts[2].Address, nonce: 1},
+		{id: 4, address: oracle, nonce: 1},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 1, mp.Lanes()[1].Mempool.CountTx())
+	require.Equal(t, 2, mp.Lanes()[2].Mempool.CountTx())
+
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 10) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{2, 4, 1, 0, 3}, ids)
+
+	require.Equal(t, 0, mp.LaneIndex(txs[2]))
+	require.Equal(t, 1, mp.LaneIndex(txs[1]))
+	require.Equal(t, 2, mp.LaneIndex(txs[0]))
+
+	// a transaction is removed from its lane
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, 0, mp.Lanes()[1].Mempool.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+	require.Len(t, fetchTxs(mp.Select(ctx, nil), 10), 4)
+
+	// without a default lane, a transaction may be matched by no lane
+	mp = mempool.NewLaneMempool(newLanes()[:2]...)
+	require.ErrorIs(t, mp.Insert(ctx, txs[0]), mempool.ErrNoMatchingLane)
+	require.Equal(t, -1, mp.LaneIndex(txs[0]))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+
+	require.Equal(t, int64(100), newLanes()[0].Limit(1000))
+	require.Equal(t, int64(200), newLanes()[1].Limit(1000))
+}
+
+func TestNewLaneMempool(t *testing.T) {
+	lane := mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool(), MaxBlockSpace: math.LegacyOneDec()}
+	require.NotPanics(t, func() { mempool.NewLaneMempool(lane) })
+	require.Panics(t, func() { mempool.NewLaneMempool() })
+	require.Panics(t, func() { mempool.NewLaneMempool(lane, lane) })
+
+	invalid := []mempool.Lane{
+		{Mempool: lane.Mempool, MaxBlockSpace: lane.MaxBlockSpace},
+		{Name: "nil", MaxBlockSpace: lane.MaxBlockSpace},
+		{Name: "unset", Mempool: lane.Mempool},
+		{Name: "zero", Mempool: lane.Mempool, MaxBlockSpace: math.LegacyZeroDec()},
+		{Name: "more", Mempool: lane.Mempool, MaxBlockSpace: math.LegacyNewDecWithPrec(11, 1)},
+	}
+	for _, lane := range invalid {
+		require.Error(t, lane.Validate(), lane.Name)
+		require.Panics(t, func() { mempool.NewLaneMempool(lane) }, lane.Name)
+	}
+}
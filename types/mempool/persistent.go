@@ -0,0 +1,168 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*PersistentMempool)(nil)
+
+// PersistentMempool is a mempool recording the transactions inserted in and
+// removed from a mempool to a db, so that they survive the restarts of the node.
+// Each transaction is written to the db before being inserted in the mempool and
+// deleted from it when removed from the mempool, in the order of insertion.
+//
+// On startup, Replay passes the recorded transactions to a CheckTx function,
+// which inserts the ones which are still valid back in the mempool. The
+// transactions replaced in the mempool, e.g. by a transaction with the same
+// sender and nonce, stay in the db until the replay.
+//
+// The mempool owns the db, which is closed by Close.
+type PersistentMempool struct {
+	mempool   Mempool
+	db        dbm.DB
+	txEncoder sdk.TxEncoder
+
+	// keys maps the hashes of the transactions to their keys in the db.
+	keys    map[[sha256.Size]byte][]byte
+	nextSeq uint64
+}
+
+// NewPersistentMempool returns a mempool recording the transactions of the
+// mempool to the db.
+func NewPersistentMempool(mp Mempool, db dbm.DB, txEncoder sdk.TxEncoder) (*PersistentMempool, error) {
+	pm := &PersistentMempool{
+		mempool:   mp,
+		db:        db,
+		txEncoder: txEncoder,
+		keys:      make(map[[sha256.Size]byte][]byte),
+	}
+
+	it, err := db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if it.Valid() {
+		pm.nextSeq = sdk.BigEndianToUint64(it.Key()) + 1
+	}
+
+	return pm, it.Error()
+}
+
+// Insert records the transaction and inserts it in the mempool.
+func (mp *PersistentMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	bz, err := mp.txEncoder(tx)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(bz)
+	if _, ok := mp.keys[hash]; ok {
+		return mp.mempool.Insert(ctx, tx)
+	}
+
+	key := sdk.Uint64ToBigEndian(mp.nextSeq)
+	if err := mp.db.Set(key, bz); err != nil {
+		return err
+	}
+
+	if err := mp.mempool.Insert(ctx, tx); err != nil {
+		if delErr := mp.db.Delete(key); delErr != nil {
+			return errors.Join(err, delErr)
+		}
+		return err
+	}
+
+	mp.keys[hash] = key
+	mp.nextSeq++
+
+	return nil
+}
+
+// Select returns an iterator over the mempool.
+func (mp *PersistentMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	return mp.mempool.Select(ctx, txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PersistentMempool) CountTx() int {
+	return mp.mempool.CountTx()
+}
+
+// Remove removes the transaction from the mempool and deletes its record. The
+// record is deleted even if the transaction is not found in the mempool, e.g.
+// because it was replaced.
+func (mp *PersistentMempool) Remove(tx sdk.Tx) error {
+	bz, err := mp.txEncoder(tx)
+	if err != nil {
+		return err
+	}
+
+	err = mp.mempool.Remove(tx)
+
+	hash := sha256.Sum256(bz)
+	if key, ok := mp.keys[hash]; ok {
+		if delErr := mp.db.Delete(key); delErr != nil {
+			return errors.Join(err, delErr)
+		}
+		delete(mp.keys, hash)
+	}
+
+	return err
+}
+
+// Replay deletes the recorded transactions and passes them to checkTx in the
+// order of their insertion. checkTx is expected to insert the valid ones in the
+// mempool, which records them again, and to return an error for the others,
+// which are dropped. It returns the numbers of transactions kept and dropped.
+func (mp *PersistentMempool) Replay(checkTx func(txBytes []byte) error) (kept, dropped int, err error) {
+	it, err := mp.db.Iterator(nil, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var txs [][]byte
+	batch := mp.db.NewBatch()
+	defer batch.Close()
+
+	for ; it.Valid(); it.Next() {
+		txs = append(txs, append([]byte(nil), it.Value()...))
+		if err := batch.Delete(append([]byte(nil), it.Key()...)); err != nil {
+			it.Close()
+			return 0, 0, err
+		}
+	}
+	if err := it.Error(); err != nil {
+		it.Close()
+		return 0, 0, err
+	}
+	if err := it.Close(); err != nil {
+		return 0, 0, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, 0, err
+	}
+
+	mp.keys = make(map[[sha256.Size]byte][]byte)
+	for _, bz := range txs {
+		if err := checkTx(bz); err != nil {
+			dropped++
+			continue
+		}
+		kept++
+	}
+
+	return kept, dropped, nil
+}
+
+// Close closes the db of the mempool.
+func (mp *PersistentMempool) Close() error {
+	return mp.db.Close()
+}
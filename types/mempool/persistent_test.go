@@ -0,0 +1,100 @@
+package mempool_test
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestPersistentMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+
+	txs := []testTx{
+		{id: 0, address: accounts[0].Address, nonce: 0},
+		{id: 1, address: accounts[0].Address, nonce: 1},
+		{id: 2, address: accounts[1].Address, nonce: 0},
+		{id: 3, address: accounts[1].Address, nonce: 1},
+	}
+	encoder := func(tx sdk.Tx) ([]byte, error) {
+		return []byte(strconv.Itoa(tx.(testTx).id)), nil
+	}
+	decode := func(bz []byte) testTx {
+		id, err := strconv.Atoi(string(bz))
+		require.NoError(t, err)
+		return txs[id]
+	}
+
+	db := dbm.NewMemDB()
+	mp, err := mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(), db, encoder)
+	require.NoError(t, err)
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+	require.Len(t, fetchTxs(mp.Select(ctx, nil), 10), len(txs))
+
+	// a removed transaction is not recorded anymore
+	require.NoError(t, mp.Remove(txs[2]))
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, 3, mp.CountTx())
+
+	// a transaction rejected by the mempool is not recorded
+	full, err := mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(1)), dbm.NewMemDB(), encoder)
+	require.NoError(t, err)
+	require.NoError(t, full.Insert(ctx, txs[0]))
+	require.ErrorIs(t, full.Insert(ctx, txs[1]), mempool.ErrMempoolTxMaxCapacity)
+
+	// on restart, the recorded transactions are replayed in order of insertion
+	mp, err = mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(), db, encoder)
+	require.NoError(t, err)
+	require.Equal(t, 0, mp.CountTx())
+
+	var replayed []int
+	kept, dropped, err := mp.Replay(func(bz []byte) error {
+		tx := decode(bz)
+		replayed = append(replayed, tx.id)
+		if tx.id == 3 {
+			return errors.New("invalid tx")
+		}
+		return mp.Insert(ctx, tx)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 3}, replayed)
+	require.Equal(t, 2, kept)
+	require.Equal(t, 1, dropped)
+	require.Equal(t, 2, mp.CountTx())
+
+	// the dropped transaction is not replayed again, the new ones are after the kept ones
+	require.NoError(t, mp.Insert(ctx, txs[2]))
+	mp, err = mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(), db, encoder)
+	require.NoError(t, err)
+
+	replayed = nil
+	kept, dropped, err = mp.Replay(func(bz []byte) error {
+		replayed = append(replayed, decode(bz).id)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2}, replayed)
+	require.Equal(t, 3, kept)
+	require.Equal(t, 0, dropped)
+
+	// the records of the transactions not inserted back are deleted
+	kept, _, err = mp.Replay(func([]byte) error { return nil })
+	require.NoError(t, err)
+	require.Zero(t, kept)
+	require.NoError(t, mp.Close())
+}
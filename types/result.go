@@ -21,6 +21,11 @@ func (gi GasInfo) String() string {
 	return string(bz)
 }
 
+func (sgi StoreGasInfo) String() string {
+	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &sgi)
+	return string(bz)
+}
+
 func (r Result) String() string {
 	bz, _ := codec.MarshalYAML(codec.NewProtoCodec(nil), &r)
 	return string(bz)
@@ -0,0 +1,53 @@
+package tx
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// StateOverrideHandler applies the overrides of a module to the state of a
+// simulation. The store overrides are already applied when it is called.
+type StateOverrideHandler func(ctx sdk.Context, overrides *StateOverrides) error
+
+// IsEmpty returns true if the overrides do not change the state.
+func (o *StateOverrides) IsEmpty() bool {
+	return o == nil || (len(o.Stores) == 0 && len(o.Sequences) == 0 && len(o.Balances) == 0)
+}
+
+// ValidateBasic performs stateless validation of the overrides.
+func (o *StateOverrides) ValidateBasic() error {
+	if o == nil {
+		return nil
+	}
+
+	for i, store := range o.Stores {
+		if store.StoreName == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "store override %d: empty store name", i)
+		}
+		if len(store.Key) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "store override %d: empty key", i)
+		}
+		if !store.Delete && store.Value == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "store override %d: nil value", i)
+		}
+	}
+
+	for i, sequence := range o.Sequences {
+		if _, err := sdk.AccAddressFromBech32(sequence.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "sequence override %d: %s", i, err)
+		}
+	}
+
+	for i, balance := range o.Balances {
+		if _, err := sdk.AccAddressFromBech32(balance.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "balance override %d: %s", i, err)
+		}
+		if err := balance.Coins.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "balance override %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
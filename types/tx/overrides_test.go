@@ -0,0 +1,52 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestStateOverridesValidateBasic(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	testcases := []struct {
+		name      string
+		overrides *tx.StateOverrides
+		expErr    bool
+	}{
+		{"nil overrides", nil, false},
+		{"empty overrides", &tx.StateOverrides{}, false},
+		{"empty store name", &tx.StateOverrides{Stores: []tx.StoreOverride{{Key: []byte{1}, Value: []byte{2}}}}, true},
+		{"empty key", &tx.StateOverrides{Stores: []tx.StoreOverride{{StoreName: "acc", Value: []byte{2}}}}, true},
+		{"nil value", &tx.StateOverrides{Stores: []tx.StoreOverride{{StoreName: "acc", Key: []byte{1}}}}, true},
+		{"deleted key", &tx.StateOverrides{Stores: []tx.StoreOverride{{StoreName: "acc", Key: []byte{1}, Delete: true}}}, false},
+		{"empty value", &tx.StateOverrides{Stores: []tx.StoreOverride{{StoreName: "acc", Key: []byte{1}, Value: []byte{}}}}, false},
+		{"invalid sequence address", &tx.StateOverrides{Sequences: []tx.SequenceOverride{{Address: "invalid", Sequence: 1}}}, true},
+		{"invalid balance address", &tx.StateOverrides{Balances: []tx.BalanceOverride{{Address: "invalid"}}}, true},
+		{"invalid coins", &tx.StateOverrides{Balances: []tx.BalanceOverride{{
+			Address: addr.String(),
+			Coins:   sdk.Coins{sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("atom", 1)},
+		}}}, true},
+		{"happy case", &tx.StateOverrides{
+			Stores:    []tx.StoreOverride{{StoreName: "acc", Key: []byte{1}, Value: []byte{2}}},
+			Sequences: []tx.SequenceOverride{{Address: addr.String(), Sequence: 1}},
+			Balances:  []tx.BalanceOverride{{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}},
+		}, false},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.overrides.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
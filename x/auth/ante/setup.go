@@ -68,11 +68,19 @@ func (sud SetUpContextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 // SetGasMeter returns a new context with a gas meter set from a given context.
 func SetGasMeter(simulate bool, ctx sdk.Context, gasLimit uint64) sdk.Context {
+	var gasMeter storetypes.GasMeter
 	// In various cases such as simulation and during the genesis block, we do not
 	// meter any gas utilization.
 	if simulate || ctx.BlockHeight() == 0 {
-		return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		gasMeter = storetypes.NewInfiniteGasMeter()
+	} else {
+		gasMeter = storetypes.NewGasMeter(gasLimit)
 	}
 
-	return ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	// keep attributing the gas to the stores if the caller enabled it
+	if attributingGasMeter, ok := ctx.GasMeter().(storetypes.AttributingGasMeter); ok {
+		gasMeter = storetypes.NewAttributingGasMeter(gasMeter, attributingGasMeter.GasAttribution())
+	}
+
+	return ctx.WithGasMeter(gasMeter)
 }
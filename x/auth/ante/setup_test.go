@@ -49,6 +49,25 @@ func TestSetup(t *testing.T) {
 	require.Equal(t, gasLimit, newCtx.GasMeter().Limit(), "GasMeter not set correctly")
 }
 
+func TestSetGasMeterKeepsAttribution(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	attribution := storetypes.NewGasAttribution()
+	ctx := suite.ctx.WithBlockHeight(1).
+		WithGasMeter(storetypes.NewAttributingGasMeter(storetypes.NewInfiniteGasMeter(), attribution))
+
+	newCtx := ante.SetGasMeter(false, ctx, 100)
+	require.Equal(t, uint64(100), newCtx.GasMeter().Limit())
+
+	gasMeter, ok := newCtx.GasMeter().(storetypes.AttributingGasMeter)
+	require.True(t, ok, "GasMeter does not attribute the gas anymore")
+	require.Same(t, attribution, gasMeter.GasAttribution())
+
+	// without attribution the plain gas meter is set
+	newCtx = ante.SetGasMeter(false, suite.ctx.WithBlockHeight(1), 100)
+	_, ok = newCtx.GasMeter().(storetypes.AttributingGasMeter)
+	require.False(t, ok)
+}
+
 func TestRecoverPanic(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
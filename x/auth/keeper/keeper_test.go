@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -139,6 +140,36 @@ func (suite *KeeperTestSuite) TestAccountMapperRemoveAccount() {
 	suite.Require().Equal(accSeq2, acc2.GetSequence())
 }
 
+func (suite *KeeperTestSuite) TestStateOverrideHandler() {
+	ctx := suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	acc1 := suite.accountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.Require().NoError(acc1.SetSequence(3))
+	suite.accountKeeper.SetAccount(ctx, acc1)
+
+	overrides := &txtypes.StateOverrides{
+		Sequences: []txtypes.SequenceOverride{
+			{Address: addr1.String(), Sequence: 10},
+			{Address: addr2.String(), Sequence: 7},
+		},
+	}
+	suite.Require().NoError(suite.accountKeeper.StateOverrideHandler()(ctx, overrides))
+
+	acc1 = suite.accountKeeper.GetAccount(ctx, addr1)
+	suite.Require().Equal(uint64(10), acc1.GetSequence())
+
+	// the missing account is created
+	acc2 := suite.accountKeeper.GetAccount(ctx, addr2)
+	suite.Require().NotNil(acc2)
+	suite.Require().Equal(uint64(7), acc2.GetSequence())
+	suite.Require().NotEqual(acc1.GetAccountNumber(), acc2.GetAccountNumber())
+
+	overrides = &txtypes.StateOverrides{Sequences: []txtypes.SequenceOverride{{Address: "invalid"}}}
+	suite.Require().Error(suite.accountKeeper.StateOverrideHandler()(ctx, overrides))
+}
+
 func (suite *KeeperTestSuite) TestGetSetParams() {
 	ctx := suite.ctx
 	params := types.DefaultParams()
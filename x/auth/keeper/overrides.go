@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// StateOverrideHandler returns the handler applying the sequence overrides of the
+// simulations, the accounts which do not exist are created.
+func (ak AccountKeeper) StateOverrideHandler() txtypes.StateOverrideHandler {
+	return func(ctx sdk.Context, overrides *txtypes.StateOverrides) error {
+		for _, override := range overrides.Sequences {
+			addr, err := sdk.AccAddressFromBech32(override.Address)
+			if err != nil {
+				return err
+			}
+
+			acc := ak.GetAccount(ctx, addr)
+			if acc == nil {
+				acc = ak.NewAccountWithAddress(ctx, addr)
+			}
+
+			if err := acc.SetSequence(override.Sequence); err != nil {
+				return err
+			}
+			ak.SetAccount(ctx, acc)
+		}
+
+		return nil
+	}
+}
@@ -2,9 +2,13 @@ package tx
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -24,19 +28,43 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// TxServiceOptions are the optional functions of the Tx service, the requests
+// needing a function which is not set are rejected.
+type TxServiceOptions struct {
+	// SimulateWithOverrides simulates the transactions of the Simulate requests
+	// with state overrides, usually BaseApp#SimulateWithOverrides.
+	SimulateWithOverrides func(txBytes []byte, overrides *txtypes.StateOverrides) (sdk.GasInfo, *sdk.Result, error)
+
+	// TraceTx traces the transactions of the TraceTx requests, usually
+	// BaseApp#TraceTx.
+	TraceTx func(txBytes []byte, req *abci.RequestBeginBlock, predecessors [][]byte) (*txtypes.TxTrace, error)
+}
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
 	interfaceRegistry codectypes.InterfaceRegistry
+	opts              TxServiceOptions
 }
 
 // NewTxServer creates a new Tx service server.
 func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+	return NewTxServerWithOptions(clientCtx, simulate, interfaceRegistry, TxServiceOptions{})
+}
+
+// NewTxServerWithOptions creates a new Tx service server with the optional functions.
+func NewTxServerWithOptions(
+	clientCtx client.Context,
+	simulate baseAppSimulateFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	opts TxServiceOptions,
+) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
+		opts:              opts,
 	}
 }
 
@@ -95,7 +123,23 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
-	gasInfo, result, err := s.simulate(txBytes)
+	var (
+		gasInfo sdk.GasInfo
+		result  *sdk.Result
+		err     error
+	)
+	if req.Overrides.IsEmpty() {
+		gasInfo, result, err = s.simulate(txBytes)
+	} else {
+		if s.opts.SimulateWithOverrides == nil {
+			return nil, status.Error(codes.InvalidArgument, "state overrides are not supported")
+		}
+		if err := req.Overrides.ValidateBasic(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid overrides; %v", err)
+		}
+
+		gasInfo, result, err = s.opts.SimulateWithOverrides(txBytes, req.Overrides)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
 	}
@@ -304,16 +348,148 @@ func (s txServer) TxDecodeAmino(ctx context.Context, req *txtypes.TxDecodeAminoR
 	}, nil
 }
 
+// TraceTx implements the ServiceServer.TraceTx RPC method.
+func (s txServer) TraceTx(ctx context.Context, req *txtypes.TraceTxRequest) (*txtypes.TraceTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.opts.TraceTx == nil {
+		return nil, status.Error(codes.Unimplemented, "tx tracing is not supported")
+	}
+
+	if req.Hash == "" {
+		if len(req.TxBytes) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "tx hash or tx bytes cannot be empty")
+		}
+
+		trace, err := s.opts.TraceTx(req.TxBytes, nil, nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "failed to trace tx: %v", err)
+		}
+
+		return &txtypes.TraceTxResponse{Trace: trace}, nil
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %v", err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(ctx, hash, false)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Errorf(codes.NotFound, "tx not found: %s", req.Hash)
+		}
+
+		return nil, err
+	}
+
+	resBlock, err := node.Block(ctx, &resTx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	beginBlock, err := beginBlockRequest(ctx, node, resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	predecessors := make([][]byte, resTx.Index)
+	for i, tx := range resBlock.Block.Txs[:resTx.Index] {
+		predecessors[i] = tx
+	}
+
+	trace, err := s.opts.TraceTx(resTx.Tx, beginBlock, predecessors)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to trace tx: %v", err)
+	}
+
+	return &txtypes.TraceTxResponse{Trace: trace}, nil
+}
+
+// beginBlockRequest returns the BeginBlock request of the block, as built by
+// CometBFT: the last commit info lists the validators of the previous height with
+// whether they signed the last commit.
+func beginBlockRequest(ctx context.Context, node client.CometRPC, resBlock *coretypes.ResultBlock) (*abci.RequestBeginBlock, error) {
+	block := resBlock.Block
+	req := &abci.RequestBeginBlock{
+		Hash:                resBlock.BlockID.Hash,
+		Header:              *block.Header.ToProto(),
+		ByzantineValidators: block.Evidence.Evidence.ToABCI(),
+	}
+
+	if block.LastCommit == nil || len(block.LastCommit.Signatures) == 0 {
+		return req, nil
+	}
+
+	validators, err := validatorsAt(ctx, node, block.Height-1)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) != len(block.LastCommit.Signatures) {
+		return nil, fmt.Errorf("%d validators at height %d for the %d signatures of the last commit",
+			len(validators), block.Height-1, len(block.LastCommit.Signatures))
+	}
+
+	req.LastCommitInfo = abci.CommitInfo{
+		Round: block.LastCommit.Round,
+		Votes: make([]abci.VoteInfo, len(validators)),
+	}
+	for i, val := range validators {
+		req.LastCommitInfo.Votes[i] = abci.VoteInfo{
+			Validator:       abci.Validator{Address: val.Address, Power: val.VotingPower},
+			SignedLastBlock: !block.LastCommit.Signatures[i].Absent(),
+		}
+	}
+
+	return req, nil
+}
+
+// validatorsAt returns all the validators of the height, in the order of the
+// validator set.
+func validatorsAt(ctx context.Context, node client.CometRPC, height int64) ([]*cmttypes.Validator, error) {
+	var validators []*cmttypes.Validator
+	perPage := 100
+	for page := 1; ; page++ {
+		res, err := node.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if len(validators) >= res.Total || len(res.Validators) == 0 {
+			return validators, nil
+		}
+	}
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+) {
+	RegisterTxServiceWithOptions(qrt, clientCtx, simulateFn, interfaceRegistry, TxServiceOptions{})
+}
+
+// RegisterTxServiceWithOptions registers the tx service with the optional functions
+// on the gRPC router.
+func RegisterTxServiceWithOptions(
+	qrt gogogrpc.Server,
+	clientCtx client.Context,
+	simulateFn baseAppSimulateFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	opts TxServiceOptions,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServerWithOptions(clientCtx, simulateFn, interfaceRegistry, opts),
 	)
 }
 
@@ -298,3 +298,85 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 
 	return resp, nil
 }
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method
+func (k BaseKeeper) BalanceHistory(_ context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateHeightRange(req.FromHeight, req.ToHeight); err != nil {
+		return nil, err
+	}
+
+	history, err := k.balanceHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	changes, pageRes, err := history.BalanceChanges(addr, req.Denom, req.FromHeight, req.ToHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBalanceHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// SupplyHistory implements the Query/SupplyHistory gRPC method
+func (k BaseKeeper) SupplyHistory(_ context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateHeightRange(req.FromHeight, req.ToHeight); err != nil {
+		return nil, err
+	}
+
+	history, err := k.balanceHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	changes, pageRes, err := history.SupplyChanges(req.Denom, req.FromHeight, req.ToHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySupplyHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// balanceHistory returns the balance history index, or an error if it is not
+// enabled on the node.
+func (k BaseKeeper) balanceHistory() (*BalanceHistory, error) {
+	if k.history == nil || k.history.index == nil {
+		return nil, status.Error(codes.Unimplemented, "balance history is not enabled on this node")
+	}
+
+	return k.history.index, nil
+}
+
+// validateHeightRange returns an error if the heights of a history query are not
+// a valid range, a zero toHeight being the latest height.
+func validateHeightRange(fromHeight, toHeight int64) error {
+	if fromHeight < 0 || toHeight < 0 {
+		return status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+	if toHeight > 0 && fromHeight > toHeight {
+		return status.Errorf(codes.InvalidArgument, "from height %d is greater than to height %d", fromHeight, toHeight)
+	}
+
+	return nil
+}
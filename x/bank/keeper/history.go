@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ streamingabci.Listener = (*BalanceHistory)(nil)
+
+var (
+	// balanceHistoryPrefix is the prefix of the balance changes in the index, the
+	// keys are: 0x01 | len(address) | address | denom | 0x00 | big endian height.
+	balanceHistoryPrefix = []byte{0x01}
+
+	// supplyHistoryPrefix is the prefix of the supply changes in the index, the
+	// keys are: 0x02 | denom | 0x00 | big endian height.
+	supplyHistoryPrefix = []byte{0x02}
+)
+
+// BalanceHistory is an index of the changes of the balances and of the supply of
+// the coins, by height. It is not part of the state: it is fed with the state
+// changes of the bank store by a streaming service, see
+// NewBalanceHistoryStreamingService, and only covers the blocks committed since
+// it is enabled.
+//
+// The amount of a balance or of a supply is recorded at the heights of the blocks
+// in which it changed, so it is the amount of the last change at or below a height.
+type BalanceHistory struct {
+	db       dbm.DB
+	storeKey string
+	logger   log.Logger
+}
+
+// NewBalanceHistory returns an index of the balance changes stored in db, fed with
+// the state changes of the bank store.
+func NewBalanceHistory(db dbm.DB, storeKey storetypes.StoreKey, logger log.Logger) *BalanceHistory {
+	return &BalanceHistory{db: db, storeKey: storeKey.Name(), logger: logger.With("module", "x/"+types.ModuleName)}
+}
+
+// ListenBeginBlock implements abci.Listener. It performs a no-op.
+func (h *BalanceHistory) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements abci.Listener. It performs a no-op.
+func (h *BalanceHistory) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements abci.Listener. It performs a no-op.
+func (h *BalanceHistory) ListenDeliverTx(context.Context, int64, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements abci.Listener. It records the balances and the supplies
+// changed by the block at its height, a deleted balance or supply being zero. The
+// balance and supply keys which cannot be decoded are logged and skipped, so that
+// they do not stop the node.
+func (h *BalanceHistory) ListenCommit(_ context.Context, blockHeight int64, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	batch := h.db.NewBatch()
+	defer batch.Close()
+
+	zero, err := math.ZeroInt().Marshal()
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range changeSet {
+		if pair.StoreKey != h.storeKey {
+			continue
+		}
+
+		key, err := historyKey(pair.Key, blockHeight)
+		if err != nil {
+			h.logger.Error("skipping malformed bank store key", "key", fmt.Sprintf("%X", pair.Key), "height", blockHeight, "err", err)
+			continue
+		}
+		if key == nil {
+			continue
+		}
+
+		value := pair.Value
+		if pair.Delete {
+			value = zero
+		}
+		if err := batch.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// BalanceChanges returns the changes of the balance of the denom of the address,
+// between the heights fromHeight and toHeight, inclusive. A zero toHeight means
+// the latest height.
+func (h *BalanceHistory) BalanceChanges(
+	addr sdk.AccAddress, denom string, fromHeight, toHeight int64, pageReq *query.PageRequest,
+) ([]types.AmountChange, *query.PageResponse, error) {
+	return h.changes(balanceHistoryKeyPrefix(addr, denom), fromHeight, toHeight, pageReq)
+}
+
+// SupplyChanges returns the changes of the supply of the denom, between the
+// heights fromHeight and toHeight, inclusive. A zero toHeight means the latest
+// height.
+func (h *BalanceHistory) SupplyChanges(
+	denom string, fromHeight, toHeight int64, pageReq *query.PageRequest,
+) ([]types.AmountChange, *query.PageResponse, error) {
+	return h.changes(supplyHistoryKeyPrefix(denom), fromHeight, toHeight, pageReq)
+}
+
+// Close closes the db of the index.
+func (h *BalanceHistory) Close() error {
+	return h.db.Close()
+}
+
+// changes returns the changes recorded under the prefix, between the heights.
+func (h *BalanceHistory) changes(
+	keyPrefix []byte, fromHeight, toHeight int64, pageReq *query.PageRequest,
+) ([]types.AmountChange, *query.PageResponse, error) {
+	store := heightRangeStore{
+		KVStore: prefix.NewStore(dbadapter.Store{DB: h.db}, keyPrefix),
+		start:   sdk.Uint64ToBigEndian(uint64(fromHeight)),
+	}
+	if toHeight > 0 {
+		store.end = sdk.Uint64ToBigEndian(uint64(toHeight) + 1)
+	}
+
+	var changes []types.AmountChange
+	pageRes, err := query.Paginate(store, pageReq, func(key, value []byte) error {
+		var amount math.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+
+		changes = append(changes, types.AmountChange{
+			Height: int64(sdk.BigEndianToUint64(key)),
+			Amount: amount,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changes, pageRes, nil
+}
+
+// historyKey returns the key in the index of the change of a bank store key at
+// the height, nil if the key is neither a balance nor a supply.
+func historyKey(storeKey []byte, height int64) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(storeKey, types.BalancesPrefix):
+		addr, denom, err := types.AddressAndDenomFromBalancesStore(storeKey[len(types.BalancesPrefix):])
+		if err != nil {
+			return nil, err
+		}
+		return append(balanceHistoryKeyPrefix(addr, denom), sdk.Uint64ToBigEndian(uint64(height))...), nil
+
+	case bytes.HasPrefix(storeKey, types.SupplyKey.Bytes()):
+		denom := string(storeKey[len(types.SupplyKey.Bytes()):])
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, fmt.Errorf("invalid supply key %X: %w", storeKey, err)
+		}
+		return append(supplyHistoryKeyPrefix(denom), sdk.Uint64ToBigEndian(uint64(height))...), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// balanceHistoryKeyPrefix returns the prefix of the balance changes of the denom
+// of the address. The denom is followed by a 0x00 byte, which is not a valid
+// character of a denom, so that the prefix of a denom is not the prefix of the
+// changes of another denom.
+func balanceHistoryKeyPrefix(addr sdk.AccAddress, denom string) []byte {
+	key := append(append([]byte(nil), balanceHistoryPrefix...), address.MustLengthPrefix(addr)...)
+	return append(append(key, denom...), 0x00)
+}
+
+// supplyHistoryKeyPrefix returns the prefix of the supply changes of the denom.
+func supplyHistoryKeyPrefix(denom string) []byte {
+	key := append(append([]byte(nil), supplyHistoryPrefix...), denom...)
+	return append(key, 0x00)
+}
+
+// heightRangeStore restricts the iterators of a store of changes to the keys of
+// the heights in [start, end), a nil end being unbounded.
+type heightRangeStore struct {
+	storetypes.KVStore
+
+	start, end []byte
+}
+
+// Iterator implements types.KVStore.
+func (s heightRangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements types.KVStore.
+func (s heightRangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// clamp restricts the domain of an iterator to the range of the store.
+func (s heightRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if end == nil || (s.end != nil && bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	// an empty domain, which the iterators reject
+	if end != nil && bytes.Compare(start, end) > 0 {
+		end = start
+	}
+
+	return start, end
+}
+
+// NewBalanceHistoryStreamingService returns a streaming service feeding the index
+// with the state changes of the bank store, the index is closed with the service.
+// The errors of the db of the index are returned, stopping the node, as the index
+// must not miss a block.
+func NewBalanceHistoryStreamingService(history *BalanceHistory, storeKey storetypes.StoreKey) *streamingabci.StreamingService {
+	return streamingabci.NewStreamingService(
+		history, []storetypes.StoreKey{storeKey}, history.logger, true, func() { _ = history.Close() },
+	)
+}
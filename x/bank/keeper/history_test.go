@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestBalanceHistory() {
+	queryClient := suite.queryClient
+	addr, other := accAddrs[0], accAddrs[1]
+
+	// the queries are not served until the index is enabled
+	_, err := queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: addr.String(), Denom: fooDenom})
+	suite.Require().Equal(codes.Unimplemented, status.Code(err))
+	_, err = queryClient.SupplyHistory(gocontext.Background(), &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom})
+	suite.Require().Equal(codes.Unimplemented, status.Code(err))
+
+	history := keeper.NewBalanceHistory(dbm.NewMemDB(), storetypes.NewKVStoreKey(banktypes.StoreKey), log.NewNopLogger())
+	suite.bankKeeper.SetBalanceHistory(history)
+
+	balance := func(addr []byte, denom string, amount int64) *storetypes.StoreKVPair {
+		bz, err := math.NewInt(amount).Marshal()
+		suite.Require().NoError(err)
+		return &storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: append(banktypes.CreateAccountBalancesPrefix(addr), denom...), Value: bz}
+	}
+	supply := func(denom string, amount int64) *storetypes.StoreKVPair {
+		bz, err := math.NewInt(amount).Marshal()
+		suite.Require().NoError(err)
+		return &storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: append(banktypes.SupplyKey.Bytes(), denom...), Value: bz}
+	}
+	commit := func(height int64, changeSet ...*storetypes.StoreKVPair) {
+		suite.Require().NoError(history.ListenCommit(gocontext.Background(), height, abci.ResponseCommit{}, changeSet))
+	}
+
+	commit(1, balance(addr, fooDenom, 100), balance(addr, barDenom, 5), supply(fooDenom, 100), supply(barDenom, 5))
+	// the last change of a block is recorded, the other keys and stores are ignored
+	commit(2, balance(addr, fooDenom, 70), balance(addr, fooDenom, 60), balance(other, fooDenom, 40),
+		&storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: append(banktypes.DenomMetadataPrefix, fooDenom...), Value: []byte{1}},
+		&storetypes.StoreKVPair{StoreKey: "acc", Key: append(banktypes.CreateAccountBalancesPrefix(addr), fooDenom...), Value: []byte{1}},
+	)
+	// the malformed balance and supply keys are skipped
+	commit(3,
+		&storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: append(append([]byte(nil), banktypes.BalancesPrefix...), 0xff), Value: []byte{1}},
+		&storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: append(banktypes.SupplyKey.Bytes(), "!"...), Value: []byte{1}},
+	)
+	// a deleted balance is zero
+	commit(4, &storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: append(banktypes.CreateAccountBalancesPrefix(addr), fooDenom...), Delete: true}, balance(other, fooDenom, 100))
+	commit(5, supply(fooDenom, 150))
+
+	changes := func(heights []int64, amounts []int64) []banktypes.AmountChange {
+		res := make([]banktypes.AmountChange, len(heights))
+		for i := range heights {
+			res[i] = banktypes.AmountChange{Height: heights[i], Amount: math.NewInt(amounts[i])}
+		}
+		return res
+	}
+
+	res, err := queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: addr.String(), Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{1, 2, 4}, []int64{100, 60, 0}), res.Changes)
+
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: addr.String(), Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{1}, []int64{5}), res.Changes)
+
+	// the heights are inclusive
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: addr.String(), Denom: fooDenom, FromHeight: 2, ToHeight: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{2}, []int64{60}), res.Changes)
+
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: other.String(), Denom: fooDenom, FromHeight: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{4}, []int64{100}), res.Changes)
+
+	// the changes are paginated within the heights
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{
+		Address: addr.String(), Denom: fooDenom, ToHeight: 4, Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{1}, []int64{100}), res.Changes)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{
+		Address: addr.String(), Denom: fooDenom, ToHeight: 2, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{2}, []int64{60}), res.Changes)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{
+		Address: addr.String(), Denom: fooDenom, ToHeight: 3, Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{2}, []int64{60}), res.Changes)
+
+	supplyRes, err := queryClient.SupplyHistory(gocontext.Background(), &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(changes([]int64{1, 5}, []int64{100, 150}), supplyRes.Changes)
+
+	supplyRes, err = queryClient.SupplyHistory(gocontext.Background(), &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, FromHeight: 6})
+	suite.Require().NoError(err)
+	suite.Require().Empty(supplyRes.Changes)
+
+	// invalid requests
+	_, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: "invalid", Denom: fooDenom})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: addr.String()})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = queryClient.BalanceHistory(gocontext.Background(), &banktypes.QueryBalanceHistoryRequest{Address: addr.String(), Denom: fooDenom, FromHeight: 3, ToHeight: 2})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	_, err = queryClient.SupplyHistory(gocontext.Background(), &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, FromHeight: -1})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -59,6 +59,16 @@ type BaseKeeper struct {
 	cdc                    codec.BinaryCodec
 	storeKey               storetypes.StoreKey
 	mintCoinsRestrictionFn MintingRestrictionFn
+
+	// the balance history index, shared by the copies of the keeper so that it
+	// can be enabled once the keeper is created, e.g. by depinject.
+	history *balanceHistoryRef
+}
+
+// balanceHistoryRef is the balance history index of a BaseKeeper, nil if it is
+// not enabled.
+type balanceHistoryRef struct {
+	index *BalanceHistory
 }
 
 type MintingRestrictionFn func(ctx sdk.Context, coins sdk.Coins) error
@@ -100,9 +110,17 @@ func NewBaseKeeper(
 		cdc:                    cdc,
 		storeKey:               storeKey,
 		mintCoinsRestrictionFn: func(ctx sdk.Context, coins sdk.Coins) error { return nil },
+		history:                &balanceHistoryRef{},
 	}
 }
 
+// SetBalanceHistory enables the BalanceHistory and SupplyHistory queries, answered
+// from the index. The index must be fed with the state changes of the bank store,
+// see NewBalanceHistoryStreamingService.
+func (k BaseKeeper) SetBalanceHistory(history *BalanceHistory) {
+	k.history.index = history
+}
+
 // WithMintCoinsRestriction restricts the bank Keeper used within a specific module to
 // have restricted permissions on minting via function passed in parameter.
 // Previous restriction functions can be nested as such:
@@ -20,8 +20,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
@@ -565,6 +567,17 @@ func (suite *KeeperTestSuite) TestInputOutputCoins() {
 
 	acc3Balances := suite.bankKeeper.GetAllBalances(ctx, accAddrs[2])
 	require.Equal(expected, acc3Balances)
+
+	// without send restriction nor hooks, several inputs are accepted
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	inputs = []banktypes.Input{
+		{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []banktypes.Output{{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0, acc1}, accAddrs[2:3])
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
 }
 
 func (suite *KeeperTestSuite) TestSendCoins() {
@@ -623,6 +636,166 @@ func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	suite.Require().Error(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sendCoins))
 }
 
+func (suite *KeeperTestSuite) TestSendRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	// the bar transfers are rejected, the ones to accAddrs[1] are redirected to accAddrs[2]
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if amt.AmountOf(barDenom).IsPositive() {
+			return nil, fmt.Errorf("%s transfers are restricted", barDenom)
+		}
+		return toAddr, nil
+	})
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[2], nil
+		}
+		return toAddr, nil
+	})
+
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))), "bar transfers are restricted")
+	require.Equal(balances, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// a prepended restriction is applied first, its recipient is redirected again
+	suite.bankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(accAddrs[3]) {
+			return accAddrs[1], nil
+		}
+		return toAddr, nil
+	})
+
+	// the restrictions are applied to each output of a multi-send
+	inputs := []banktypes.Input{{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[2], accAddrs[2]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	outputs[0].Coins = sdk.NewCoins(newBarCoin(10))
+	inputs[0].Coins = sdk.NewCoins(newFooCoin(10), newBarCoin(10))
+	require.ErrorContains(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs), "bar transfers are restricted")
+	require.Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(50)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// with a send restriction, several inputs are rejected since there is no single sender
+	inputs = []banktypes.Input{
+		{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []banktypes.Output{{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs), banktypes.ErrMultipleSenders)
+
+	// a restriction cannot return an empty recipient
+	suite.bankKeeper.ClearSendRestriction()
+	suite.bankKeeper.AppendSendRestriction(func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) (sdk.AccAddress, error) {
+		return nil, nil
+	})
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrInvalidAddress)
+
+	// without restriction, the transfers are executed as is
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+	require.Equal(sdk.NewCoins(newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+}
+
+// mockBankHooks records the transfers seen by the bank hooks and rejects the
+// ones to the blocked address.
+type mockBankHooks struct {
+	blocked       sdk.AccAddress
+	before, after []string
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if toAddr.Equals(h.blocked) {
+		return fmt.Errorf("%s cannot receive funds", toAddr)
+	}
+	h.before = append(h.before, fmt.Sprintf("%s>%s:%s", fromAddr, toAddr, amt))
+	return nil
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	h.after = append(h.after, fmt.Sprintf("%s>%s:%s", fromAddr, toAddr, amt))
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestSendHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+	transfer := func(from, to sdk.AccAddress, amt sdk.Coins) string {
+		return fmt.Sprintf("%s>%s:%s", from, to, amt)
+	}
+
+	hooks := &mockBankHooks{blocked: accAddrs[3]}
+	suite.bankKeeper.SetHooks(hooks)
+
+	// the hooks are called by the sends from a module account
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+	funding := transfer(mintAcc.GetAddress(), accAddrs[0], balances)
+	require.Equal([]string{funding}, hooks.before)
+	require.Equal([]string{funding}, hooks.after)
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal([]string{funding, transfer(accAddrs[0], accAddrs[1], sendAmt)}, hooks.after)
+
+	// a transfer rejected by a hook is not executed
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sendAmt), "cannot receive funds")
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]).IsZero())
+	require.Len(hooks.after, 2)
+
+	// the hooks are called for each output of a multi-send
+	inputs := []banktypes.Input{{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sendAmt},
+		{Address: accAddrs[2].String(), Coins: sendAmt},
+	}
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, accAddrs[1:3])
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal([]string{
+		funding,
+		transfer(accAddrs[0], accAddrs[1], sendAmt),
+		transfer(accAddrs[0], accAddrs[1], sendAmt),
+		transfer(accAddrs[0], accAddrs[2], sendAmt),
+	}, hooks.after)
+	require.Equal(hooks.after, hooks.before)
+
+	outputs[1].Address = accAddrs[3].String()
+	require.ErrorContains(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs), "cannot receive funds")
+	require.Equal(sdk.NewCoins(newFooCoin(70)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the hooks set later are called after the previous ones
+	otherHooks := &mockBankHooks{}
+	suite.bankKeeper.SetHooks(otherHooks)
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Len(hooks.after, 5)
+	require.Equal([]string{transfer(accAddrs[0], accAddrs[1], sendAmt)}, otherHooks.after)
+
+	// with hooks, several inputs are rejected since there is no single sender
+	inputs = append(inputs, banktypes.Input{Address: accAddrs[1].String(), Coins: sendAmt})
+	outputs = []banktypes.Output{{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(30))}}
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs), banktypes.ErrMultipleSenders)
+}
+
 func (suite *KeeperTestSuite) TestValidateBalance() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -1812,3 +1985,33 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		}
 	})
 }
+
+func (suite *KeeperTestSuite) TestStateOverrideHandler() {
+	ctx := suite.ctx
+	require := suite.Require()
+	authKeeper, keeper := suite.authKeeper, suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(keeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	newAcc := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	authKeeper.EXPECT().HasAccount(ctx, accAddrs[0]).Return(true)
+	authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(false)
+	authKeeper.EXPECT().NewAccountWithAddress(ctx, accAddrs[1]).Return(newAcc)
+	authKeeper.EXPECT().SetAccount(ctx, newAcc)
+
+	overrides := &txtypes.StateOverrides{
+		Balances: []txtypes.BalanceOverride{
+			{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(30))},
+			{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newBarCoin(20))},
+		},
+	}
+	require.NoError(keeper.StateOverrideHandler()(ctx, overrides))
+
+	require.Equal(sdk.NewCoins(newFooCoin(30)), keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newBarCoin(20)), keeper.GetAllBalances(ctx, accAddrs[1]))
+
+	supply, _, err := keeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(20)), supply)
+}
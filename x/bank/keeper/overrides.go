@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// StateOverrideHandler returns the handler applying the balance overrides of the
+// simulations. The balances of an account are replaced by the overridden ones, the
+// account is created if it does not exist and the supply follows the balances.
+func (k BaseKeeper) StateOverrideHandler() txtypes.StateOverrideHandler {
+	return func(ctx sdk.Context, overrides *txtypes.StateOverrides) error {
+		for _, override := range overrides.Balances {
+			addr, err := sdk.AccAddressFromBech32(override.Address)
+			if err != nil {
+				return err
+			}
+
+			if !k.ak.HasAccount(ctx, addr) {
+				k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, addr))
+			}
+
+			balances := k.GetAllBalances(ctx, addr)
+			for _, balance := range balances {
+				if override.Coins.AmountOf(balance.Denom).IsZero() {
+					if err := k.overrideBalance(ctx, addr, balance, sdk.NewCoin(balance.Denom, math.ZeroInt())); err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, coin := range override.Coins {
+				balance := sdk.NewCoin(coin.Denom, balances.AmountOf(coin.Denom))
+				if err := k.overrideBalance(ctx, addr, balance, coin); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// overrideBalance replaces the balance of the account by the coin, updating the
+// supply by the difference.
+func (k BaseKeeper) overrideBalance(ctx sdk.Context, addr sdk.AccAddress, balance, coin sdk.Coin) error {
+	if err := k.setBalance(ctx, addr, coin); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, coin.Denom)
+	supply.Amount = supply.Amount.Add(coin.Amount).Sub(balance.Amount)
+	k.setSupply(ctx, supply)
+
+	return nil
+}
@@ -40,6 +40,11 @@ type SendKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	SetHooks(bh types.BankHooks)
+
 	GetAuthority() string
 }
 
@@ -60,6 +65,16 @@ type BaseSendKeeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// the send restriction and hooks, shared by the copies of the keeper so that
+	// they can be set once the keeper is created, e.g. by depinject.
+	sendHandlers *sendHandlers
+}
+
+// sendHandlers are the send restriction and hooks of a BaseSendKeeper.
+type sendHandlers struct {
+	restriction types.SendRestrictionFn
+	hooks       types.BankHooks
 }
 
 func NewBaseSendKeeper(
@@ -80,6 +95,7 @@ func NewBaseSendKeeper(
 		storeKey:       storeKey,
 		blockedAddrs:   blockedAddrs,
 		authority:      authority,
+		sendHandlers:   &sendHandlers{},
 	}
 }
 
@@ -110,9 +126,41 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return k.Params.Set(ctx, params)
 }
 
+// AppendSendRestriction adds a send restriction applied after the current
+// send restriction of the keeper, to the recipient it returns.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendHandlers.restriction = k.sendHandlers.restriction.Then(restriction)
+}
+
+// PrependSendRestriction adds a send restriction applied before the current
+// send restriction of the keeper.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendHandlers.restriction = restriction.Then(k.sendHandlers.restriction)
+}
+
+// ClearSendRestriction removes the send restriction of the keeper.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendHandlers.restriction = nil
+}
+
+// SetHooks adds hooks called around the transfers of coins, after the hooks
+// already set on the keeper.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if k.sendHandlers.hooks == nil {
+		k.sendHandlers.hooks = bh
+		return
+	}
+
+	k.sendHandlers.hooks = types.MultiBankHooks{k.sendHandlers.hooks, bh}
+}
+
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't line up or if any single transfer of tokens fails.
+//
+// With a single input, the send restriction and the hooks are called for each
+// output. Since they need the sender of the coins, several inputs are rejected
+// with ErrMultipleSenders when a send restriction or hooks are set.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -120,6 +168,38 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	var fromAddr sdk.AccAddress
+	if len(inputs) == 1 {
+		var err error
+		fromAddr, err = sdk.AccAddressFromBech32(inputs[0].Address)
+		if err != nil {
+			return err
+		}
+	} else if k.sendHandlers.restriction != nil || k.sendHandlers.hooks != nil {
+		return errorsmod.Wrap(types.ErrMultipleSenders, "the send restriction and hooks require a single input")
+	}
+
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		if fromAddr != nil {
+			outAddress, err = k.applySendRestriction(ctx, fromAddr, outAddress, out.Coins)
+			if err != nil {
+				return err
+			}
+
+			if err := k.beforeSend(ctx, fromAddr, outAddress, out.Coins); err != nil {
+				return err
+			}
+		}
+
+		outAddresses[i] = outAddress
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -139,12 +219,8 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
-		if err != nil {
-			return err
-		}
-
+	for i, out := range outputs {
+		outAddress := outAddresses[i]
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
@@ -152,7 +228,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -166,15 +242,31 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 			defer telemetry.IncrCounter(1, "new", "account")
 			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, outAddress))
 		}
+
+		if fromAddr != nil {
+			if err := k.afterSend(ctx, fromAddr, outAddress, out.Coins); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The send restriction of the keeper may reject the transfer or change its
+// recipient. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -209,7 +301,44 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	return nil
+	return k.afterSend(ctx, fromAddr, toAddr, amt)
+}
+
+// applySendRestriction applies the send restriction of the keeper to a transfer
+// and returns its recipient.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if k.sendHandlers.restriction == nil {
+		return toAddr, nil
+	}
+
+	newToAddr, err := k.sendHandlers.restriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if newToAddr.Empty() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "send restriction returned an empty recipient for %s", toAddr)
+	}
+
+	return newToAddr, nil
+}
+
+// beforeSend calls the BeforeSend hook, if the hooks are set.
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendHandlers.hooks == nil {
+		return nil
+	}
+
+	return k.sendHandlers.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+}
+
+// afterSend calls the AfterSend hook, if the hooks are set.
+func (k BaseSendKeeper) afterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendHandlers.hooks == nil {
+		return nil
+	}
+
+	return k.sendHandlers.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
 }
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
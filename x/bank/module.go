@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
@@ -12,6 +13,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	store "cosmossdk.io/store/types"
 
@@ -41,6 +43,13 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 )
 
+// Module init related flags
+const (
+	// FlagBalanceHistory enables the balance history index of the node, which
+	// serves the BalanceHistory and SupplyHistory queries.
+	FlagBalanceHistory = "x-bank-balance-history"
+)
+
 // AppModuleBasic defines the basic application module used by the bank module.
 type AppModuleBasic struct {
 	cdc codec.Codec
@@ -114,6 +123,11 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagBalanceHistory, false, "Index the balance and supply changes of the blocks committed from now on, serving the x/bank history queries")
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -203,6 +217,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetSendRestrictions, InvokeSetHooks),
 	)
 }
 
@@ -261,3 +276,53 @@ func ProvideModule(in BankInputs) BankOutputs {
 
 	return BankOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeSetSendRestrictions sets the send restrictions provided by the modules
+// on the bank keeper.
+func InvokeSetSendRestrictions(keeper keeper.BaseKeeper, restrictions map[string]types.SendRestrictionFn) error {
+	if len(restrictions) == 0 {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	order := maps.Keys(restrictions)
+	sort.Strings(order)
+
+	for _, modName := range order {
+		restriction, ok := restrictions[modName]
+		if !ok {
+			return fmt.Errorf("can't find send restriction for module %s", modName)
+		}
+		if restriction == nil {
+			return fmt.Errorf("send restriction for module %s is nil", modName)
+		}
+		keeper.AppendSendRestriction(restriction)
+	}
+
+	return nil
+}
+
+// InvokeSetHooks sets the bank hooks provided by the modules on the bank keeper.
+func InvokeSetHooks(keeper keeper.BaseKeeper, bankHooks map[string]types.BankHooksWrapper) error {
+	if len(bankHooks) == 0 {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	order := maps.Keys(bankHooks)
+	sort.Strings(order)
+
+	var multiHooks types.MultiBankHooks
+	for _, modName := range order {
+		hook, ok := bankHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find bank hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
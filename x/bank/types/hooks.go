@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankHooks are the hooks called by the bank keeper around the transfers of
+// coins between accounts, i.e. SendCoins and the outputs of InputOutputCoins,
+// which the transfers from and to module accounts go through. The transfer is
+// reverted if a hook returns an error.
+type BankHooks interface {
+	// BeforeSend is called before the coins are transferred, after the send
+	// restriction was applied, with the final recipient.
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	// AfterSend is called after the coins are transferred.
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// BankHooksWrapper is a wrapper for modules to inject BankHooks using depinject.
+type BankHooksWrapper struct{ BankHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BankHooksWrapper) IsOnePerModuleType() {}
+
+var _ BankHooks = MultiBankHooks{}
+
+// MultiBankHooks combines multiple bank hooks, all hook functions are run in
+// array sequence until one returns an error.
+type MultiBankHooks []BankHooks
+
+// NewMultiBankHooks returns the hooks running the given hooks in sequence.
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+// BeforeSend implements BankHooks.
+func (h MultiBankHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterSend implements BankHooks.
+func (h MultiBankHooks) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
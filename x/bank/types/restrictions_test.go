@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	ctx := sdk.Context{}
+	addr1, addr2, addr3 := sdk.AccAddress("addr1"), sdk.AccAddress("addr2"), sdk.AccAddress("addr3")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+
+	var calls []string
+	redirect := func(name string, from, to sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, name)
+			if toAddr.Equals(from) {
+				return to, nil
+			}
+			return toAddr, nil
+		}
+	}
+	reject := func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "reject")
+		return nil, errors.New("rejected")
+	}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	toAddr, err := types.NoOpSendRestrictionFn(ctx, addr1, addr2, amt)
+	require.NoError(t, err)
+	require.Equal(t, addr2, toAddr)
+
+	// each restriction gets the recipient returned by the previous one
+	restriction := types.ComposeSendRestrictions(redirect("first", addr2, addr3), nil, redirect("second", addr3, addr1))
+	toAddr, err = restriction(ctx, addr1, addr2, amt)
+	require.NoError(t, err)
+	require.Equal(t, addr1, toAddr)
+	require.Equal(t, []string{"first", "second"}, calls)
+
+	// the restrictions after a rejection are not applied
+	calls = nil
+	restriction = restriction.Then(reject).Then(redirect("third", addr1, addr2))
+	_, err = restriction(ctx, addr1, addr2, amt)
+	require.EqualError(t, err, "rejected")
+	require.Equal(t, []string{"first", "second", "reject"}, calls)
+}
@@ -0,0 +1,69 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// CircuitBreaker is the circuit breaker checked by CircuitBreakerDecorator,
+// usually the circuit keeper.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
+
+// CircuitBreakerDecorator rejects the transactions with a Msg whose circuit
+// breaker is tripped, including the Msgs nested in an authz.MsgExec, so that
+// they do not enter the mempool. Execution is also checked by the
+// MsgServiceRouter once the circuit breaker is set on the BaseApp.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitBreaker
+}
+
+// NewCircuitBreakerDecorator returns a new CircuitBreakerDecorator.
+func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := cbd.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs returns an error if the circuit breaker of a Msg, or of a Msg
+// nested in an authz.MsgExec, is tripped.
+func (cbd CircuitBreakerDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		url := sdk.MsgTypeURL(msg)
+		allowed, err := cbd.circuitKeeper.IsAllowed(ctx, url)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errorsmod.Wrapf(types.ErrMsgDisabled, "circuit breaker disables execution of %s", url)
+		}
+
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			nested, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := cbd.checkMsgs(ctx, nested); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/log"
+
+	"cosmossdk.io/x/circuit/ante"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb.disabled[typeURL], nil
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	addr := sdk.AccAddress("addr")
+
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins())
+	multiSend := banktypes.NewMsgMultiSend(nil, nil)
+	exec := authz.NewMsgExec(addr, []sdk.Msg{multiSend, send})
+	nestedExec := authz.NewMsgExec(addr, []sdk.Msg{&exec})
+
+	cb := mockCircuitBreaker{disabled: map[string]bool{sdk.MsgTypeURL(send): true}}
+	decorator := ante.NewCircuitBreakerDecorator(cb)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		allowed bool
+	}{
+		{"allowed msg", []sdk.Msg{multiSend}, true},
+		{"disabled msg", []sdk.Msg{multiSend, send}, false},
+		{"disabled msg in MsgExec", []sdk.Msg{&exec}, false},
+		{"disabled msg in nested MsgExec", []sdk.Msg{&nestedExec}, false},
+		{"allowed msg in MsgExec", []sdk.Msg{&authz.MsgExec{Grantee: addr.String(), Msgs: exec.Msgs[:1]}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, mockTx{msgs: tc.msgs}, false, next)
+			if tc.allowed {
+				assert.NilError(t, err)
+			} else {
+				assert.ErrorIs(t, err, types.ErrMsgDisabled)
+			}
+		})
+	}
+}
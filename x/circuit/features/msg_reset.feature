@@ -63,3 +63,37 @@ Feature: MsgResetCircuitBreaker
         }
         """
       Then expect an "msg enabled" error
+
+  Rule: all the messages the caller can trip are reset if none is specified
+
+    Example: caller resets the messages they have permission to trip
+      Given "acct1" has permission to trip circuit breaker for "cosmos.bank.v1beta1.MsgSend"
+      And "cosmos.bank.v1beta1.MsgSend" is disabled
+      And "cosmos.bank.v1beta1.MsgMultiSend" is disabled
+      When "acct1" attempts to reset a disabled message
+        """
+        { "msgs": [] }
+        """
+      Then expect success
+      And expect that "cosmos.bank.v1beta1.MsgSend" is enabled
+      And expect that "cosmos.bank.v1beta1.MsgMultiSend" is disabled
+
+    Example: caller with permission for all messages resets all the messages
+      Given "acct1" has permission "LEVEL_ALL_MSGS"
+      And all messages are disabled
+      When "acct1" attempts to reset a disabled message
+        """
+        { "msgs": [] }
+        """
+      Then expect success
+      And expect that "cosmos.bank.v1beta1.MsgSend" is enabled
+
+    Example: caller with permission for some messages has nothing to reset
+      Given "acct1" has permission to trip circuit breaker for "cosmos.bank.v1beta1.MsgSend"
+      And all messages are disabled
+      When "acct1" attempts to reset a disabled message
+        """
+        { "msgs": [] }
+        """
+      Then expect an "invalid request" error
+      And expect that "cosmos.bank.v1beta1.MsgSend" is disabled
@@ -74,3 +74,42 @@ Feature: MsgTripCircuitBreaker
         }
         """
       Then expect an "msg disabled" error
+
+  Rule: all the messages are disabled if none is specified
+
+    Example: user with permission for all messages disables all the messages
+      Given "acct1" has permission "LEVEL_ALL_MSGS"
+      When "acct1" attempts to disable msg execution
+        """
+        { "msgs": [] }
+        """
+      Then expect success
+      And expect that "cosmos.bank.v1beta1.MsgSend" is disabled
+      And expect that "cosmos.circuit.v1.MsgResetCircuitBreaker" is enabled
+
+    Example: user with permission for some messages cannot disable all the messages
+      Given "acct1" has permission to disable "cosmos.bank.v1beta1.MsgSend"
+      When "acct1" attempts to disable msg execution
+        """
+        { "msgs": [] }
+        """
+      Then expect an "unauthorized" error
+
+    Example: user tries to disable all the messages twice
+      Given "acct1" has permission "LEVEL_SUPER_ADMIN"
+      And all messages are disabled
+      When "acct1" attempts to disable msg execution
+        """
+        { "msgs": [] }
+        """
+      Then expect an "msg disabled" error
+
+  Rule: the circuit module's messages cannot be disabled
+
+    Example: user tries to disable a circuit message
+      Given "acct1" has permission "LEVEL_SUPER_ADMIN"
+      When "acct1" attempts to disable msg execution
+        """
+        { "msg": "cosmos.circuit.v1.MsgResetCircuitBreaker" }
+        """
+      Then expect an "invalid request" error
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/regen-network/gocuke"
+	"gotest.tools/v3/assert"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/circuit"
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// baseFixture defines the steps shared by the circuit Msg features. The
+// "authority" account is the module authority.
+type baseFixture struct {
+	t   gocuke.TestingT
+	err error
+	ctx sdk.Context
+
+	cdc       codec.Codec
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	accounts  map[string]sdk.AccAddress
+}
+
+func initFixture(t gocuke.TestingT) *baseFixture {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(circuit.AppModuleBasic{})
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k := keeper.NewKeeper(encCfg.Codec, key, authority.String())
+
+	return &baseFixture{
+		t:         t,
+		ctx:       ctx,
+		cdc:       encCfg.Codec,
+		keeper:    k,
+		msgServer: keeper.NewMsgServerImpl(k),
+		accounts:  map[string]sdk.AccAddress{"authority": authority},
+	}
+}
+
+// account returns the address of the named account.
+func (s *baseFixture) account(name string) sdk.AccAddress {
+	addr, ok := s.accounts[name]
+	if !ok {
+		addr = sdk.AccAddress(crypto.AddressHash([]byte(name)))
+		s.accounts[name] = addr
+	}
+
+	return addr
+}
+
+// level returns the permission level of its name.
+func (s *baseFixture) level(name string) types.CircuitBreakerPermissions_Level {
+	level, ok := types.CircuitBreakerPermissions_Level_value[name]
+	assert.Assert(s.t, ok, "unknown level %s", name)
+
+	return types.CircuitBreakerPermissions_Level(level)
+}
+
+// deliver runs the Msg like the MsgServiceRouter does.
+func (s *baseFixture) deliver(msg sdk.Msg, handler func() error) {
+	s.err = msg.ValidateBasic()
+	if s.err == nil {
+		s.err = handler()
+	}
+}
+
+// docField matches a string or a list of strings field of a feature document.
+var docField = regexp.MustCompile(`"(\w+)"\s*:\s*(\[[^\]]*\]|"[^"]*")`)
+
+// docFields returns the values of the fields of a feature document. The
+// documents are not always strict JSON, so each value of a list is read up to
+// the commas and trimmed of its quotes.
+func docFields(doc string) map[string][]string {
+	fields := map[string][]string{}
+	for _, match := range docField.FindAllStringSubmatch(doc, -1) {
+		values := []string{}
+		for _, value := range strings.Split(strings.Trim(match[2], "[]"), ",") {
+			if value = strings.Trim(strings.TrimSpace(value), `"`); value != "" {
+				values = append(values, value)
+			}
+		}
+		fields[match[1]] = values
+	}
+
+	return fields
+}
+
+// typeURL returns the Msg type URL of a Msg name or type URL.
+func typeURL(name string) string {
+	return "/" + strings.TrimPrefix(name, "/")
+}
+
+// docMsgTypeURLs returns the Msg type URLs of the "msg" or "msgs" field of a
+// feature document.
+func docMsgTypeURLs(doc string) []string {
+	fields := docFields(doc)
+	urls := []string{}
+	for _, name := range append(fields["msg"], fields["msgs"]...) {
+		urls = append(urls, typeURL(name))
+	}
+
+	return urls
+}
+
+func (s *baseFixture) setPermissions(name string, perms types.CircuitBreakerPermissions) {
+	assert.NilError(s.t, s.keeper.Permissions.Set(s.ctx, s.account(name), perms))
+}
+
+func (s *baseFixture) HasPermission(name, level string) {
+	s.setPermissions(name, types.CircuitBreakerPermissions{Level: s.level(level)})
+}
+
+func (s *baseFixture) HasNoPermissions(name string) {
+	assert.NilError(s.t, s.keeper.Permissions.Remove(s.ctx, s.account(name)))
+}
+
+func (s *baseFixture) setPermissionToTrip(name string, names ...string) {
+	urls := make([]string, len(names))
+	for i, name := range names {
+		urls[i] = typeURL(name)
+	}
+
+	s.setPermissions(name, types.CircuitBreakerPermissions{
+		Level:         types.CircuitBreakerPermissions_LEVEL_SOME_MSGS,
+		LimitMsgTypes: urls,
+	})
+}
+
+func (s *baseFixture) IsDisabled(url string) {
+	assert.NilError(s.t, s.keeper.DisableList.Set(s.ctx, typeURL(url)))
+}
+
+func (s *baseFixture) AllMessagesAreDisabled() {
+	assert.NilError(s.t, s.keeper.AllMsgsDisabled.Set(s.ctx, true))
+}
+
+func (s *baseFixture) ExpectSuccess() {
+	assert.NilError(s.t, s.err)
+}
+
+func (s *baseFixture) ExpectSucesss() {
+	s.ExpectSuccess()
+}
+
+func (s *baseFixture) ExpectAnError(msg string) {
+	assert.ErrorContains(s.t, s.err, msg)
+}
+
+func (s *baseFixture) ExpectThatIsDisabled(url string) {
+	allowed, err := s.keeper.IsAllowed(s.ctx, typeURL(url))
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, !allowed)
+}
+
+func (s *baseFixture) ExpectThatIsEnabled(url string) {
+	allowed, err := s.keeper.IsAllowed(s.ctx, typeURL(url))
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, allowed)
+}
+
+func (s *baseFixture) ExpectThatHasPermission(name, level string) {
+	perms, err := s.keeper.GetPermissions(s.ctx, s.account(name))
+	assert.NilError(s.t, err)
+	assert.Equal(s.t, s.level(level), perms.Level)
+}
+
+func (s *baseFixture) ExpectThatHasNoPermissions(name string) {
+	has, err := s.keeper.Permissions.Has(s.ctx, s.account(name))
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, !has)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the circuit module's genesis state from a given
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, account := range genState.AccountPermissions {
+		addr := sdk.MustAccAddressFromBech32(account.Address)
+		if err := k.Permissions.Set(ctx, addr, *account.Permissions); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, url := range genState.DisabledTypeUrls {
+		if err := k.DisableList.Set(ctx, url); err != nil {
+			panic(err)
+		}
+	}
+
+	if genState.AllMsgsDisabled {
+		if err := k.AllMsgsDisabled.Set(ctx, true); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var accounts []*types.GenesisAccountPermissions
+	err := k.Permissions.Walk(ctx, nil, func(addr sdk.AccAddress, perms types.CircuitBreakerPermissions) bool {
+		accounts = append(accounts, &types.GenesisAccountPermissions{
+			Address:     addr.String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+	// the iterator of an empty collection is invalid
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	disabledList, err := k.GetDisableList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	allDisabled, err := k.GetAllMsgsDisabled(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(accounts, disabledList)
+	genState.AllMsgsDisabled = allDisabled
+	return genState
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier constructor for the Querier struct
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Account returns the circuit breaker permissions of an account.
+func (q Querier) Account(ctx context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	perms, err := q.Keeper.GetPermissions(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountResponse{Permissions: &perms}, nil
+}
+
+// Accounts returns the circuit breaker permissions of all the accounts.
+func (q Querier) Accounts(ctx context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[sdk.AccAddress, types.CircuitBreakerPermissions](ctx, q.Keeper.Permissions, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*types.GenesisAccountPermissions, len(results))
+	for i, result := range results {
+		perms := result.Value
+		accounts[i] = &types.GenesisAccountPermissions{
+			Address:     result.Key.String(),
+			Permissions: &perms,
+		}
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the Msg type URLs whose processing is stopped, and whether
+// the processing of all the Msgs is stopped.
+func (q Querier) DisabledList(ctx context.Context, req *types.QueryDisabledListRequest) (*types.QueryDisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	disabledList, err := q.Keeper.GetDisableList(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	allDisabled, err := q.Keeper.GetAllMsgsDisabled(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisabledListResponse{DisabledList: disabledList, AllMsgsDisabled: allDisabled}, nil
+}
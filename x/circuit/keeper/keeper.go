@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper defines the circuit module's keeper.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing all the circuit breaker actions,
+	// usually the gov module account.
+	authority string
+
+	Schema collections.Schema
+	// Permissions are the circuit breaker permissions of the accounts.
+	Permissions collections.Map[sdk.AccAddress, types.CircuitBreakerPermissions]
+	// DisableList is the set of the disabled Msg type URLs.
+	DisableList collections.KeySet[string]
+	// AllMsgsDisabled is true if all the Msgs, except the circuit module's, are
+	// disabled.
+	AllMsgsDisabled collections.Item[bool]
+}
+
+// NewKeeper returns a new circuit keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeKey *storetypes.KVStoreKey, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	k := Keeper{
+		storeKey:        storeKey,
+		authority:       authority,
+		Permissions:     collections.NewMap(sb, types.AccountPermissionPrefix, "permissions", sdk.AccAddressKey, codec.CollValue[types.CircuitBreakerPermissions](cdc)),
+		DisableList:     collections.NewKeySet(sb, types.DisableListPrefix, "disable_list", collections.StringKey),
+		AllMsgsDisabled: collections.NewItem(sb, types.AllMsgsDisabledPrefix, "all_msgs_disabled", collections.BoolValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetPermissions returns the circuit breaker permissions of the account. The
+// authority always has LEVEL_SUPER_ADMIN.
+func (k Keeper) GetPermissions(ctx context.Context, addr sdk.AccAddress) (types.CircuitBreakerPermissions, error) {
+	if addr.String() == k.authority {
+		return types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN}, nil
+	}
+
+	perms, err := k.Permissions.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CircuitBreakerPermissions{}, nil
+	}
+
+	return perms, err
+}
+
+// IsAllowed returns false if the circuit breaker of the Msg type URL, or of all
+// the Msgs, is tripped. The circuit module's Msgs are always allowed. It
+// implements baseapp.CircuitBreaker.
+func (k Keeper) IsAllowed(ctx context.Context, msgTypeURL string) (bool, error) {
+	if isCircuitMsg(msgTypeURL) {
+		return true, nil
+	}
+
+	allDisabled, err := k.GetAllMsgsDisabled(ctx)
+	if err != nil || allDisabled {
+		return false, err
+	}
+
+	disabled, err := k.DisableList.Has(ctx, msgTypeURL)
+	if err != nil {
+		return false, err
+	}
+
+	return !disabled, nil
+}
+
+// DisabledError returns the error rejecting a Msg whose circuit breaker is
+// tripped. It implements baseapp.CircuitBreakerError.
+func (k Keeper) DisabledError(msgTypeURL string) error {
+	return errorsmod.Wrapf(types.ErrMsgDisabled, "circuit breaker disables execution of %s", msgTypeURL)
+}
+
+// GetAllMsgsDisabled returns true if all the Msgs, except the circuit module's,
+// are disabled.
+func (k Keeper) GetAllMsgsDisabled(ctx context.Context) (bool, error) {
+	disabled, err := k.AllMsgsDisabled.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+
+	return disabled, err
+}
+
+// GetDisableList returns the disabled Msg type URLs, in ascending order.
+func (k Keeper) GetDisableList(ctx context.Context) ([]string, error) {
+	var urls []string
+	err := k.DisableList.Walk(ctx, nil, func(url string) bool {
+		urls = append(urls, url)
+		return false
+	})
+	// the iterator of an empty collection is invalid
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	return urls, nil
+}
+
+// isCircuitMsg returns true if the Msg type URL is one of the circuit module's,
+// which are never disabled so that the circuit breaker can always be reset.
+func isCircuitMsg(url string) bool {
+	switch url {
+	case sdk.MsgTypeURL(&types.MsgAuthorizeCircuitBreaker{}),
+		sdk.MsgTypeURL(&types.MsgTripCircuitBreaker{}),
+		sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{}):
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	genState := types.NewGenesisState(
+		[]*types.GenesisAccountPermissions{
+			{
+				Address:     f.account("acct1").String(),
+				Permissions: &types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN},
+			},
+			{
+				Address: f.account("acct2").String(),
+				Permissions: &types.CircuitBreakerPermissions{
+					Level:         types.CircuitBreakerPermissions_LEVEL_SOME_MSGS,
+					LimitMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+				},
+			},
+		},
+		[]string{"/cosmos.bank.v1beta1.MsgMultiSend", "/cosmos.bank.v1beta1.MsgSend"},
+	)
+	genState.AllMsgsDisabled = true
+	assert.NilError(t, genState.Validate())
+
+	// an empty state exports the default genesis
+	assert.DeepEqual(t, types.DefaultGenesisState(), f.keeper.ExportGenesis(f.ctx))
+
+	f.keeper.InitGenesis(f.ctx, genState)
+	f.ExpectThatHasPermission("acct1", "LEVEL_SUPER_ADMIN")
+	f.ExpectThatIsDisabled("/cosmos.bank.v1beta1.MsgSend")
+	f.ExpectThatIsDisabled("/cosmos.staking.v1beta1.MsgDelegate")
+	f.ExpectThatIsEnabled("/cosmos.circuit.v1.MsgResetCircuitBreaker")
+
+	exported := f.keeper.ExportGenesis(f.ctx)
+	assert.Equal(t, len(genState.AccountPermissions), len(exported.AccountPermissions))
+	exportedPerms := make(map[string]types.CircuitBreakerPermissions)
+	for _, account := range exported.AccountPermissions {
+		exportedPerms[account.Address] = *account.Permissions
+	}
+	for _, account := range genState.AccountPermissions {
+		assert.DeepEqual(t, *account.Permissions, exportedPerms[account.Address])
+	}
+	assert.DeepEqual(t, genState.DisabledTypeUrls, exported.DisabledTypeUrls)
+	assert.Assert(t, exported.AllMsgsDisabled)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	f := initFixture(t)
+	addr := f.account("acct1").String()
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   string
+	}{
+		{"default", types.DefaultGenesisState(), ""},
+		{
+			"invalid address",
+			types.NewGenesisState([]*types.GenesisAccountPermissions{{Address: "invalid", Permissions: &types.CircuitBreakerPermissions{}}}, nil),
+			"invalid account address",
+		},
+		{
+			"duplicate account",
+			types.NewGenesisState([]*types.GenesisAccountPermissions{
+				{Address: addr, Permissions: &types.CircuitBreakerPermissions{}},
+				{Address: addr, Permissions: &types.CircuitBreakerPermissions{}},
+			}, nil),
+			"duplicate permissions",
+		},
+		{
+			"nil permissions",
+			types.NewGenesisState([]*types.GenesisAccountPermissions{{Address: addr}}, nil),
+			"cannot be nil",
+		},
+		{
+			"invalid permissions",
+			types.NewGenesisState([]*types.GenesisAccountPermissions{
+				{Address: addr, Permissions: &types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_SOME_MSGS}},
+			}, nil),
+			"limit msg types must be set",
+		},
+		{
+			"invalid type url",
+			types.NewGenesisState(nil, []string{"cosmos.bank.v1beta1.MsgSend"}),
+			"invalid msg type URL",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestQueries(t *testing.T) {
+	f := initFixture(t)
+	querier := keeper.NewQuerier(f.keeper)
+
+	f.HasPermission("acct1", "LEVEL_ALL_MSGS")
+	f.setPermissionToTrip("acct2", "/cosmos.bank.v1beta1.MsgSend")
+	f.IsDisabled("/cosmos.bank.v1beta1.MsgSend")
+
+	res, err := querier.Account(f.ctx, &types.QueryAccountRequest{Address: f.account("acct1").String()})
+	assert.NilError(t, err)
+	assert.Equal(t, types.CircuitBreakerPermissions_LEVEL_ALL_MSGS, res.Permissions.Level)
+
+	// the authority is always a super admin, accounts without permissions have none
+	res, err = querier.Account(f.ctx, &types.QueryAccountRequest{Address: f.account("authority").String()})
+	assert.NilError(t, err)
+	assert.Equal(t, types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN, res.Permissions.Level)
+	res, err = querier.Account(f.ctx, &types.QueryAccountRequest{Address: f.account("acct3").String()})
+	assert.NilError(t, err)
+	assert.Equal(t, types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED, res.Permissions.Level)
+
+	_, err = querier.Account(f.ctx, &types.QueryAccountRequest{Address: "invalid"})
+	assert.ErrorContains(t, err, "invalid address")
+
+	accounts, err := querier.Accounts(f.ctx, &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(accounts.Accounts))
+	assert.Equal(t, uint64(2), accounts.Pagination.Total)
+
+	accounts, err = querier.Accounts(f.ctx, &types.QueryAccountsRequest{Pagination: &query.PageRequest{Key: accounts.Pagination.NextKey}})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(accounts.Accounts))
+	assert.Assert(t, accounts.Pagination.NextKey == nil)
+
+	disabled, err := querier.DisabledList(f.ctx, &types.QueryDisabledListRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, disabled.DisabledList)
+	assert.Assert(t, !disabled.AllMsgsDisabled)
+
+	f.AllMessagesAreDisabled()
+	disabled, err = querier.DisabledList(f.ctx, &types.QueryDisabledListRequest{})
+	assert.NilError(t, err)
+	assert.Assert(t, disabled.AllMsgsDisabled)
+}
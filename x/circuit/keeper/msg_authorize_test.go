@@ -1,14 +1,14 @@
-package keeper
+package keeper_test
 
 import (
 	"testing"
 
 	"github.com/regen-network/gocuke"
-	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/types"
 )
 
 func TestAuthorize(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &authorizeSuite{}).Path("../features/msg_authorize.feature").Run()
 }
 
@@ -16,30 +16,27 @@ type authorizeSuite struct {
 	*baseFixture
 }
 
-func (s *authorizeSuite) Before(t *testing.T) {
+func (s *authorizeSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *authorizeSuite) HasPermission(a string, b string) {
-	panic("PENDING")
-}
-
-func (s *authorizeSuite) HasNoPermissions(a string) {
-	panic("PENDING")
-}
-
-func (s *authorizeSuite) AttemptsToGrantThePermissions(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
-}
-
-func (s *authorizeSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
-}
+func (s *authorizeSuite) AttemptsToGrantThePermissions(granter, grantee string, permissions gocuke.DocString) {
+	fields := docFields(permissions.Content)
+	perms := types.CircuitBreakerPermissions{}
+	for _, level := range fields["level"] {
+		perms.Level = s.level(level)
+	}
+	for _, name := range fields["limit_msg_types"] {
+		perms.LimitMsgTypes = append(perms.LimitMsgTypes, typeURL(name))
+	}
 
-func (s *authorizeSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
+	msg := types.NewMsgAuthorizeCircuitBreaker(s.account(granter).String(), s.account(grantee).String(), &perms)
+	s.deliver(msg, func() error {
+		_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, msg)
+		return err
+	})
 }
 
-func (s *authorizeSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+func (s *authorizeSuite) AttemptsToRevokeThePermissions(granter, grantee string, permissions gocuke.DocString) {
+	s.AttemptsToGrantThePermissions(granter, grantee, permissions)
 }
@@ -1,45 +1,55 @@
-package keeper
+package keeper_test
 
 import (
 	"testing"
 
 	"github.com/regen-network/gocuke"
-	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/types"
 )
 
 func TestReset(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &resetSuite{}).Path("../features/msg_reset.feature").Run()
 }
 
 type resetSuite struct {
 	*baseFixture
-}
 
-func (s *resetSuite) Before(t *testing.T) {
-	s.baseFixture = initFixture(t)
+	// enabled are the Msg type URLs which are not disabled before being reset.
+	enabled map[string]bool
 }
 
-func (s *resetSuite) HasPermission(a string, b string) {
-	panic("PENDING")
+func (s *resetSuite) Before(t gocuke.TestingT) {
+	s.baseFixture = initFixture(t)
+	s.enabled = map[string]bool{}
 }
 
-func (s *resetSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+func (s *resetSuite) HasPermissionToTripCircuitBreakerFor(name, msg string) {
+	s.setPermissionToTrip(name, msg)
 }
 
-func (s *resetSuite) AttemptsToResetCircuit(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
+func (s *resetSuite) HasPermissionHasBeenEnabled(name, level, msg string) {
+	s.HasPermission(name, level)
+	s.enabled[typeURL(msg)] = true
 }
 
-func (s *resetSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
+func (s *resetSuite) AttemptsToEnableADisabledMessage(authority string, doc gocuke.DocString) {
+	s.AttemptsToResetADisabledMessage(authority, doc)
 }
 
-func (s *resetSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
-}
+// AttemptsToResetADisabledMessage disables the Msgs of the document, except the
+// enabled ones, before resetting them.
+func (s *resetSuite) AttemptsToResetADisabledMessage(authority string, doc gocuke.DocString) {
+	urls := docMsgTypeURLs(doc.Content)
+	for _, url := range urls {
+		if !s.enabled[url] {
+			s.IsDisabled(url)
+		}
+	}
 
-func (s *resetSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	msg := types.NewMsgResetCircuitBreaker(s.account(authority).String(), urls)
+	s.deliver(msg, func() error {
+		_, err := s.msgServer.ResetCircuitBreaker(s.ctx, msg)
+		return err
+	})
 }
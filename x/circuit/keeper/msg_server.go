@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AuthorizeCircuitBreaker grants the permissions to the grantee, or revokes its
+// permissions with LEVEL_NONE_UNSPECIFIED. A super admin can grant or revoke any
+// permissions, an account with LEVEL_ALL_MSGS can only grant LEVEL_SOME_MSGS.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := k.GetPermissions(ctx, granter)
+	if err != nil {
+		return nil, err
+	}
+	switch perms.Level {
+	case types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN:
+	case types.CircuitBreakerPermissions_LEVEL_ALL_MSGS:
+		if msg.Permissions.Level != types.CircuitBreakerPermissions_LEVEL_SOME_MSGS {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s can only grant %s", msg.Granter, types.CircuitBreakerPermissions_LEVEL_SOME_MSGS)
+		}
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s cannot grant circuit breaker permissions", msg.Granter)
+	}
+
+	if msg.Permissions.Level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED {
+		err = k.Permissions.Remove(ctx, grantee)
+	} else {
+		err = k.Permissions.Set(ctx, grantee, *msg.Permissions)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyLevel, msg.Permissions.Level.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker disables the Msg type URLs. None of them may already be
+// disabled, and the authority must have the permission to trip each of them.
+// Without Msg type URL, all the Msgs except the circuit module's are disabled,
+// which requires LEVEL_SUPER_ADMIN or LEVEL_ALL_MSGS.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perms, err := k.accountPermissions(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		disabled, err := k.GetAllMsgsDisabled(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if disabled {
+			return nil, errorsmod.Wrap(types.ErrMsgDisabled, "all the msgs are already disabled")
+		}
+		if !canTripAll(perms) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s cannot trip the circuit breaker of all the msgs", msg.Authority)
+		}
+
+		if err := k.AllMsgsDisabled.Set(ctx, true); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, url := range msg.MsgTypeUrls {
+		if isCircuitMsg(url) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot trip the circuit breaker of %s", url)
+		}
+
+		allowed, err := k.IsAllowed(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, errorsmod.Wrapf(types.ErrMsgDisabled, "%s is already disabled", url)
+		}
+
+		if !perms.CanTrip(url) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s cannot trip the circuit breaker of %s", msg.Authority, url)
+		}
+
+		if err := k.DisableList.Set(ctx, url); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTripCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msg.MsgTypeUrls, ",")),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker enables the disabled Msg type URLs. Any authority with
+// circuit breaker permissions can reset any Msg type URL. If no Msg type URL is
+// given, every disabled Msg type URL the authority has the permission to trip is
+// enabled, and all the Msgs are enabled again if the authority can trip all of
+// them; it fails if there is nothing to reset.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perms, err := k.accountPermissions(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	if perms.Level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s has no circuit breaker permissions", msg.Authority)
+	}
+
+	urls := msg.MsgTypeUrls
+	if len(urls) == 0 {
+		allDisabled, err := k.GetAllMsgsDisabled(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resetAll := allDisabled && canTripAll(perms)
+		if resetAll {
+			if err := k.AllMsgsDisabled.Remove(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		disabledList, err := k.GetDisableList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, url := range disabledList {
+			if perms.CanTrip(url) {
+				urls = append(urls, url)
+			}
+		}
+
+		if !resetAll && len(urls) == 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s has no disabled msg to reset", msg.Authority)
+		}
+	}
+
+	for _, url := range urls {
+		disabled, err := k.DisableList.Has(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		if !disabled {
+			return nil, errorsmod.Wrapf(types.ErrMsgEnabled, "%s is not disabled", url)
+		}
+
+		if err := k.DisableList.Remove(ctx, url); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(urls, ",")),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// accountPermissions returns the circuit breaker permissions of the account.
+func (k msgServer) accountPermissions(ctx sdk.Context, account string) (types.CircuitBreakerPermissions, error) {
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return types.CircuitBreakerPermissions{}, err
+	}
+
+	return k.GetPermissions(ctx, addr)
+}
+
+// canTripAll returns true if the permissions allow to trip or reset the circuit
+// breaker of all the Msgs.
+func canTripAll(perms types.CircuitBreakerPermissions) bool {
+	return perms.Level == types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN ||
+		perms.Level == types.CircuitBreakerPermissions_LEVEL_ALL_MSGS
+}
@@ -1,14 +1,14 @@
-package keeper
+package keeper_test
 
 import (
 	"testing"
 
 	"github.com/regen-network/gocuke"
-	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/types"
 )
 
 func TestTrip(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &tripSuite{}).Path("../features/msg_trip.feature").Run()
 }
 
@@ -16,30 +16,31 @@ type tripSuite struct {
 	*baseFixture
 }
 
-func (s *tripSuite) Before(t *testing.T) {
+func (s *tripSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *tripSuite) HasPermission(a string, b string) {
-	panic("PENDING")
-}
-
-func (s *tripSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+func (s *tripSuite) HasPermissionToDisable(name, msg string) {
+	s.setPermissionToTrip(name, msg)
 }
 
-func (s *tripSuite) AttemptsToTripCircuit(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
+func (s *tripSuite) HasPermissionToDisableAnd(name, msg1, msg2 string) {
+	s.setPermissionToTrip(name, msg1, msg2)
 }
 
-func (s *tripSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
+func (s *tripSuite) HasPermissionToDiable(name, msg string) {
+	s.setPermissionToTrip(name, msg)
 }
 
-func (s *tripSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
+func (s *tripSuite) HasPermissionToDiableIsAlreadyTripped(name, msg string) {
+	s.setPermissionToTrip(name, msg)
+	s.AllMessagesAreDisabled()
 }
 
-func (s *tripSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+func (s *tripSuite) AttemptsToDisableMsgExecution(authority string, doc gocuke.DocString) {
+	msg := types.NewMsgTripCircuitBreaker(s.account(authority).String(), docMsgTypeURLs(doc.Content))
+	s.deliver(msg, func() error {
+		_, err := s.msgServer.TripCircuitBreaker(s.ctx, msg)
+		return err
+	})
 }
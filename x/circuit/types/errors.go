@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	// ErrMsgDisabled is returned when a Msg type URL is disabled by the circuit
+	// breaker.
+	ErrMsgDisabled = errors.Register(ModuleName, 2, "msg disabled")
+
+	// ErrMsgEnabled is returned when resetting the circuit breaker of a Msg
+	// type URL which is not disabled.
+	ErrMsgEnabled = errors.Register(ModuleName, 3, "msg enabled")
+)
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state for the circuit module.
+func NewGenesisState(accounts []*GenesisAccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accounts,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state, with no permissions and
+// no disabled Msg type URL.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.AccountPermissions))
+	for _, account := range gs.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", account.Address, err)
+		}
+		if seen[account.Address] {
+			return fmt.Errorf("duplicate permissions for account %s", account.Address)
+		}
+		seen[account.Address] = true
+
+		if account.Permissions == nil {
+			return fmt.Errorf("permissions of account %s cannot be nil", account.Address)
+		}
+		if err := account.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions of account %s: %w", account.Address, err)
+		}
+	}
+
+	return validateMsgTypeURLs(gs.DisabledTypeUrls)
+}
@@ -0,0 +1,25 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// AccountPermissionPrefix is the prefix of the circuit breaker permissions
+	// of the accounts: 0x1 | account_address -> ProtocolBuffer(CircuitBreakerPermissions)
+	AccountPermissionPrefix = collections.NewPrefix(1)
+
+	// DisableListPrefix is the prefix of the disabled Msg type URLs:
+	// 0x2 | msg_type_url -> []byte{}
+	DisableListPrefix = collections.NewPrefix(2)
+
+	// AllMsgsDisabledPrefix is the prefix of the flag stopping the processing of
+	// all the Msgs: 0x3 -> bool
+	AllMsgsDisabledPrefix = collections.NewPrefix(3)
+)
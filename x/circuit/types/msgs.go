@@ -0,0 +1,162 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+	_, _, _ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permissions *CircuitBreakerPermissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permissions,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if msg.Permissions == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "permissions cannot be nil")
+	}
+
+	return msg.Permissions.Validate()
+}
+
+// GetSigners returns the expected signers for a MsgAuthorizeCircuitBreaker.
+func (msg MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, urls []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return validateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSigners returns the expected signers for a MsgTripCircuitBreaker.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, urls []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSigners returns the expected signers for a MsgResetCircuitBreaker.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// Validate returns an error if the permissions are not well-formed: the Msg
+// type URLs must be set with LEVEL_SOME_MSGS and only with it.
+func (p CircuitBreakerPermissions) Validate() error {
+	if _, ok := CircuitBreakerPermissions_Level_name[int32(p.Level)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown permission level %d", p.Level)
+	}
+
+	if p.Level != CircuitBreakerPermissions_LEVEL_SOME_MSGS {
+		if len(p.LimitMsgTypes) != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "limit msg types cannot be set with %s", p.Level)
+		}
+		return nil
+	}
+
+	if len(p.LimitMsgTypes) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "limit msg types must be set with %s", p.Level)
+	}
+
+	return validateMsgTypeURLs(p.LimitMsgTypes)
+}
+
+// CanTrip returns true if the permissions allow to trip or reset the circuit
+// breaker of the Msg type URL.
+func (p CircuitBreakerPermissions) CanTrip(msgTypeURL string) bool {
+	switch p.Level {
+	case CircuitBreakerPermissions_LEVEL_SUPER_ADMIN, CircuitBreakerPermissions_LEVEL_ALL_MSGS:
+		return true
+	case CircuitBreakerPermissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitMsgTypes {
+			if url == msgTypeURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateMsgTypeURLs returns an error if a Msg type URL is not of the form
+// returned by sdk.MsgTypeURL, e.g. /cosmos.bank.v1beta1.MsgSend, or is
+// duplicated.
+func validateMsgTypeURLs(urls []string) error {
+	seen := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		if len(url) < 2 || !strings.HasPrefix(url, "/") {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid msg type URL %q", url)
+		}
+		if seen[url] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate msg type URL %s", url)
+		}
+		seen[url] = true
+	}
+
+	return nil
+}
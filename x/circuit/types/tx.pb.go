@@ -436,41 +436,41 @@ func init() {
 func init() { proto.RegisterFile("cosmos/circuit/v1/tx.proto", fileDescriptor_a02145e57a6fbb1d) }
 
 var fileDescriptor_a02145e57a6fbb1d = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0x26, 0x0a, 0x28, 0x13, 0x9a, 0xa6, 0x5b, 0xb5, 0x0d, 0x16, 0x98, 0xc8, 0x42, 0x28,
-	0x54, 0xad, 0x43, 0x82, 0xb8, 0xf4, 0x96, 0xb6, 0x2e, 0x44, 0x8a, 0xd3, 0xc8, 0x21, 0x1c, 0xb8,
-	0x58, 0xa9, 0x59, 0xb9, 0x4b, 0xed, 0xd8, 0xda, 0xdd, 0x44, 0x2d, 0x17, 0x10, 0x5f, 0xc0, 0x97,
-	0xa0, 0x7e, 0x06, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0xd0, 0x3b, 0xe2, 0x03, 0x90, 0xed, 0x38, 0x29,
-	0xd4, 0x11, 0xad, 0x38, 0x59, 0x9e, 0x79, 0xf3, 0xde, 0x5b, 0xbf, 0xf5, 0x80, 0x64, 0x79, 0xdc,
-	0xf5, 0x78, 0xd5, 0xa2, 0xcc, 0x1a, 0x52, 0x51, 0x1d, 0xd5, 0xaa, 0xe2, 0x54, 0xf5, 0x99, 0x27,
-	0x3c, 0xbc, 0x12, 0xf5, 0xd4, 0x69, 0x4f, 0x1d, 0xd5, 0xa4, 0x8d, 0x29, 0xdc, 0xe5, 0x76, 0x00,
-	0x75, 0xb9, 0x1d, 0x61, 0x95, 0xaf, 0x08, 0x24, 0x9d, 0xdb, 0x8d, 0xa1, 0x38, 0xf6, 0x18, 0xfd,
-	0x40, 0xf6, 0xa2, 0x99, 0x5d, 0x46, 0xfa, 0x27, 0x84, 0xe1, 0x12, 0xdc, 0xb5, 0x59, 0x7f, 0x20,
-	0x08, 0x2b, 0xa1, 0x32, 0xaa, 0xe4, 0x8c, 0xf8, 0x75, 0xde, 0x21, 0xa5, 0xf4, 0xd5, 0x0e, 0xc1,
-	0x6d, 0xc8, 0xfb, 0x84, 0xb9, 0x94, 0x73, 0xea, 0x0d, 0x78, 0x29, 0x53, 0x46, 0x95, 0x7c, 0x7d,
-	0x4b, 0xbd, 0x66, 0x4a, 0xfd, 0x53, 0xab, 0x33, 0x9f, 0x31, 0xae, 0x12, 0xec, 0xdc, 0xfb, 0x7c,
-	0x79, 0xbe, 0x19, 0xeb, 0x2a, 0x8f, 0x41, 0x59, 0xec, 0xd7, 0x20, 0xdc, 0xf7, 0x06, 0x9c, 0x28,
-	0x3f, 0x11, 0xdc, 0x5f, 0x48, 0x8f, 0x5f, 0x41, 0xd6, 0x21, 0x23, 0xe2, 0x84, 0x67, 0x2a, 0xd4,
-	0xeb, 0xb7, 0xf1, 0xa6, 0xb6, 0x82, 0x49, 0x23, 0x22, 0xc0, 0x4f, 0x60, 0xd9, 0xa1, 0x2e, 0x15,
-	0xa6, 0xcb, 0x6d, 0x53, 0x9c, 0xf9, 0x84, 0x97, 0xd2, 0xe5, 0x4c, 0x25, 0x67, 0x2c, 0x85, 0x65,
-	0x9d, 0xdb, 0xaf, 0x83, 0xa2, 0x62, 0x41, 0x36, 0x9c, 0xc3, 0x12, 0xac, 0xb7, 0xb4, 0x37, 0x5a,
-	0xcb, 0x6c, 0x1f, 0xb6, 0x35, 0xb3, 0xd7, 0xee, 0x76, 0xb4, 0xbd, 0xe6, 0x41, 0x53, 0xdb, 0x2f,
-	0xa6, 0xf0, 0x2a, 0x2c, 0x47, 0xbd, 0xee, 0xa1, 0xae, 0x99, 0x7a, 0xf7, 0x65, 0xb7, 0x88, 0x30,
-	0x86, 0x42, 0x54, 0x6c, 0xb4, 0x5a, 0x51, 0x2d, 0x8d, 0xd7, 0x60, 0x65, 0x0a, 0xec, 0x75, 0x34,
-	0xc3, 0x6c, 0xec, 0xeb, 0xcd, 0x76, 0x31, 0xa3, 0x50, 0x58, 0x0b, 0x04, 0x19, 0xf5, 0xff, 0x4a,
-	0xf1, 0x01, 0xe4, 0xfa, 0xd1, 0x07, 0x13, 0x67, 0xd3, 0x1c, 0xe7, 0x05, 0xac, 0xc0, 0x52, 0xec,
-	0xde, 0x1c, 0x32, 0x27, 0x3e, 0x41, 0xde, 0x8d, 0xcc, 0xf7, 0x98, 0xc3, 0x77, 0x0a, 0x41, 0x06,
-	0xf3, 0x19, 0xe5, 0x11, 0x3c, 0x4c, 0x94, 0x9a, 0x05, 0xf0, 0x1e, 0xd6, 0x75, 0x6e, 0x1b, 0x84,
-	0x13, 0xf1, 0x7f, 0x66, 0x32, 0xff, 0x36, 0x53, 0x06, 0x39, 0x59, 0x2b, 0x76, 0x53, 0xff, 0x95,
-	0x86, 0x8c, 0xce, 0x6d, 0xfc, 0x11, 0x36, 0x16, 0xdd, 0xf4, 0xed, 0x84, 0x4b, 0xb0, 0xf8, 0xa2,
-	0x49, 0x2f, 0x6e, 0x05, 0x8f, 0x8d, 0x60, 0x1f, 0x70, 0x42, 0x3e, 0x95, 0x64, 0xb2, 0xeb, 0x48,
-	0xe9, 0xd9, 0x4d, 0x91, 0x33, 0x45, 0x0e, 0xab, 0x49, 0x29, 0x3c, 0x4d, 0x26, 0x4a, 0x80, 0x4a,
-	0xb5, 0x1b, 0x43, 0x63, 0x51, 0x29, 0xfb, 0xe9, 0xf2, 0x7c, 0x13, 0xed, 0x1e, 0x7c, 0x1b, 0xcb,
-	0xe8, 0x62, 0x2c, 0xa3, 0x1f, 0x63, 0x19, 0x7d, 0x99, 0xc8, 0xa9, 0x8b, 0x89, 0x9c, 0xfa, 0x3e,
-	0x91, 0x53, 0x6f, 0xb7, 0x6c, 0x2a, 0x8e, 0x87, 0x47, 0xaa, 0xe5, 0xb9, 0xd5, 0x78, 0x93, 0x85,
-	0x8f, 0x6d, 0xfe, 0xee, 0xa4, 0x7a, 0x3a, 0x5b, 0x6b, 0xe1, 0x2f, 0x75, 0x74, 0x27, 0xdc, 0x55,
-	0xcf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x99, 0x58, 0xf0, 0xf5, 0x04, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0xcd, 0x24, 0xca, 0x7b, 0xca, 0x0d, 0x4d, 0xd3, 0xa9, 0xda, 0x06, 0xab, 0x98, 0xc8, 0x42,
+	0x28, 0x54, 0xe0, 0x90, 0x20, 0x58, 0x74, 0x97, 0xb6, 0x06, 0x22, 0xd9, 0x6e, 0xe4, 0x10, 0x16,
+	0x6c, 0xac, 0x10, 0x46, 0x66, 0xa8, 0x1d, 0x5b, 0x33, 0x4e, 0xd4, 0xb2, 0x01, 0xf1, 0x05, 0x7c,
+	0x09, 0xea, 0x67, 0xb0, 0xec, 0x92, 0x25, 0x4a, 0x16, 0xdd, 0x23, 0x3e, 0x00, 0xd9, 0x13, 0x27,
+	0x85, 0x3a, 0xa2, 0x15, 0x4b, 0xdf, 0x7b, 0xee, 0x39, 0x67, 0x7c, 0xee, 0x0c, 0x48, 0x03, 0x9f,
+	0x7b, 0x3e, 0xaf, 0x0f, 0x28, 0x1b, 0x8c, 0x68, 0x58, 0x1f, 0x37, 0xea, 0xe1, 0xb1, 0x1a, 0x30,
+	0x3f, 0xf4, 0xf1, 0x9a, 0xe8, 0xa9, 0xb3, 0x9e, 0x3a, 0x6e, 0x48, 0x5b, 0x33, 0xb8, 0xc7, 0x9d,
+	0x08, 0xea, 0x71, 0x47, 0x60, 0x95, 0x2f, 0x08, 0x24, 0x83, 0x3b, 0xad, 0x51, 0xf8, 0xd6, 0x67,
+	0xf4, 0x3d, 0xd9, 0x17, 0x33, 0x7b, 0x8c, 0xf4, 0x8f, 0x08, 0xc3, 0x15, 0xf8, 0xdf, 0x61, 0xfd,
+	0x61, 0x48, 0x58, 0x05, 0x55, 0x51, 0xad, 0x60, 0x25, 0x9f, 0x8b, 0x0e, 0xa9, 0x64, 0x2f, 0x76,
+	0x08, 0x36, 0xa1, 0x18, 0x10, 0xe6, 0x51, 0xce, 0xa9, 0x3f, 0xe4, 0x95, 0x5c, 0x15, 0xd5, 0x8a,
+	0xcd, 0xfb, 0xea, 0x25, 0x53, 0xea, 0xef, 0x5a, 0x9d, 0xc5, 0x8c, 0x75, 0x91, 0x60, 0xf7, 0xc6,
+	0xa7, 0xf3, 0xd3, 0x9d, 0x44, 0x57, 0xb9, 0x03, 0xca, 0x72, 0xbf, 0x16, 0xe1, 0x81, 0x3f, 0xe4,
+	0x44, 0xf9, 0x81, 0xe0, 0xe6, 0x52, 0x7a, 0xfc, 0x1c, 0xf2, 0x2e, 0x19, 0x13, 0x37, 0x3e, 0x53,
+	0xa9, 0xd9, 0xbc, 0x8e, 0x37, 0x55, 0x8f, 0x26, 0x2d, 0x41, 0x80, 0xef, 0xc2, 0xaa, 0x4b, 0x3d,
+	0x1a, 0xda, 0x1e, 0x77, 0xec, 0xf0, 0x24, 0x20, 0xbc, 0x92, 0xad, 0xe6, 0x6a, 0x05, 0x6b, 0x25,
+	0x2e, 0x1b, 0xdc, 0x79, 0x11, 0x15, 0x95, 0x01, 0xe4, 0xe3, 0x39, 0x2c, 0xc1, 0xa6, 0xae, 0xbd,
+	0xd4, 0x74, 0xdb, 0x3c, 0x34, 0x35, 0xbb, 0x67, 0x76, 0x3b, 0xda, 0x7e, 0xfb, 0x69, 0x5b, 0x3b,
+	0x28, 0x67, 0xf0, 0x3a, 0xac, 0x8a, 0x5e, 0xf7, 0xd0, 0xd0, 0x6c, 0xa3, 0xfb, 0xac, 0x5b, 0x46,
+	0x18, 0x43, 0x49, 0x14, 0x5b, 0xba, 0x2e, 0x6a, 0x59, 0xbc, 0x01, 0x6b, 0x33, 0x60, 0xaf, 0xa3,
+	0x59, 0x76, 0xeb, 0xc0, 0x68, 0x9b, 0xe5, 0x9c, 0x42, 0x61, 0x23, 0x12, 0x64, 0x34, 0xf8, 0x23,
+	0xc5, 0x6d, 0x28, 0xf4, 0xc5, 0x0f, 0x0b, 0x4f, 0x66, 0x39, 0x2e, 0x0a, 0x58, 0x81, 0x95, 0xc4,
+	0xbd, 0x3d, 0x62, 0x6e, 0x72, 0x82, 0xa2, 0x27, 0xcc, 0xf7, 0x98, 0xcb, 0x77, 0x4b, 0x51, 0x06,
+	0x8b, 0x19, 0xe5, 0x36, 0xdc, 0x4a, 0x95, 0x9a, 0x07, 0xf0, 0x0e, 0x36, 0x0d, 0xee, 0x58, 0x84,
+	0x93, 0xf0, 0xdf, 0xcc, 0xe4, 0xfe, 0x6e, 0xa6, 0x0a, 0x72, 0xba, 0x56, 0xe2, 0xa6, 0xf9, 0x33,
+	0x0b, 0x39, 0x83, 0x3b, 0xf8, 0x03, 0x6c, 0x2d, 0xdb, 0xf4, 0x07, 0x29, 0x4b, 0xb0, 0x7c, 0xd1,
+	0xa4, 0xc7, 0xd7, 0x82, 0x27, 0x46, 0x70, 0x00, 0x38, 0x25, 0x9f, 0x5a, 0x3a, 0xd9, 0x65, 0xa4,
+	0xf4, 0xf0, 0xaa, 0xc8, 0xb9, 0x22, 0x87, 0xf5, 0xb4, 0x14, 0xee, 0xa5, 0x13, 0xa5, 0x40, 0xa5,
+	0xc6, 0x95, 0xa1, 0x89, 0xa8, 0x94, 0xff, 0x78, 0x7e, 0xba, 0x83, 0xf6, 0x9e, 0x7c, 0x9d, 0xc8,
+	0xe8, 0x6c, 0x22, 0xa3, 0xef, 0x13, 0x19, 0x7d, 0x9e, 0xca, 0x99, 0xb3, 0xa9, 0x9c, 0xf9, 0x36,
+	0x95, 0x33, 0xaf, 0xb6, 0x05, 0x25, 0x7f, 0x73, 0xa4, 0x52, 0xbf, 0x7e, 0x3c, 0x7f, 0xc6, 0xe2,
+	0x2b, 0xf4, 0xfa, 0xbf, 0xf8, 0x6d, 0x7a, 0xf4, 0x6b, 0x00, 0x5d, 0x27, 0x84, 0x8e, 0xe5, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -103,6 +103,45 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the shares of the
+// tokenize share records of an owner, and sends them to the owner. The rewards
+// are withdrawn to the module accounts of the records, which also hold the
+// rewards withdrawn when the shares were modified, and whose balances are sent
+// to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	total := sdk.NewCoins()
+	for _, record := range records {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		moduleAddr := record.GetModuleAddress()
+		if k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		if balance.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, balance); err != nil {
+			return nil, err
+		}
+		total = total.Add(balance...)
+	}
+
+	return total, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
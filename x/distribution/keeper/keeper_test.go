@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -201,3 +202,52 @@ func TestFundCommunityPool(t *testing.T) {
 
 	require.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), distrKeeper.GetFeePool(ctx).CommunityPool)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+
+	owner := addrs[0]
+	valAddr := sdk.ValAddress(addrs[1])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// the owner has no tokenize share record
+	stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return(nil)
+	_, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecords)
+
+	// the shares of both records have been redeemed, the rewards left in the
+	// module account of the first one are sent to the owner
+	record1 := stakingtypes.NewTokenizeShareRecord(1, owner, valAddr)
+	record2 := stakingtypes.NewTokenizeShareRecord(2, owner, valAddr)
+	stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return([]stakingtypes.TokenizeShareRecord{record1, record2})
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), valAddr).Return(nil).Times(2)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), record1.GetModuleAddress()).Return(rewards)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), record2.GetModuleAddress()).Return(sdk.NewCoins())
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), record1.GetModuleAddress(), owner, rewards).Return(nil)
+
+	withdrawn, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, rewards, withdrawn)
+}
@@ -653,6 +653,95 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the tokenize
+// share records of an owner.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -668,6 +757,8 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 }
 
 func init() {
@@ -675,64 +766,70 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 912 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xb1, 0x6f, 0xf3, 0x44,
-	0x14, 0xcf, 0xa5, 0xa2, 0x28, 0xf7, 0x7d, 0xd2, 0xd7, 0x44, 0x45, 0x6d, 0xfd, 0x15, 0xa7, 0x72,
-	0xa1, 0x44, 0x11, 0xb5, 0x95, 0x80, 0x40, 0x0d, 0x03, 0x90, 0xb4, 0x91, 0x18, 0x22, 0xaa, 0x54,
-	0x80, 0xc4, 0x52, 0x39, 0xb1, 0x71, 0x4f, 0xd4, 0x3e, 0xcb, 0x77, 0x69, 0x9a, 0x0d, 0x10, 0x48,
-	0x88, 0x09, 0x89, 0x8d, 0xa5, 0x95, 0xba, 0x54, 0x2c, 0x64, 0xe0, 0x8f, 0xe8, 0x82, 0x54, 0x31,
-	0x31, 0x20, 0x40, 0xe9, 0x10, 0x24, 0x58, 0xd9, 0x91, 0xed, 0xb3, 0x63, 0xc7, 0x8e, 0xdd, 0x94,
-	0x8a, 0xb2, 0xb4, 0xd5, 0xdd, 0xfb, 0xbd, 0xfb, 0xbd, 0xdf, 0xfd, 0xee, 0x3d, 0x17, 0xbe, 0xd0,
-	0xc5, 0x44, 0xc7, 0x44, 0x52, 0x10, 0xa1, 0x16, 0xea, 0xf4, 0x28, 0xc2, 0x86, 0x74, 0x52, 0xe9,
-	0xa8, 0x54, 0xae, 0x48, 0xf4, 0x54, 0x34, 0x2d, 0x4c, 0x71, 0xe1, 0xa9, 0x1b, 0x25, 0x06, 0xa3,
-	0x44, 0x16, 0xc5, 0x2d, 0x6b, 0x58, 0xc3, 0x4e, 0x9c, 0x64, 0xff, 0xe5, 0x42, 0x38, 0x9e, 0x25,
-	0xee, 0xc8, 0x44, 0xf5, 0x13, 0x76, 0x31, 0x32, 0xd8, 0xfe, 0x9a, 0xbb, 0x7f, 0xe8, 0x02, 0x59,
-	0x7e, 0x77, 0x6b, 0x85, 0x41, 0x75, 0xa2, 0x49, 0x27, 0x15, 0xfb, 0x17, 0xdb, 0xc8, 0xcb, 0x3a,
-	0x32, 0xb0, 0xe4, 0xfc, 0x64, 0x4b, 0x62, 0x12, 0xff, 0x10, 0x5d, 0x27, 0x5e, 0xf8, 0x13, 0xc0,
-	0xe7, 0x5a, 0x44, 0x3b, 0x50, 0xe9, 0x07, 0x88, 0x1e, 0x29, 0x96, 0xdc, 0x7f, 0x5b, 0x51, 0x2c,
-	0x95, 0x90, 0xc2, 0x1e, 0xcc, 0x2b, 0xea, 0xb1, 0xaa, 0xc9, 0x14, 0x5b, 0x87, 0xb2, 0xbb, 0xb8,
-	0x0a, 0x36, 0x40, 0x29, 0x57, 0x5f, 0xfd, 0xe9, 0x87, 0xed, 0x65, 0x46, 0x91, 0x85, 0x1f, 0x50,
-	0x0b, 0x19, 0x5a, 0x7b, 0xc9, 0x87, 0x78, 0x69, 0x1a, 0x70, 0xa9, 0xcf, 0x32, 0xfb, 0x59, 0xb2,
-	0x29, 0x59, 0x9e, 0xf4, 0xc3, 0x5c, 0x6a, 0xcd, 0x2f, 0xcf, 0x8b, 0x99, 0x3f, 0xce, 0x8b, 0x99,
-	0xcf, 0xc6, 0xc3, 0x72, 0x94, 0xd6, 0x57, 0xe3, 0x61, 0x79, 0xd3, 0xcd, 0xb4, 0x4d, 0x94, 0x8f,
-	0xa5, 0x16, 0xd1, 0x5a, 0x58, 0x41, 0x1f, 0x0d, 0xa6, 0x6a, 0x12, 0x8a, 0xf0, 0xf9, 0xd8, 0x62,
-	0xdb, 0x2a, 0x31, 0xb1, 0x41, 0x54, 0xe1, 0x6f, 0x00, 0xb9, 0x16, 0xd1, 0xbc, 0xed, 0x5d, 0xef,
-	0xa4, 0xb6, 0xda, 0x97, 0x2d, 0xe5, 0xbe, 0x34, 0xd9, 0x83, 0xf9, 0x13, 0xf9, 0x18, 0x29, 0xa1,
-	0x34, 0x69, 0xa2, 0x2c, 0xf9, 0x10, 0x4f, 0x95, 0x77, 0xd2, 0x55, 0xd9, 0x0a, 0xab, 0x32, 0x55,
-	0x17, 0xc2, 0x86, 0x5b, 0x98, 0x70, 0x06, 0xa0, 0x30, 0xbb, 0x6e, 0x4f, 0x9e, 0xc2, 0x00, 0x2e,
-	0xca, 0x3a, 0xee, 0x19, 0x74, 0x15, 0x6c, 0x2c, 0x94, 0x1e, 0x55, 0xd7, 0x98, 0xdd, 0x44, 0xdb,
-	0xd5, 0xde, 0x03, 0x10, 0x1b, 0x18, 0x19, 0xf5, 0xe6, 0xd5, 0xaf, 0xc5, 0xcc, 0x77, 0xbf, 0x15,
-	0x4b, 0x1a, 0xa2, 0x47, 0xbd, 0x8e, 0xd8, 0xc5, 0x3a, 0x73, 0xb5, 0x14, 0xe0, 0x44, 0x07, 0xa6,
-	0x4a, 0x1c, 0x00, 0xf9, 0x76, 0x3c, 0x2c, 0x3f, 0xb6, 0x8f, 0xed, 0x0e, 0x0e, 0xed, 0x77, 0x41,
-	0x2e, 0xc7, 0xc3, 0x32, 0x68, 0xb3, 0x03, 0x85, 0xef, 0x01, 0xe4, 0x03, 0x0c, 0xdf, 0xf7, 0xc4,
-	0x68, 0x60, 0x5d, 0x47, 0x84, 0x20, 0x6c, 0xc4, 0xcb, 0x0a, 0xe6, 0x96, 0x35, 0x6c, 0xb6, 0x48,
-	0xc6, 0x18, 0xb3, 0x05, 0x48, 0x4d, 0xe8, 0x08, 0x17, 0x00, 0x6e, 0x25, 0x33, 0xfe, 0x3f, 0xe8,
-	0xfa, 0x45, 0x16, 0x2e, 0xb7, 0x88, 0xd6, 0xec, 0x19, 0x8a, 0x4d, 0xac, 0x67, 0x20, 0x3a, 0xd8,
-	0xc7, 0xf8, 0xf8, 0x01, 0x39, 0x15, 0x5e, 0x83, 0x39, 0x45, 0x35, 0x31, 0x41, 0x14, 0x5b, 0xa9,
-	0xef, 0x62, 0x12, 0x5a, 0xab, 0x05, 0x6f, 0x6e, 0xb2, 0x6e, 0xdf, 0x58, 0x31, 0x7c, 0x63, 0x91,
-	0x72, 0x05, 0x1e, 0xae, 0xc7, 0xad, 0xfb, 0x9d, 0xe1, 0x47, 0x00, 0x9f, 0xb4, 0x88, 0xf6, 0x9e,
-	0xa9, 0xc8, 0x54, 0xdd, 0x97, 0x2d, 0x59, 0x27, 0x36, 0x4f, 0xb9, 0x47, 0x8f, 0xb0, 0x85, 0xe8,
-	0x20, 0xd5, 0x68, 0x93, 0xd0, 0x42, 0x13, 0x2e, 0x9a, 0x4e, 0x06, 0xa7, 0xb8, 0x47, 0xd5, 0x4d,
-	0x31, 0x61, 0x9e, 0x88, 0xee, 0x61, 0xf5, 0x9c, 0x2d, 0x32, 0xd3, 0xc9, 0x45, 0xd7, 0x6a, 0x4e,
-	0x9d, 0x7e, 0x5e, 0xbb, 0xce, 0x97, 0x02, 0x75, 0x86, 0x66, 0xc0, 0x14, 0x77, 0x61, 0x0d, 0xae,
-	0x4c, 0x2d, 0xf9, 0xa5, 0x5e, 0x64, 0x9d, 0x99, 0x10, 0xd2, 0xe1, 0xc0, 0x54, 0x0d, 0xe5, 0xce,
-	0x05, 0xaf, 0xc3, 0x9c, 0xa5, 0x76, 0x91, 0x89, 0x54, 0x83, 0xba, 0x17, 0xda, 0x9e, 0x2c, 0x04,
-	0x9c, 0xb6, 0xf0, 0x1f, 0x3b, 0xad, 0xb6, 0x13, 0x55, 0x70, 0x6b, 0x5a, 0x41, 0x29, 0x56, 0x0b,
-	0x36, 0x4b, 0xa2, 0x1b, 0xbe, 0x8c, 0xbf, 0x64, 0x9d, 0x8e, 0xb5, 0xeb, 0xda, 0xd0, 0x7f, 0xfe,
-	0x6e, 0x4b, 0x25, 0xce, 0x1b, 0xbb, 0xab, 0x9e, 0xf7, 0x33, 0x40, 0x1e, 0x52, 0xf8, 0xb7, 0x42,
-	0x4f, 0x35, 0x74, 0x01, 0x2f, 0xc6, 0x5d, 0xc0, 0x44, 0x45, 0xa6, 0x9f, 0x50, 0x72, 0xba, 0x6b,
-	0x82, 0xba, 0xde, 0x45, 0x54, 0xff, 0x7a, 0x16, 0x2e, 0xb4, 0x88, 0x56, 0xf8, 0x1c, 0xc0, 0x42,
-	0xcc, 0x87, 0x4e, 0x35, 0xf1, 0xf5, 0xc5, 0x7e, 0x2f, 0x70, 0xb5, 0xf9, 0x31, 0x7e, 0xb3, 0xff,
-	0x06, 0xc0, 0x95, 0x59, 0x1f, 0x18, 0xaf, 0xa7, 0xe5, 0x9d, 0x01, 0xe4, 0xde, 0xbc, 0x23, 0xd0,
-	0x67, 0x75, 0x06, 0xe0, 0xd3, 0xa4, 0xe1, 0xfa, 0xc6, 0x6d, 0x0f, 0x88, 0x01, 0x73, 0x8d, 0x7f,
-	0x01, 0xf6, 0x19, 0x7e, 0x0a, 0x60, 0x3e, 0x3a, 0xa6, 0x2a, 0x69, 0xa9, 0x23, 0x10, 0x6e, 0x67,
-	0x6e, 0x88, 0xcf, 0xc1, 0x82, 0x8f, 0x43, 0x13, 0xe0, 0xe5, 0xb4, 0x54, 0xc1, 0x68, 0xee, 0xd5,
-	0x79, 0xa2, 0xfd, 0x33, 0x6d, 0xdb, 0xc6, 0xf4, 0xe2, 0x54, 0xdb, 0x46, 0x31, 0xe9, 0xb6, 0x9d,
-	0xdd, 0xce, 0x1c, 0x83, 0x24, 0xf5, 0xb2, 0x54, 0x83, 0x24, 0x80, 0xd3, 0x0d, 0x72, 0x8b, 0x77,
-	0xce, 0x3d, 0xf3, 0x89, 0xdd, 0x62, 0xea, 0xef, 0x5e, 0x8e, 0x78, 0x70, 0x35, 0xe2, 0xc1, 0xf5,
-	0x88, 0x07, 0xbf, 0x8f, 0x78, 0xf0, 0xf5, 0x0d, 0x9f, 0xb9, 0xbe, 0xe1, 0x33, 0x3f, 0xdf, 0xf0,
-	0x99, 0x0f, 0x2b, 0x89, 0x0d, 0xec, 0x34, 0x3c, 0x34, 0x9d, 0x7e, 0xd6, 0x59, 0x74, 0xfe, 0x55,
-	0x7a, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xb6, 0xd1, 0xb4, 0x1c, 0x0e, 0x00, 0x00,
+	// 994 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xde, 0x49, 0x44, 0xa4, 0x9d, 0x06, 0x35, 0xb1, 0x82, 0x92, 0xb8, 0xc5, 0x5b, 0xdc, 0x10,
+	0xa2, 0xa8, 0xb5, 0xb5, 0x01, 0x81, 0x6a, 0x84, 0xa0, 0xd9, 0x36, 0x52, 0x0f, 0x2b, 0xaa, 0x0d,
+	0x3f, 0x24, 0x2e, 0x91, 0x77, 0x3d, 0x38, 0xa3, 0xc6, 0x1e, 0xcb, 0x33, 0x9b, 0xed, 0x72, 0x02,
+	0x04, 0x12, 0xe2, 0x84, 0xc4, 0x8d, 0x4b, 0x23, 0xf5, 0x52, 0x7a, 0x21, 0x48, 0xfc, 0x11, 0xbd,
+	0x20, 0x55, 0x9c, 0x38, 0x20, 0x40, 0xc9, 0x21, 0x48, 0x9c, 0xb9, 0x23, 0xdb, 0xe3, 0x59, 0x7b,
+	0xed, 0xb5, 0x77, 0x93, 0xaa, 0xed, 0x25, 0x89, 0x66, 0xde, 0xf7, 0xcd, 0xf7, 0xbe, 0x79, 0xf3,
+	0x9e, 0x03, 0x57, 0x3a, 0x84, 0x3a, 0x84, 0xea, 0x16, 0xa6, 0xcc, 0xc7, 0xed, 0x2e, 0xc3, 0xc4,
+	0xd5, 0xf7, 0xeb, 0x6d, 0xc4, 0xcc, 0xba, 0xce, 0xee, 0x6a, 0x9e, 0x4f, 0x18, 0x91, 0x2e, 0x44,
+	0x51, 0x5a, 0x32, 0x4a, 0xe3, 0x51, 0xf2, 0x82, 0x4d, 0x6c, 0x12, 0xc6, 0xe9, 0xc1, 0x5f, 0x11,
+	0x44, 0x56, 0x38, 0x71, 0xdb, 0xa4, 0x48, 0x10, 0x76, 0x08, 0x76, 0xf9, 0xfe, 0x72, 0xb4, 0xbf,
+	0x13, 0x01, 0x39, 0x7f, 0xb4, 0xb5, 0xc8, 0xa1, 0x0e, 0xb5, 0xf5, 0xfd, 0x7a, 0xf0, 0x8b, 0x6f,
+	0xcc, 0x9b, 0x0e, 0x76, 0x89, 0x1e, 0xfe, 0xe4, 0x4b, 0x5a, 0x91, 0xfe, 0x94, 0xdc, 0x30, 0x5e,
+	0xfd, 0x17, 0xc0, 0x97, 0x9a, 0xd4, 0xde, 0x46, 0xec, 0x63, 0xcc, 0x76, 0x2d, 0xdf, 0xec, 0x5d,
+	0xb7, 0x2c, 0x1f, 0x51, 0x2a, 0xdd, 0x84, 0xf3, 0x16, 0xda, 0x43, 0xb6, 0xc9, 0x88, 0xbf, 0x63,
+	0x46, 0x8b, 0x4b, 0xe0, 0x12, 0x58, 0xab, 0x6e, 0x2e, 0xfd, 0xf6, 0xcb, 0xd5, 0x05, 0x2e, 0x91,
+	0x87, 0x6f, 0x33, 0x1f, 0xbb, 0x76, 0x6b, 0x4e, 0x40, 0x62, 0x9a, 0x06, 0x9c, 0xeb, 0x71, 0x66,
+	0xc1, 0x32, 0x55, 0xc2, 0x72, 0xbe, 0x97, 0xd6, 0x62, 0x6c, 0x7d, 0x73, 0x50, 0xab, 0xfc, 0x73,
+	0x50, 0xab, 0x7c, 0x79, 0x72, 0xb8, 0x9e, 0x95, 0xf5, 0xed, 0xc9, 0xe1, 0xfa, 0xe5, 0x88, 0xe9,
+	0x2a, 0xb5, 0xee, 0xe8, 0x4d, 0x6a, 0x37, 0x89, 0x85, 0x3f, 0xed, 0x0f, 0xe5, 0xa4, 0xd6, 0xe0,
+	0xcb, 0xb9, 0xc9, 0xb6, 0x10, 0xf5, 0x88, 0x4b, 0x91, 0xfa, 0x1f, 0x80, 0x72, 0x93, 0xda, 0xf1,
+	0xf6, 0x8d, 0xf8, 0xa4, 0x16, 0xea, 0x99, 0xbe, 0xf5, 0xa4, 0x3c, 0xb9, 0x09, 0xe7, 0xf7, 0xcd,
+	0x3d, 0x6c, 0xa5, 0x68, 0xca, 0x4c, 0x99, 0x13, 0x90, 0xd8, 0x95, 0x5b, 0xe5, 0xae, 0xac, 0xa6,
+	0x5d, 0x19, 0xca, 0x0b, 0x13, 0x37, 0x4a, 0x4c, 0xbd, 0x07, 0xa0, 0x3a, 0x3a, 0xef, 0xd8, 0x1e,
+	0xa9, 0x0f, 0x67, 0x4c, 0x87, 0x74, 0x5d, 0xb6, 0x04, 0x2e, 0x4d, 0xaf, 0x9d, 0xdb, 0x58, 0xe6,
+	0xe5, 0xa6, 0x05, 0x55, 0x1d, 0x3f, 0x00, 0xad, 0x41, 0xb0, 0xbb, 0xb9, 0xf5, 0xe8, 0xcf, 0x5a,
+	0xe5, 0xe1, 0x5f, 0xb5, 0x35, 0x1b, 0xb3, 0xdd, 0x6e, 0x5b, 0xeb, 0x10, 0x87, 0x57, 0xb5, 0x9e,
+	0xd0, 0xc4, 0xfa, 0x1e, 0xa2, 0x21, 0x80, 0xfe, 0x70, 0x72, 0xb8, 0x3e, 0x1b, 0x1c, 0xdb, 0xe9,
+	0xef, 0x04, 0xef, 0x82, 0x3e, 0x38, 0x39, 0x5c, 0x07, 0x2d, 0x7e, 0xa0, 0xfa, 0x13, 0x80, 0x4a,
+	0x42, 0xe1, 0x47, 0xb1, 0x19, 0x0d, 0xe2, 0x38, 0x98, 0x52, 0x4c, 0xdc, 0x7c, 0x5b, 0xc1, 0xc4,
+	0xb6, 0xa6, 0x8b, 0x2d, 0xc3, 0x98, 0x53, 0x6c, 0x09, 0x51, 0x03, 0x39, 0xea, 0x7d, 0x00, 0x57,
+	0x8b, 0x15, 0x3f, 0x0f, 0xbe, 0x7e, 0x3d, 0x05, 0x17, 0x9a, 0xd4, 0xde, 0xea, 0xba, 0x56, 0x20,
+	0xac, 0xeb, 0x62, 0xd6, 0xbf, 0x4d, 0xc8, 0xde, 0x33, 0xd4, 0x24, 0xbd, 0x09, 0xab, 0x16, 0xf2,
+	0x08, 0xc5, 0x8c, 0xf8, 0xa5, 0xef, 0x62, 0x10, 0x6a, 0x18, 0xc9, 0x9b, 0x1b, 0xac, 0x07, 0x37,
+	0x56, 0x4b, 0xdf, 0x58, 0x26, 0x5d, 0x55, 0x81, 0x17, 0xf3, 0xd6, 0x45, 0x67, 0xf8, 0x15, 0xc0,
+	0xf3, 0x4d, 0x6a, 0x7f, 0xe8, 0x59, 0x26, 0x43, 0xb7, 0x4d, 0xdf, 0x74, 0x68, 0xa0, 0xd3, 0xec,
+	0xb2, 0x5d, 0xe2, 0x63, 0xd6, 0x2f, 0x2d, 0xb4, 0x41, 0xa8, 0xb4, 0x05, 0x67, 0xbc, 0x90, 0x21,
+	0x4c, 0xee, 0xdc, 0xc6, 0x65, 0xad, 0x60, 0x9e, 0x68, 0xd1, 0x61, 0x9b, 0xd5, 0xc0, 0x64, 0xee,
+	0x53, 0x84, 0x36, 0x8c, 0x30, 0x4f, 0xc1, 0x1b, 0xe4, 0xf9, 0x5a, 0x22, 0xcf, 0xd4, 0x0c, 0x18,
+	0xd2, 0xae, 0x2e, 0xc3, 0xc5, 0xa1, 0x25, 0x91, 0xea, 0xfd, 0xa9, 0x70, 0x26, 0xa4, 0x7c, 0xd8,
+	0xf6, 0x90, 0x6b, 0x9d, 0x3a, 0xe1, 0x8b, 0xb0, 0xea, 0xa3, 0x0e, 0xf6, 0x30, 0x72, 0x59, 0x74,
+	0xa1, 0xad, 0xc1, 0x42, 0xa2, 0xd2, 0xa6, 0x9f, 0x72, 0xa5, 0x19, 0xd7, 0xb2, 0x0e, 0xae, 0x0e,
+	0x3b, 0xa8, 0xe7, 0x7a, 0xc1, 0x67, 0x49, 0x76, 0x43, 0xd8, 0xf8, 0xc7, 0x54, 0xd8, 0xb1, 0x6e,
+	0x44, 0x65, 0x28, 0x9e, 0x7f, 0xd4, 0x52, 0x69, 0xf8, 0xc6, 0x4e, 0xeb, 0xe7, 0x93, 0x19, 0x20,
+	0xcf, 0xd2, 0xf8, 0xf7, 0x52, 0x4f, 0x35, 0x75, 0x01, 0xaf, 0xe6, 0x5d, 0xc0, 0xc0, 0x45, 0xee,
+	0x9f, 0xba, 0x06, 0x57, 0x53, 0xeb, 0x19, 0x77, 0xc5, 0x45, 0x3c, 0x04, 0x70, 0x25, 0xd1, 0x88,
+	0x3f, 0x20, 0x77, 0x90, 0x8b, 0x3f, 0x43, 0xdb, 0xbb, 0xa6, 0x8f, 0x5a, 0xa8, 0x43, 0x7c, 0x2b,
+	0x42, 0x49, 0xef, 0xc0, 0x17, 0x49, 0xcf, 0x45, 0xe3, 0x0f, 0x8f, 0xd9, 0x30, 0x3c, 0x1e, 0x1c,
+	0x8d, 0x64, 0x4e, 0x69, 0xa6, 0x20, 0xaf, 0x95, 0xfc, 0xa1, 0x11, 0xcb, 0xe1, 0x93, 0xf8, 0x47,
+	0x00, 0xaf, 0x8c, 0x23, 0xf6, 0x39, 0x98, 0x1d, 0x1b, 0x07, 0x55, 0x38, 0xdd, 0xa4, 0xb6, 0xf4,
+	0x15, 0x80, 0x52, 0xce, 0x17, 0xe4, 0x46, 0x61, 0x5b, 0xcb, 0xfd, 0x10, 0x93, 0x8d, 0xc9, 0x31,
+	0xc2, 0x89, 0xef, 0x01, 0x5c, 0x1c, 0xf5, 0xe5, 0xf6, 0x56, 0x19, 0xef, 0x08, 0xa0, 0xfc, 0xee,
+	0x29, 0x81, 0x42, 0xd5, 0x3d, 0x00, 0x2f, 0x14, 0x7d, 0xb5, 0xbc, 0x3d, 0xee, 0x01, 0x39, 0x60,
+	0xb9, 0x71, 0x06, 0xb0, 0x50, 0xf8, 0x05, 0x80, 0xf3, 0xd9, 0xf9, 0x5f, 0x2f, 0xa3, 0xce, 0x40,
+	0xe4, 0x6b, 0x13, 0x43, 0x84, 0x06, 0x1f, 0xce, 0xa6, 0x46, 0xeb, 0x95, 0x32, 0xaa, 0x64, 0xb4,
+	0xfc, 0xc6, 0x24, 0xd1, 0xe2, 0xcc, 0xa0, 0x6c, 0x73, 0x86, 0x5c, 0x69, 0xd9, 0x66, 0x31, 0xb2,
+	0x31, 0x39, 0x26, 0x55, 0x20, 0x45, 0x43, 0xa2, 0xb4, 0x40, 0x0a, 0xc0, 0x72, 0xe3, 0x0c, 0x60,
+	0xa1, 0xf0, 0x67, 0x00, 0x5f, 0x29, 0xef, 0x9e, 0xd7, 0xc7, 0xad, 0xc5, 0x91, 0x14, 0xf2, 0xad,
+	0x33, 0x53, 0xc4, 0x9a, 0xe5, 0x17, 0x3e, 0x0f, 0x5a, 0xd5, 0xe6, 0xfb, 0x0f, 0x8e, 0x14, 0xf0,
+	0xe8, 0x48, 0x01, 0x8f, 0x8f, 0x14, 0xf0, 0xf7, 0x91, 0x02, 0xbe, 0x3b, 0x56, 0x2a, 0x8f, 0x8f,
+	0x95, 0xca, 0xef, 0xc7, 0x4a, 0xe5, 0x93, 0x7a, 0x61, 0x23, 0xbc, 0x9b, 0xfe, 0x82, 0x0a, 0xfb,
+	0x62, 0x7b, 0x26, 0xfc, 0xbf, 0xf9, 0xf5, 0xff, 0x07, 0x00, 0x1d, 0x2a, 0xba, 0x5d, 0x29, 0x10,
+	0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -960,6 +1057,35 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1002,6 +1128,10 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the shares tokenized in x/staking to the owner of their tokenize share
+	// records.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 type msgClient struct {
@@ -1075,6 +1205,15 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1106,6 +1245,10 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the shares tokenized in x/staking to the owner of their tokenize share
+	// records.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1133,6 +1276,9 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1264,6 +1410,24 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1296,6 +1460,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1780,6 +1948,73 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1993,6 +2228,34 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3254,6 +3517,172 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
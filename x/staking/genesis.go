@@ -45,9 +45,43 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	for _, bond := range data.ValidatorBonds {
+		if _, err := sdk.AccAddressFromBech32(bond.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid validator bond delegator address: %w", err)
+		}
+		if _, err := sdk.ValAddressFromBech32(bond.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator bond validator address: %w", err)
+		}
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d greater than the last id %d", record.Id, lastID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -113,6 +113,7 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	store.Delete(types.GetValidatorBondKey(delegation.GetValidatorAddr(), delegatorAddress))
 	return nil
 }
 
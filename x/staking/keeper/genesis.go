@@ -100,6 +100,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	// the tokenized shares of the validators are the shares delegated by the
+	// module accounts of the records
+	for _, record := range data.TokenizeShareRecords {
+		k.SetTokenizeShareRecord(ctx, record)
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+		if delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); found {
+			k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+		}
+	}
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, bond := range data.ValidatorBonds {
+		valAddr, err := sdk.ValAddressFromBech32(bond.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorBond(ctx, sdk.MustAccAddressFromBech32(bond.DelegatorAddress), valAddr)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -202,5 +225,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		ValidatorBonds:            k.GetAllValidatorBonds(ctx),
 	}
 }
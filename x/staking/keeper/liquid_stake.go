@@ -0,0 +1,472 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record
+// created, zero if none.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record of an id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	ownerPrefix := types.GetTokenizeShareRecordsByOwnerKey(owner)
+
+	iterator := storetypes.KVStorePrefixIterator(store, ownerPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(ownerPrefix):])
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if !found {
+			panic("tokenize share record of the owner index not found")
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and its indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(record.Owner)
+	id := sdk.Uint64ToBigEndian(record.Id)
+
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.GetTokenizeShareRecordByOwnerKey(owner, record.Id), []byte{})
+	store.Set(types.GetTokenizeShareRecordByDenomKey(record.GetShareTokenDenom()), id)
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(record.Owner)
+
+	store.Delete(types.GetTokenizeShareRecordKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordByOwnerKey(owner, record.Id))
+	store.Delete(types.GetTokenizeShareRecordByDenomKey(record.GetShareTokenDenom()))
+}
+
+// GetValidatorLiquidShares returns the tokenized shares of a validator.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized shares of a validator, the record
+// is deleted when the shares are zero.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), k.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+}
+
+// GetTotalLiquidStakedTokens returns the amount of bond denom represented by the
+// tokenized shares of all the validators. The tokens are computed from the
+// current exchange rates of the validators, so that they reflect the slashes.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	total := math.LegacyZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorLiquidSharesKey)+1:])
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		total = total.Add(validator.TokensFromShares(shares.Dec))
+	}
+
+	return total.TruncateInt()
+}
+
+// IsValidatorBond returns whether the delegation of a delegator to a validator
+// is flagged as a validator bond.
+func (k Keeper) IsValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorBondKey(valAddr, delAddr))
+}
+
+// SetValidatorBond flags the delegation of a delegator to a validator as a
+// validator bond. The flag is removed with the delegation.
+func (k Keeper) SetValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondKey(valAddr, delAddr), []byte{})
+}
+
+// GetValidatorBondShares returns the shares of the delegations to a validator
+// flagged as validator bonds.
+func (k Keeper) GetValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bondsPrefix := types.GetValidatorBondsKey(valAddr)
+
+	iterator := storetypes.KVStorePrefixIterator(store, bondsPrefix)
+	defer iterator.Close()
+
+	shares := math.LegacyZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr := sdk.AccAddress(iterator.Key()[len(bondsPrefix)+1:])
+		if delegation, found := k.GetDelegation(ctx, delAddr, valAddr); found {
+			shares = shares.Add(delegation.Shares)
+		}
+	}
+
+	return shares
+}
+
+// GetAllValidatorBonds returns the delegations flagged as validator bonds.
+func (k Keeper) GetAllValidatorBonds(ctx sdk.Context) (bonds []types.DVPair) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorBondKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.ValidatorBondKey):]
+		valAddr := sdk.ValAddress(key[1 : 1+key[0]])
+		delAddr := sdk.AccAddress(key[2+key[0]:])
+		bonds = append(bonds, types.DVPair{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()})
+	}
+
+	return bonds
+}
+
+// CheckLiquidStakingCaps returns an error if tokenizing shares of a validator
+// would exceed the global or the validator liquid staking cap, or the tokenized
+// shares allowed by the validator bond of the validator.
+func (k Keeper) CheckLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	params := k.GetParams(ctx)
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+
+	if !params.ValidatorBondFactor.Equal(types.DefaultValidatorBondFactor) {
+		maxShares := k.GetValidatorBondShares(ctx, validator.GetOperator()).Mul(params.ValidatorBondFactor)
+		if liquidShares.GT(maxShares) {
+			return errorsmod.Wrapf(types.ErrInsufficientValidatorBond, "%s tokenized shares allowed", maxShares)
+		}
+	}
+
+	if liquidShares.GT(validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	liquidTokens := math.LegacyNewDecFromInt(k.GetTotalLiquidStakedTokens(ctx)).Add(validator.TokensFromShares(shares))
+	if liquidTokens.GT(math.LegacyNewDecFromInt(k.TotalBondedTokens(ctx)).Mul(params.GlobalLiquidStakingCap)) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	return nil
+}
+
+// CheckValidatorBondUnbond returns an error if unbonding shares from a delegation
+// flagged as validator bond would leave the validator with fewer validator bond
+// shares than its tokenized shares require.
+func (k Keeper) CheckValidatorBondUnbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	factor := k.GetParams(ctx).ValidatorBondFactor
+	if factor.Equal(types.DefaultValidatorBondFactor) || !k.IsValidatorBond(ctx, delAddr, valAddr) {
+		return nil
+	}
+
+	maxShares := k.GetValidatorBondShares(ctx, valAddr).Sub(shares).Mul(factor)
+	if k.GetValidatorLiquidShares(ctx, valAddr).GT(maxShares) {
+		return errorsmod.Wrap(types.ErrInsufficientValidatorBond, "the tokenized shares of the validator require the validator bond")
+	}
+
+	return nil
+}
+
+// TokenizeShares converts the shares of an amount of the delegation of a
+// delegator to a validator into share tokens sent to the delegator. The shares
+// are delegated by the module account of a new tokenize share record owned by
+// owner, and the share tokens can be redeemed for a delegation of the shares by
+// RedeemTokensForShares.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if k.IsValidatorBond(ctx, delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrValidatorBondNotTokenizable
+	}
+
+	// the redelegation would be slashed from the delegation, which would not
+	// hold the tokenized shares anymore
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	acc := k.authKeeper.GetAccount(ctx, delAddr)
+	if vacc, ok := acc.(vestexported.VestingAccount); ok && vacc.GetDelegatedFree().AmountOf(bondDenom).LT(amount) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrExceedingFreeVestingDelegations
+	}
+
+	if err := k.CheckLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(k.GetLastTokenizeShareRecordID(ctx)+1, owner, valAddr)
+	k.SetLastTokenizeShareRecordID(ctx, record.Id)
+	k.SetTokenizeShareRecord(ctx, record)
+
+	moduleAddr := record.GetModuleAddress()
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, moduleAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Delegate(ctx, moduleAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareTokens.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.ErrInvalidRequest.Wrap("too few tokens to tokenize (truncates to zero share tokens)")
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(newShares))
+
+	return record, shareTokens, nil
+}
+
+// RedeemTokensForShares burns share tokens of a delegator and delegates the
+// shares they represent, a part of the delegation of the module account of their
+// tokenize share record proportional to the amount of the share tokens, from the
+// delegator. When the last share tokens are redeemed, the balance of the module
+// account, the rewards of the shares not withdrawn yet, is sent to the owner of
+// the record and the record is deleted.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "share denom %s", amount.Denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, moduleAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+	if amount.Amount.GT(supply) {
+		return sdk.Coin{}, sdkerrors.ErrInsufficientFunds.Wrapf("%s is greater than the supply of the share tokens %s", amount, supply)
+	}
+
+	shares := delegation.Shares
+	if amount.Amount.LT(supply) {
+		shares = shares.MulInt(amount.Amount).QuoInt(supply)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, moduleAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares))
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, moduleAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator is removed with its last shares once unbonded, the tokens
+	// are then left to the delegator
+	if validator, found = k.GetValidator(ctx, valAddr); found {
+		if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if _, found := k.GetDelegation(ctx, moduleAddr, valAddr); !found {
+		owner := sdk.MustAccAddressFromBech32(record.Owner)
+		if balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr); !balance.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, balance); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	return returnCoin, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share record,
+// and of the rewards of its shares not withdrawn yet, from its owner to a new owner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+
+	if record.Owner != sender.String() {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	k.DeleteTokenizeShareRecord(ctx, record)
+	record.Owner = newOwner.String()
+	k.SetTokenizeShareRecord(ctx, record)
+
+	return nil
+}
+
+// ValidatorBond flags the delegation of a delegator to a validator as a
+// validator bond, which allows the shares of the validator to be tokenized up
+// to the validator bond factor times the validator bond shares.
+func (k Keeper) ValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if _, found := k.GetDelegation(ctx, delAddr, valAddr); !found {
+		return types.ErrNoDelegation
+	}
+
+	if k.IsValidatorBond(ctx, delAddr, valAddr) {
+		return types.ErrValidatorBondAlreadySet
+	}
+
+	// the redelegation would be slashed from the validator bond
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.ErrRedelegationInProgress
+	}
+
+	k.SetValidatorBond(ctx, delAddr, valAddr)
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTokenizeShareRecord() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+
+	require.Equal(uint64(0), keeper.GetLastTokenizeShareRecordID(ctx))
+	keeper.SetLastTokenizeShareRecordID(ctx, 2)
+	require.Equal(uint64(2), keeper.GetLastTokenizeShareRecordID(ctx))
+
+	record1 := stakingtypes.NewTokenizeShareRecord(1, addrs[0], valAddrs[0])
+	record2 := stakingtypes.NewTokenizeShareRecord(2, addrs[0], valAddrs[1])
+	keeper.SetTokenizeShareRecord(ctx, record1)
+	keeper.SetTokenizeShareRecord(ctx, record2)
+
+	res, found := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(found)
+	require.Equal(record1, res)
+
+	res, found = keeper.GetTokenizeShareRecordByDenom(ctx, record2.GetShareTokenDenom())
+	require.True(found)
+	require.Equal(record2, res)
+
+	require.Equal([]stakingtypes.TokenizeShareRecord{record1, record2}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0]))
+	require.Empty(keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+	require.Len(keeper.GetAllTokenizeShareRecords(ctx), 2)
+
+	// only the owner can transfer a record
+	require.ErrorIs(keeper.TransferTokenizeShareRecord(ctx, 1, addrs[1], addrs[1]), stakingtypes.ErrNotTokenizeShareRecordOwner)
+	require.ErrorIs(keeper.TransferTokenizeShareRecord(ctx, 3, addrs[0], addrs[1]), stakingtypes.ErrTokenizeShareRecordNotFound)
+
+	require.NoError(keeper.TransferTokenizeShareRecord(ctx, 1, addrs[0], addrs[1]))
+	res, found = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(found)
+	require.Equal(addrs[1].String(), res.Owner)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record2}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0]))
+	require.Equal([]stakingtypes.TokenizeShareRecord{res}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+
+	keeper.DeleteTokenizeShareRecord(ctx, res)
+	_, found = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(found)
+	_, found = keeper.GetTokenizeShareRecordByDenom(ctx, record1.GetShareTokenDenom())
+	require.False(found)
+	require.Empty(keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+	require.Len(keeper.GetAllTokenizeShareRecords(ctx), 1)
+}
+
+func (s *KeeperTestSuite) TestValidatorLiquidShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(2)
+
+	// the second validator has been slashed by half
+	for i, tokens := range []int64{100, 50} {
+		validator := testutil.NewValidator(s.T(), valAddrs[i], PKs[i])
+		validator, _ = validator.AddTokensFromDel(math.NewInt(100))
+		validator.Tokens = math.NewInt(tokens)
+		keeper.SetValidator(ctx, validator)
+	}
+
+	require.True(keeper.GetValidatorLiquidShares(ctx, valAddrs[0]).IsZero())
+	require.True(keeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(10))
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[1], math.LegacyNewDec(20))
+	require.Equal(math.LegacyNewDec(10), keeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+	require.Equal(math.NewInt(20), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[1], math.LegacyZeroDec())
+	require.True(keeper.GetValidatorLiquidShares(ctx, valAddrs[1]).IsZero())
+	require.Equal(math.NewInt(10), keeper.GetTotalLiquidStakedTokens(ctx))
+}
+
+func (s *KeeperTestSuite) TestValidatorBond() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+
+	require.ErrorIs(keeper.ValidatorBond(ctx, addrs[0], valAddrs[0]), stakingtypes.ErrNoValidatorFound)
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(100))
+	keeper.SetValidator(ctx, validator)
+
+	require.ErrorIs(keeper.ValidatorBond(ctx, addrs[0], valAddrs[0]), stakingtypes.ErrNoDelegation)
+
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrs[0], valAddrs[0], math.LegacyNewDec(60)))
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrs[1], valAddrs[0], math.LegacyNewDec(40)))
+
+	require.NoError(keeper.ValidatorBond(ctx, addrs[0], valAddrs[0]))
+	require.True(keeper.IsValidatorBond(ctx, addrs[0], valAddrs[0]))
+	require.False(keeper.IsValidatorBond(ctx, addrs[1], valAddrs[0]))
+	require.ErrorIs(keeper.ValidatorBond(ctx, addrs[0], valAddrs[0]), stakingtypes.ErrValidatorBondAlreadySet)
+
+	require.NoError(keeper.ValidatorBond(ctx, addrs[1], valAddrs[0]))
+	require.Equal(math.LegacyNewDec(100), keeper.GetValidatorBondShares(ctx, valAddrs[0]))
+	require.Len(keeper.GetAllValidatorBonds(ctx), 2)
+
+	// removing the delegation removes the validator bond
+	require.NoError(keeper.RemoveDelegation(ctx, stakingtypes.NewDelegation(addrs[1], valAddrs[0], math.LegacyNewDec(40))))
+	require.False(keeper.IsValidatorBond(ctx, addrs[1], valAddrs[0]))
+	require.Equal(math.LegacyNewDec(60), keeper.GetValidatorBondShares(ctx, valAddrs[0]))
+}
+
+func (s *KeeperTestSuite) TestCheckLiquidStakingCaps() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(1)
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(1000))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrs[0], valAddrs[0], math.LegacyNewDec(10)))
+	keeper.SetValidatorBond(ctx, addrs[0], valAddrs[0])
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(100))
+
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).
+		Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		malleate  func(params *stakingtypes.Params)
+		shares    int64
+		expectErr error
+	}{
+		{
+			name:     "default params",
+			malleate: func(*stakingtypes.Params) {},
+			shares:   900,
+		},
+		{
+			name:      "validator bond factor",
+			malleate:  func(params *stakingtypes.Params) { params.ValidatorBondFactor = math.LegacyNewDec(20) },
+			shares:    101,
+			expectErr: stakingtypes.ErrInsufficientValidatorBond,
+		},
+		{
+			name:     "within validator bond factor",
+			malleate: func(params *stakingtypes.Params) { params.ValidatorBondFactor = math.LegacyNewDec(20) },
+			shares:   100,
+		},
+		{
+			name:      "validator liquid staking cap",
+			malleate:  func(params *stakingtypes.Params) { params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1) },
+			shares:    401,
+			expectErr: stakingtypes.ErrValidatorLiquidStakingCapExceeded,
+		},
+		{
+			name:      "global liquid staking cap",
+			malleate:  func(params *stakingtypes.Params) { params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2) },
+			shares:    151,
+			expectErr: stakingtypes.ErrGlobalLiquidStakingCapExceeded,
+		},
+		{
+			name:     "within global liquid staking cap",
+			malleate: func(params *stakingtypes.Params) { params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2) },
+			shares:   150,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			params := stakingtypes.DefaultParams()
+			tc.malleate(&params)
+			require.NoError(keeper.SetParams(ctx, params))
+
+			err := keeper.CheckLiquidStakingCaps(ctx, validator, math.LegacyNewDec(tc.shares))
+			if tc.expectErr != nil {
+				require.ErrorIs(err, tc.expectErr)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCheckValidatorBondUnbond() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrs[0], valAddrs[0], math.LegacyNewDec(10)))
+	keeper.SetValidatorBond(ctx, addrs[0], valAddrs[0])
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(100))
+
+	// the validator bond factor is disabled by default
+	require.NoError(keeper.CheckValidatorBondUnbond(ctx, addrs[0], valAddrs[0], math.LegacyNewDec(10)))
+
+	params := stakingtypes.DefaultParams()
+	params.ValidatorBondFactor = math.LegacyNewDec(20)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	require.NoError(keeper.CheckValidatorBondUnbond(ctx, addrs[0], valAddrs[0], math.LegacyNewDec(5)))
+	require.ErrorIs(keeper.CheckValidatorBondUnbond(ctx, addrs[0], valAddrs[0], math.LegacyNewDec(6)), stakingtypes.ErrInsufficientValidatorBond)
+
+	// delegations which are not a validator bond are never restricted
+	require.NoError(keeper.CheckValidatorBondUnbond(ctx, addrs[1], valAddrs[0], math.LegacyNewDec(10)))
+}
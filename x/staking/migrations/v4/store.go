@@ -33,7 +33,7 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCo
 	var legacyParams types.Params
 	legacySubspace.GetParamSet(ctx, &legacyParams)
 
-	if err := legacyParams.Validate(); err != nil {
+	if err := validateParams(legacyParams); err != nil {
 		return err
 	}
 
@@ -0,0 +1,8 @@
+package v5
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
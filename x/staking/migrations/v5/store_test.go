@@ -0,0 +1,44 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+
+	storeKey := storetypes.NewKVStoreKey(v5.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// the v4 params, without the liquid staking params
+	oldParams := types.DefaultParams()
+	oldParams.MaxValidators = 42
+	oldParams.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	oldParams.ValidatorBondFactor = math.LegacyDec{}
+	oldParams.GlobalLiquidStakingCap = math.LegacyDec{}
+	oldParams.ValidatorLiquidStakingCap = math.LegacyDec{}
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(v5.ParamsKey), &params)
+	require.Equal(t, uint32(42), params.MaxValidators)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), params.MinCommissionRate)
+	require.Equal(t, types.DefaultValidatorBondFactor, params.ValidatorBondFactor)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, params.ValidatorLiquidStakingCap)
+}
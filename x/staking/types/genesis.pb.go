@@ -45,6 +45,13 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the identifier of the last tokenize share
+	// record created.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// validator_bonds defines the delegations flagged as validator bonds at genesis.
+	ValidatorBonds []DVPair `protobuf:"bytes,11,rep,name=validator_bonds,json=validatorBonds,proto3" json:"validator_bonds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +136,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidatorBonds() []DVPair {
+	if m != nil {
+		return m.ValidatorBonds
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +208,44 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0x6d, 0xd2, 0x36, 0xc9, 0xa5, 0x14, 0x38, 0xd2, 0xca, 0x8d, 0x84, 0x63, 0xa2, 0x0a,
+	0x45, 0x85, 0xda, 0x6a, 0xba, 0x31, 0xd1, 0xa8, 0x02, 0x55, 0xaa, 0x44, 0xe4, 0xb4, 0x1d, 0x90,
+	0x90, 0x75, 0x89, 0x4f, 0xce, 0x29, 0x8e, 0x2f, 0xf2, 0x5d, 0x4a, 0xe1, 0x09, 0x18, 0x79, 0x02,
+	0xd4, 0x91, 0x91, 0x81, 0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x0a, 0x25, 0x03, 0x3c, 0x06, 0xf2,
+	0x9d, 0xe3, 0x3a, 0x4a, 0x9c, 0x25, 0x89, 0xf3, 0xfd, 0xff, 0xbf, 0xff, 0x77, 0xd6, 0xf7, 0x1d,
+	0xd8, 0xe9, 0x52, 0x36, 0xa0, 0xcc, 0x62, 0x1c, 0xf5, 0x49, 0xe0, 0x59, 0x17, 0xfb, 0x1d, 0xcc,
+	0xd1, 0xbe, 0xe5, 0xe1, 0x00, 0x33, 0xc2, 0xcc, 0x61, 0x48, 0x39, 0x85, 0x5b, 0x52, 0x65, 0xc6,
+	0x2a, 0x33, 0x56, 0x55, 0xca, 0x1e, 0xf5, 0xa8, 0x90, 0x58, 0xd1, 0x2f, 0xa9, 0xae, 0x64, 0x31,
+	0xa7, 0x6e, 0xa9, 0xda, 0x96, 0x2a, 0x47, 0xda, 0xe3, 0x00, 0x59, 0x7a, 0x84, 0x06, 0x24, 0xa0,
+	0x96, 0xf8, 0x94, 0x7f, 0xd5, 0xbe, 0xe6, 0xc1, 0xfa, 0x1b, 0xd9, 0x53, 0x9b, 0x23, 0x8e, 0xe1,
+	0x21, 0x58, 0x1b, 0xa2, 0x10, 0x0d, 0x98, 0xa6, 0x1a, 0x6a, 0xbd, 0xd4, 0xd0, 0xcd, 0xc5, 0x3d,
+	0x9a, 0x2d, 0xa1, 0x6a, 0x16, 0xaf, 0x6f, 0xab, 0xca, 0xb7, 0xbf, 0xdf, 0x77, 0x55, 0x3b, 0x36,
+	0xc2, 0xf7, 0xe0, 0xa1, 0x8f, 0x18, 0x77, 0x38, 0xe5, 0xc8, 0x77, 0x86, 0xf4, 0x03, 0x0e, 0xb5,
+	0x7b, 0x86, 0x5a, 0x5f, 0x6f, 0x1e, 0x44, 0xe2, 0xdf, 0xb7, 0xd5, 0x67, 0x1e, 0xe1, 0xbd, 0x51,
+	0xc7, 0xec, 0xd2, 0x41, 0xdc, 0x61, 0xfc, 0xb5, 0xc7, 0xdc, 0xbe, 0xc5, 0x3f, 0x0e, 0x31, 0x33,
+	0x8f, 0x03, 0x2e, 0xb1, 0x1b, 0x11, 0xec, 0x34, 0x62, 0xb5, 0x22, 0x14, 0x24, 0x60, 0x53, 0xe0,
+	0x2f, 0x90, 0x4f, 0x5c, 0xc4, 0x69, 0x28, 0x23, 0x98, 0x96, 0x33, 0x72, 0xf5, 0x52, 0x63, 0x37,
+	0xab, 0xe1, 0x13, 0xc4, 0xf8, 0xf9, 0xd4, 0x23, 0x50, 0xe9, 0xe6, 0x1f, 0xfb, 0x73, 0x65, 0x06,
+	0x4f, 0x00, 0x48, 0x52, 0x98, 0xb6, 0x22, 0xf8, 0x4f, 0xb3, 0xf8, 0x89, 0x39, 0x8d, 0x4d, 0xf9,
+	0xe1, 0x5b, 0x50, 0x72, 0xb1, 0x8f, 0x3d, 0xc4, 0x09, 0x0d, 0x98, 0xb6, 0x2a, 0x70, 0xb5, 0x2c,
+	0xdc, 0x51, 0x22, 0x4d, 0xf3, 0xd2, 0x04, 0xd8, 0x07, 0x9b, 0xa3, 0xa0, 0x43, 0x03, 0x97, 0x04,
+	0x9e, 0x93, 0x46, 0xaf, 0x09, 0xf4, 0xf3, 0x2c, 0xf4, 0xd9, 0xd4, 0xb4, 0x38, 0xa3, 0x3c, 0x9a,
+	0xaf, 0x33, 0x78, 0x06, 0xee, 0x87, 0x38, 0x1d, 0x92, 0x17, 0x21, 0x3b, 0x59, 0x21, 0x36, 0x76,
+	0x17, 0xd2, 0x67, 0x29, 0xb0, 0x02, 0x0a, 0xf8, 0x72, 0x48, 0x43, 0x8e, 0x5d, 0xad, 0x60, 0xa8,
+	0xf5, 0x82, 0x9d, 0x3c, 0x43, 0x1f, 0x6c, 0x71, 0xda, 0xc7, 0x01, 0xf9, 0x84, 0x1d, 0xd6, 0x43,
+	0x21, 0x76, 0x42, 0xdc, 0xa5, 0xa1, 0xcb, 0xb4, 0xe2, 0xf2, 0x03, 0x9e, 0xc6, 0xae, 0x76, 0x64,
+	0xb2, 0x85, 0x67, 0xe6, 0x80, 0x7c, 0xbe, 0xce, 0xe0, 0x2b, 0xf0, 0x24, 0x1e, 0xdb, 0x05, 0x91,
+	0x0e, 0x71, 0x35, 0x60, 0xa8, 0xf5, 0x15, 0x7b, 0x5b, 0x8e, 0xe3, 0x1c, 0xe0, 0xd8, 0x85, 0x36,
+	0x78, 0x70, 0x37, 0x94, 0xd1, 0x2b, 0x64, 0x5a, 0xc9, 0xc8, 0x2d, 0x5b, 0xa2, 0xa3, 0xf3, 0x16,
+	0x22, 0x33, 0x03, 0xb3, 0x91, 0x10, 0x9a, 0x11, 0xa0, 0xd6, 0x03, 0x70, 0x7e, 0x70, 0x61, 0x03,
+	0xe4, 0x91, 0xeb, 0x86, 0x98, 0xc9, 0x35, 0x2d, 0x36, 0xb5, 0x9f, 0x3f, 0xf6, 0xca, 0x71, 0xc8,
+	0xa1, 0xac, 0xb4, 0x79, 0x48, 0x02, 0xcf, 0x9e, 0x0a, 0x61, 0x19, 0xac, 0xde, 0xed, 0x62, 0xce,
+	0x96, 0x0f, 0x2f, 0x0b, 0x9f, 0xaf, 0xaa, 0xca, 0xbf, 0xab, 0xaa, 0xd2, 0x7c, 0x7d, 0x3d, 0xd6,
+	0xd5, 0x9b, 0xb1, 0xae, 0xfe, 0x19, 0xeb, 0xea, 0x97, 0x89, 0xae, 0xdc, 0x4c, 0x74, 0xe5, 0xd7,
+	0x44, 0x57, 0xde, 0xbd, 0x58, 0xba, 0xae, 0x97, 0xc9, 0x85, 0x24, 0x16, 0xb7, 0xb3, 0x26, 0x6e,
+	0x96, 0x83, 0xff, 0x03, 0x00, 0xef, 0x13, 0x2b, 0x28, 0x03, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +268,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorBonds) > 0 {
+		for iNdEx := len(m.ValidatorBonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +493,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ValidatorBonds) > 0 {
+		for _, e := range m.ValidatorBonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +818,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBonds = append(m.ValidatorBonds, DVPair{})
+			if err := m.ValidatorBonds[len(m.ValidatorBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -54,6 +54,13 @@ var (
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordKey        = []byte{0x81} // prefix for each key to a tokenize share record, by id
+	TokenizeShareRecordByOwnerKey = []byte{0x82} // prefix for each key to a tokenize share record index, by owner
+	TokenizeShareRecordByDenomKey = []byte{0x83} // prefix for each key to a tokenize share record index, by share denom
+	LastTokenizeShareRecordIDKey  = []byte{0x84} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey      = []byte{0x85} // prefix for the tokenized shares of each validator
+	ValidatorBondKey              = []byte{0x86} // prefix for each key to a delegation flagged as validator bond, by validator
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -378,3 +385,42 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordKey creates the key for the tokenize share record of an id.
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordsByOwnerKey creates the prefix of the index of the
+// tokenize share records of an owner.
+func GetTokenizeShareRecordsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordByOwnerKey creates the key of the index of a tokenize
+// share record by owner.
+func GetTokenizeShareRecordByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordByDenomKey creates the key of the index of a tokenize
+// share record by share denom.
+func GetTokenizeShareRecordByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordByDenomKey, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey creates the key for the tokenized shares of a validator.
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondsKey creates the prefix of the validator bonds of a validator.
+func GetValidatorBondsKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorBondKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondKey creates the key flagging the delegation of a delegator to
+// a validator as a validator bond.
+func GetValidatorBondKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(GetValidatorBondsKey(valAddr), address.MustLengthPrefix(delAddr)...)
+}
@@ -31,21 +31,53 @@ const (
 	DefaultHistoricalEntries uint32 = 10000
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
 
-// NewParams creates a new Params instance
+	// DefaultValidatorBondFactor is set to -1, which disables the validator bond
+	// requirement of the tokenized shares.
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+)
+
+// NewParams creates a new Params instance, with the default validator bond
+// factor and liquid staking caps, see WithValidatorBondFactor and
+// WithLiquidStakingCaps.
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		ValidatorBondFactor:       DefaultValidatorBondFactor,
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
 	}
 }
 
+// WithValidatorBondFactor returns the params with the validator bond factor, -1
+// disabling the validator bond requirement of the tokenized shares.
+func (p Params) WithValidatorBondFactor(validatorBondFactor sdk.Dec) Params {
+	p.ValidatorBondFactor = validatorBondFactor
+	return p
+}
+
+// WithLiquidStakingCaps returns the params with the global and per validator
+// liquid staking caps.
+func (p Params) WithLiquidStakingCaps(globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec) Params {
+	p.GlobalLiquidStakingCap = globalLiquidStakingCap
+	p.ValidatorLiquidStakingCap = validatorLiquidStakingCap
+	return p
+}
+
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return NewParams(
@@ -104,6 +136,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +247,38 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(math.LegacyNewDec(-1)) {
+		return fmt.Errorf("validator bond factor must be -1 or non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
@@ -35,4 +35,22 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate the liquid staking params
+	params = types.DefaultParams().WithValidatorBondFactor(math.LegacyNewDec(250))
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFactor = math.LegacyNewDecWithPrec(-5, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(-1, 1)
+	require.Error(t, params.Validate())
+
+	params = params.WithLiquidStakingCaps(math.LegacyZeroDec(), math.LegacyNewDecWithPrec(5, 1))
+	require.NoError(t, params.Validate())
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), params.ValidatorLiquidStakingCap)
+
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
 }
@@ -932,6 +932,16 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// validator_bond_factor is the number of shares which may be tokenized from a
+	// validator for each share of its validator bond. -1 disables the validator
+	// bond requirement.
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum share of the bonded tokens which may
+	// be tokenized, from 0 to 1.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum share of the delegator shares of
+	// a validator which may be tokenized, from 0 to 1.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1199,6 +1209,84 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents the shares of a validator tokenized by
+// MsgTokenizeShares. The shares are delegated by the module account of the
+// record, and represented by the tokens of the share denom of the record, which
+// can be redeemed for a delegation of the shares by MsgRedeemTokensForShares.
+// The rewards of the delegation are withdrawn by the owner of the record.
+type TokenizeShareRecord struct {
+	// id is the identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the owner of the record, entitled to the rewards of
+	// the tokenized shares.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account delegating the shares.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the shares.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
 // TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
 type ValidatorUpdates struct {
@@ -1209,7 +1297,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1268,6 +1356,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 }
 
@@ -1276,125 +1365,134 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3d, 0x6c, 0x23, 0xc7,
-	0x15, 0xd6, 0x92, 0x32, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x27, 0xdf, 0xf1, 0x78, 0x89, 0x48, 0xd3,
-	0x17, 0x5b, 0x3e, 0xf8, 0xa8, 0x9c, 0x02, 0xa4, 0x50, 0x8c, 0x04, 0xa2, 0xa8, 0xf3, 0xd1, 0xb1,
-	0x25, 0x61, 0x29, 0x29, 0x71, 0x7e, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x89, 0xb8, 0xb3, 0xc4, 0xce,
-	0xf0, 0x2c, 0xb6, 0x41, 0x0a, 0x43, 0x45, 0x62, 0x20, 0x4d, 0x9a, 0x03, 0x0e, 0x48, 0x11, 0xa7,
-	0x73, 0x61, 0xc4, 0x45, 0x90, 0x22, 0x9d, 0x93, 0x34, 0x07, 0x57, 0x41, 0x0a, 0x25, 0xb8, 0x2b,
-	0x6c, 0xa4, 0x0a, 0xd2, 0x25, 0x55, 0x30, 0xb3, 0xb3, 0x3f, 0x14, 0xc5, 0x93, 0x74, 0x50, 0x0c,
-	0x03, 0x6e, 0xc8, 0x9d, 0x99, 0xf7, 0xbe, 0x99, 0xf7, 0xbd, 0x9f, 0xf9, 0x81, 0x9b, 0xb6, 0xc7,
-	0x5d, 0x8f, 0x2f, 0x71, 0x81, 0x0f, 0x28, 0x6b, 0x2f, 0xdd, 0xbf, 0xd3, 0x22, 0x02, 0xdf, 0x09,
-	0xdb, 0xd5, 0xae, 0xef, 0x09, 0x0f, 0x5d, 0x0d, 0xa4, 0xaa, 0x61, 0xaf, 0x96, 0x2a, 0xce, 0xb7,
-	0xbd, 0xb6, 0xa7, 0x44, 0x96, 0xe4, 0x57, 0x20, 0x5d, 0xbc, 0xde, 0xf6, 0xbc, 0x76, 0x87, 0x2c,
-	0xa9, 0x56, 0xab, 0xb7, 0xb7, 0x84, 0x59, 0x5f, 0x0f, 0x2d, 0x9c, 0x1c, 0x72, 0x7a, 0x3e, 0x16,
-	0xd4, 0x63, 0x7a, 0xbc, 0x74, 0x72, 0x5c, 0x50, 0x97, 0x70, 0x81, 0xdd, 0x6e, 0x88, 0x1d, 0xac,
-	0xc4, 0x0a, 0x26, 0xd5, 0xcb, 0xd2, 0xd8, 0xda, 0x94, 0x16, 0xe6, 0x24, 0xb2, 0xc3, 0xf6, 0x68,
-	0x88, 0x3d, 0x87, 0x5d, 0xca, 0xbc, 0x25, 0xf5, 0xab, 0xbb, 0xbe, 0x22, 0x08, 0x73, 0x88, 0xef,
-	0x52, 0x26, 0x96, 0x44, 0xbf, 0x4b, 0x78, 0xf0, 0xab, 0x47, 0x6f, 0x24, 0x46, 0x71, 0xcb, 0xa6,
-	0xc9, 0xc1, 0xca, 0x2f, 0x0d, 0x98, 0xbd, 0x47, 0xb9, 0xf0, 0x7c, 0x6a, 0xe3, 0x4e, 0x83, 0xed,
-	0x79, 0xe8, 0x5b, 0x90, 0xd9, 0x27, 0xd8, 0x21, 0x7e, 0xc1, 0x28, 0x1b, 0x8b, 0xd9, 0xe5, 0x42,
-	0x35, 0x06, 0xa8, 0x06, 0xba, 0xf7, 0xd4, 0x78, 0x6d, 0xea, 0xe3, 0xe3, 0xd2, 0xd8, 0xfb, 0x9f,
-	0x7e, 0x70, 0xcb, 0x30, 0xb5, 0x0a, 0xaa, 0x43, 0xe6, 0x3e, 0xee, 0x70, 0x22, 0x0a, 0xa9, 0x72,
-	0x7a, 0x31, 0xbb, 0xfc, 0x42, 0xf5, 0x74, 0xce, 0xab, 0xbb, 0xb8, 0x43, 0x1d, 0x2c, 0xbc, 0x41,
-	0x94, 0x40, 0xb7, 0xf2, 0x51, 0x0a, 0x72, 0x6b, 0x9e, 0xeb, 0x52, 0xce, 0xa9, 0xc7, 0x4c, 0x2c,
-	0x08, 0x47, 0x3b, 0x30, 0xee, 0x63, 0x41, 0xd4, 0xa2, 0xa6, 0x6a, 0xab, 0x52, 0xe9, 0x6f, 0xc7,
-	0xa5, 0x97, 0xda, 0x54, 0xec, 0xf7, 0x5a, 0x55, 0xdb, 0x73, 0x35, 0x8d, 0xfa, 0xef, 0x36, 0x77,
-	0x0e, 0xb4, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xe1, 0x6d, 0xd0, 0x0b, 0xa9, 0x13, 0x3b, 0x98, 0x4c,
-	0xc1, 0xa1, 0x1f, 0xc1, 0xa4, 0x8b, 0x0f, 0x2d, 0x05, 0x9d, 0xba, 0x2c, 0xe8, 0x09, 0x17, 0x1f,
-	0xca, 0x55, 0x23, 0x0a, 0x39, 0x89, 0x6e, 0xef, 0x63, 0xd6, 0x26, 0xc1, 0x24, 0xe9, 0xcb, 0x9a,
-	0x64, 0xc6, 0xc5, 0x87, 0x6b, 0x0a, 0x58, 0x4e, 0xb5, 0x32, 0xfe, 0xd9, 0xc3, 0x92, 0x51, 0xf9,
-	0xa3, 0x01, 0x10, 0x33, 0x87, 0x30, 0xe4, 0xed, 0xa8, 0xa5, 0xe6, 0xe7, 0xda, 0xab, 0x2f, 0x8f,
-	0x72, 0xcc, 0x09, 0xde, 0x6b, 0x33, 0x72, 0xa5, 0x8f, 0x8e, 0x4b, 0x46, 0x30, 0x6b, 0xce, 0x3e,
-	0xe1, 0x97, 0x37, 0x20, 0xdb, 0xeb, 0x3a, 0x58, 0x10, 0x4b, 0x06, 0xb9, 0xe2, 0x30, 0xbb, 0x5c,
-	0xac, 0x06, 0x19, 0x50, 0x0d, 0x33, 0xa0, 0xba, 0x1d, 0x66, 0x40, 0x00, 0xf8, 0xde, 0xdf, 0x43,
-	0x40, 0x08, 0xb4, 0xe5, 0xb8, 0xb6, 0xe1, 0x7d, 0x03, 0xb2, 0x75, 0xc2, 0x6d, 0x9f, 0x76, 0x65,
-	0x4e, 0xa1, 0x02, 0x4c, 0xb8, 0x1e, 0xa3, 0x07, 0x3a, 0x22, 0xa7, 0xcc, 0xb0, 0x89, 0x8a, 0x30,
-	0x49, 0x1d, 0xc2, 0x04, 0x15, 0xfd, 0xc0, 0x79, 0x66, 0xd4, 0x96, 0x5a, 0xef, 0x90, 0x16, 0xa7,
-	0x21, 0xe5, 0x66, 0xd8, 0x44, 0xaf, 0x40, 0x9e, 0x13, 0xbb, 0xe7, 0x53, 0xd1, 0xb7, 0x6c, 0x8f,
-	0x09, 0x6c, 0x8b, 0xc2, 0xb8, 0x12, 0xc9, 0x85, 0xfd, 0x6b, 0x41, 0xb7, 0x04, 0x71, 0x88, 0xc0,
-	0xb4, 0xc3, 0x0b, 0xcf, 0x05, 0x20, 0xba, 0xa9, 0x97, 0xfa, 0xd1, 0x04, 0x4c, 0x45, 0x91, 0x8c,
-	0xd6, 0x20, 0xef, 0x75, 0x89, 0x2f, 0xbf, 0x2d, 0xec, 0x38, 0x3e, 0xe1, 0x5c, 0x87, 0x6b, 0xe1,
-	0x93, 0x0f, 0x6f, 0xcf, 0x6b, 0xc2, 0x57, 0x83, 0x91, 0xa6, 0xf0, 0x29, 0x6b, 0x9b, 0xb9, 0x50,
-	0x43, 0x77, 0xa3, 0xb7, 0xa5, 0xcb, 0x18, 0x27, 0x8c, 0xf7, 0xb8, 0xd5, 0xed, 0xb5, 0x0e, 0x48,
-	0x5f, 0x93, 0x3a, 0x3f, 0x44, 0xea, 0x2a, 0xeb, 0xd7, 0x0a, 0x7f, 0x8e, 0xa1, 0x6d, 0xbf, 0xdf,
-	0x15, 0x5e, 0x75, 0xab, 0xd7, 0xfa, 0x2e, 0xe9, 0x4b, 0x57, 0x69, 0x9c, 0x2d, 0x05, 0x83, 0xae,
-	0x42, 0xe6, 0x27, 0x98, 0x76, 0x88, 0xa3, 0x18, 0x99, 0x34, 0x75, 0x0b, 0xad, 0x40, 0x86, 0x0b,
-	0x2c, 0x7a, 0x5c, 0xd1, 0x30, 0xbb, 0x5c, 0x19, 0x15, 0x1b, 0x35, 0x8f, 0x39, 0x4d, 0x25, 0x69,
-	0x6a, 0x0d, 0xb4, 0x0d, 0x19, 0xe1, 0x1d, 0x10, 0xa6, 0x09, 0xaa, 0xbd, 0x76, 0x81, 0xc0, 0x6e,
-	0x30, 0x91, 0x08, 0xec, 0x06, 0x13, 0xa6, 0xc6, 0x42, 0x6d, 0xc8, 0x3b, 0xa4, 0x43, 0xda, 0x8a,
-	0x4a, 0xbe, 0x8f, 0x7d, 0xc2, 0x0b, 0x99, 0x0b, 0xe3, 0x0f, 0x25, 0x8e, 0x99, 0x8b, 0x50, 0x9b,
-	0x0a, 0x14, 0x6d, 0x41, 0xd6, 0x89, 0x43, 0xad, 0x30, 0xa1, 0x88, 0x7e, 0x71, 0x94, 0xfd, 0x89,
-	0xa8, 0x4c, 0x96, 0xad, 0x24, 0x84, 0x8c, 0xae, 0x1e, 0x6b, 0x79, 0xcc, 0xa1, 0xac, 0x6d, 0xed,
-	0x13, 0xda, 0xde, 0x17, 0x85, 0xc9, 0xb2, 0xb1, 0x98, 0x36, 0x73, 0x51, 0xff, 0x3d, 0xd5, 0x8d,
-	0xb6, 0x60, 0x36, 0x16, 0x55, 0xd9, 0x33, 0x75, 0xd1, 0xec, 0x99, 0x89, 0x00, 0xa4, 0x08, 0x7a,
-	0x0b, 0x20, 0xce, 0xcf, 0x02, 0x28, 0xb4, 0xca, 0xd9, 0x99, 0x9e, 0x34, 0x26, 0x01, 0x80, 0x3a,
-	0x70, 0xc5, 0xa5, 0xcc, 0xe2, 0xa4, 0xb3, 0x67, 0x69, 0xe6, 0x24, 0x6e, 0xf6, 0x12, 0x3c, 0x3d,
-	0xe7, 0x52, 0xd6, 0x24, 0x9d, 0xbd, 0x7a, 0x04, 0x8b, 0x5e, 0x83, 0x1b, 0x31, 0x1d, 0x1e, 0xb3,
-	0xf6, 0xbd, 0x8e, 0x63, 0xf9, 0x64, 0xcf, 0xb2, 0xbd, 0x1e, 0x13, 0x85, 0x69, 0x45, 0xe2, 0xb5,
-	0x48, 0x64, 0x93, 0xdd, 0xf3, 0x3a, 0x8e, 0x49, 0xf6, 0xd6, 0xe4, 0x30, 0x7a, 0x11, 0x62, 0x2e,
-	0x2c, 0xea, 0xf0, 0xc2, 0x4c, 0x39, 0xbd, 0x38, 0x6e, 0x4e, 0x47, 0x9d, 0x0d, 0x87, 0xaf, 0x4c,
-	0xbe, 0xfb, 0xb0, 0x34, 0xf6, 0xd9, 0xc3, 0xd2, 0x58, 0xe5, 0x2e, 0x4c, 0xef, 0xe2, 0x8e, 0x4e,
-	0x3a, 0xc2, 0xd1, 0x37, 0x61, 0x0a, 0x87, 0x8d, 0x82, 0x51, 0x4e, 0x3f, 0x35, 0x69, 0x63, 0xd1,
-	0xca, 0x43, 0x03, 0x32, 0xf5, 0xdd, 0x2d, 0x4c, 0x7d, 0xb4, 0x0e, 0x73, 0x71, 0xd0, 0x9e, 0x37,
-	0xff, 0xe3, 0x38, 0x0f, 0x0b, 0xc0, 0x3a, 0xcc, 0xdd, 0x0f, 0x4b, 0x4a, 0x04, 0x93, 0x3a, 0x0b,
-	0x26, 0x52, 0xd1, 0xfd, 0x09, 0x53, 0xdf, 0x80, 0x89, 0x60, 0x85, 0x1c, 0x7d, 0x07, 0x9e, 0xeb,
-	0xca, 0x0f, 0x65, 0x61, 0x76, 0x79, 0x61, 0x64, 0xa0, 0x2b, 0xf9, 0x64, 0x58, 0x04, 0x7a, 0x95,
-	0xff, 0x18, 0x00, 0xf5, 0xdd, 0xdd, 0x6d, 0x9f, 0x76, 0x3b, 0x44, 0x5c, 0x96, 0xc9, 0x6f, 0xc2,
-	0xf3, 0xb1, 0xc9, 0xdc, 0xb7, 0xcf, 0x6d, 0xf6, 0x95, 0x48, 0xad, 0xe9, 0xdb, 0xa7, 0xa2, 0x39,
-	0x5c, 0x44, 0x68, 0xe9, 0x73, 0xa3, 0xd5, 0xb9, 0x18, 0xe6, 0xf1, 0xfb, 0x90, 0x8d, 0x4d, 0xe7,
-	0xa8, 0x01, 0x93, 0x42, 0x7f, 0x6b, 0x3a, 0x2b, 0xa3, 0xe9, 0x0c, 0xd5, 0x92, 0x94, 0x46, 0xea,
-	0x95, 0xff, 0x4a, 0x56, 0xe3, 0x44, 0xf8, 0x42, 0x05, 0x92, 0xac, 0xf0, 0xba, 0x02, 0xa7, 0x2f,
-	0xa1, 0x02, 0x6b, 0xac, 0x04, 0xad, 0x3f, 0x4b, 0xc1, 0x95, 0x9d, 0x30, 0x49, 0xbf, 0xb0, 0x2c,
-	0xec, 0xc0, 0x04, 0x61, 0xc2, 0xa7, 0x8a, 0x06, 0xe9, 0xec, 0xaf, 0x8f, 0x72, 0xf6, 0x29, 0xb6,
-	0xac, 0x33, 0xe1, 0xf7, 0x93, 0xae, 0x0f, 0xb1, 0x12, 0x34, 0xfc, 0x21, 0x0d, 0x85, 0x51, 0xaa,
-	0xe8, 0x65, 0xc8, 0xd9, 0x3e, 0x51, 0x1d, 0xe1, 0x9e, 0x62, 0xa8, 0x72, 0x38, 0x1b, 0x76, 0xeb,
-	0x2d, 0xc5, 0x04, 0x79, 0x40, 0x93, 0x51, 0x25, 0x45, 0x9f, 0xed, 0x44, 0x36, 0x1b, 0x23, 0xa8,
-	0x4d, 0x85, 0x40, 0x8e, 0x32, 0x2a, 0x28, 0xee, 0x58, 0x2d, 0xdc, 0xc1, 0xcc, 0x26, 0xcf, 0x10,
-	0x09, 0xc3, 0x3b, 0xc0, 0xac, 0x06, 0xad, 0x05, 0x98, 0x68, 0x17, 0x26, 0x42, 0xf8, 0xf1, 0x4b,
-	0x80, 0x0f, 0xc1, 0xd0, 0x0b, 0x30, 0x9d, 0xdc, 0x18, 0xd4, 0x39, 0x65, 0xdc, 0xcc, 0x26, 0xf6,
-	0x85, 0xb3, 0x76, 0x9e, 0xcc, 0x53, 0x77, 0x1e, 0x7d, 0x14, 0xfc, 0x7d, 0x1a, 0xe6, 0x4c, 0xe2,
-	0x7c, 0x09, 0x1d, 0xf7, 0x43, 0x80, 0x20, 0xa9, 0x65, 0xb1, 0x7d, 0x06, 0xdf, 0x0d, 0x17, 0x89,
-	0xa9, 0x00, 0xaf, 0xce, 0xc5, 0xe7, 0xe5, 0xbd, 0xbf, 0xa4, 0x60, 0x3a, 0xe9, 0xbd, 0x2f, 0xc1,
-	0xce, 0x86, 0x36, 0xe2, 0x92, 0x36, 0xae, 0x4a, 0xda, 0x2b, 0xa3, 0x4a, 0xda, 0x50, 0x5c, 0x9f,
-	0x51, 0xcb, 0x7e, 0x93, 0x86, 0xcc, 0x16, 0xf6, 0xb1, 0xcb, 0xd1, 0xe6, 0xd0, 0x19, 0x37, 0xb8,
-	0x7f, 0x5e, 0x1f, 0x0a, 0xeb, 0xba, 0x7e, 0x43, 0x09, 0xa2, 0xfa, 0x57, 0xa3, 0x8e, 0xb8, 0x5f,
-	0x83, 0x59, 0x79, 0xa5, 0x8e, 0x0c, 0x0a, 0xa8, 0x9c, 0x51, 0xd7, 0xe1, 0xe8, 0x2a, 0xc6, 0x51,
-	0x09, 0xb2, 0x52, 0x2c, 0xae, 0xd9, 0x52, 0x06, 0x5c, 0x7c, 0xb8, 0x1e, 0xf4, 0xa0, 0xdb, 0x80,
-	0xf6, 0xa3, 0x87, 0x0f, 0x2b, 0x26, 0x42, 0xca, 0xcd, 0xc5, 0x23, 0xa1, 0xf8, 0x57, 0x01, 0xe4,
-	0x2a, 0x2c, 0x87, 0x30, 0xcf, 0xd5, 0x97, 0xc1, 0x29, 0xd9, 0x53, 0x97, 0x1d, 0xe8, 0x17, 0x46,
-	0x70, 0x54, 0x3e, 0x71, 0xdb, 0xd6, 0x97, 0x16, 0xeb, 0x62, 0xd9, 0xf0, 0xef, 0xe3, 0x52, 0xb1,
-	0x8f, 0xdd, 0xce, 0x4a, 0xe5, 0x14, 0xc8, 0xca, 0x69, 0x6f, 0x01, 0xf2, 0x34, 0x3d, 0x78, 0x71,
-	0x5f, 0xb9, 0x29, 0xe3, 0xfa, 0xe8, 0xd3, 0x0f, 0x6e, 0xdd, 0x48, 0x4c, 0x71, 0x18, 0xbd, 0x90,
-	0x05, 0xee, 0xa9, 0xfc, 0xd6, 0x00, 0x14, 0x6f, 0x36, 0x26, 0xe1, 0x5d, 0x79, 0x67, 0x94, 0xf7,
-	0x88, 0xc4, 0x79, 0xdf, 0x78, 0xfa, 0x3d, 0x22, 0xd6, 0x1f, 0xb8, 0x47, 0x24, 0x92, 0xe9, 0xdb,
-	0x71, 0x69, 0x4f, 0x69, 0xef, 0x6b, 0xac, 0x16, 0xe6, 0x24, 0x71, 0x21, 0xa1, 0x03, 0x10, 0xa1,
-	0x92, 0xca, 0xd1, 0xb1, 0xca, 0xb1, 0x01, 0xd7, 0x87, 0x22, 0x31, 0x5a, 0xb2, 0x0d, 0xc8, 0x4f,
-	0x0c, 0x2a, 0x8f, 0xf6, 0xf5, 0xd2, 0x9f, 0x2d, 0xb0, 0xe7, 0xfc, 0xa1, 0x72, 0xfe, 0x7f, 0xda,
-	0xa3, 0x74, 0x11, 0xfa, 0x93, 0x01, 0xf3, 0xc9, 0x15, 0x45, 0xb6, 0x35, 0x61, 0x3a, 0xb9, 0x16,
-	0x6d, 0xd5, 0xcd, 0xf3, 0x58, 0x95, 0x34, 0x68, 0x00, 0x44, 0xda, 0x12, 0x46, 0x7d, 0xf0, 0x56,
-	0x77, 0xe7, 0xdc, 0x2c, 0x85, 0x0b, 0x3b, 0xb5, 0x0c, 0x04, 0xce, 0xfa, 0x79, 0x0a, 0xc6, 0xb7,
-	0x3c, 0xaf, 0x83, 0x7e, 0x6a, 0xc0, 0x1c, 0xf3, 0x84, 0x25, 0x73, 0x85, 0x38, 0x96, 0x7e, 0x2c,
-	0x08, 0x2a, 0xe9, 0xee, 0xc5, 0xd8, 0xfb, 0xe7, 0x71, 0x69, 0x18, 0x6a, 0x90, 0x52, 0xfd, 0x48,
-	0xc5, 0x3c, 0x51, 0x53, 0x42, 0xdb, 0xc1, 0x7b, 0xc2, 0x3b, 0x30, 0x33, 0x38, 0x7f, 0x50, 0x7e,
-	0xcd, 0x0b, 0xcf, 0x3f, 0x73, 0xe6, 0xdc, 0xd3, 0xad, 0xc4, 0xc4, 0x2b, 0x93, 0xd2, 0xb1, 0xff,
-	0x92, 0xce, 0x7d, 0x1b, 0xf2, 0x51, 0x79, 0xda, 0x51, 0x4f, 0x5e, 0xf2, 0x6c, 0x3a, 0x11, 0xbc,
-	0x7e, 0x85, 0x37, 0x88, 0x72, 0xf2, 0xad, 0x15, 0xb7, 0x6c, 0x5a, 0x3d, 0xa1, 0x33, 0xc0, 0xb8,
-	0xd6, 0xbd, 0xf5, 0x3b, 0x03, 0x20, 0x7e, 0x9a, 0x41, 0xaf, 0xc2, 0xb5, 0xda, 0xe6, 0x46, 0xdd,
-	0x6a, 0x6e, 0xaf, 0x6e, 0xef, 0x34, 0xad, 0x9d, 0x8d, 0xe6, 0xd6, 0xfa, 0x5a, 0xe3, 0x6e, 0x63,
-	0xbd, 0x9e, 0x1f, 0x2b, 0xe6, 0x8e, 0x1e, 0x94, 0xb3, 0x3b, 0x8c, 0x77, 0x89, 0x4d, 0xf7, 0x28,
-	0x71, 0xd0, 0x4b, 0x30, 0x3f, 0x28, 0x2d, 0x5b, 0xeb, 0xf5, 0xbc, 0x51, 0x9c, 0x3e, 0x7a, 0x50,
-	0x9e, 0x0c, 0x8e, 0xa4, 0xc4, 0x41, 0x8b, 0xf0, 0xfc, 0xb0, 0x5c, 0x63, 0xe3, 0xf5, 0x7c, 0xaa,
-	0x38, 0x73, 0xf4, 0xa0, 0x3c, 0x15, 0x9d, 0x5d, 0x51, 0x05, 0x50, 0x52, 0x52, 0xe3, 0xa5, 0x8b,
-	0x70, 0xf4, 0xa0, 0x9c, 0x09, 0xdc, 0x52, 0x1c, 0x7f, 0xf7, 0xd7, 0x0b, 0x63, 0xb7, 0x7e, 0x0c,
-	0xd0, 0x60, 0x7b, 0x3e, 0xb6, 0x55, 0x40, 0x16, 0xe1, 0x6a, 0x63, 0xe3, 0xae, 0xb9, 0xba, 0xb6,
-	0xdd, 0xd8, 0xdc, 0x18, 0x5c, 0xf6, 0x89, 0xb1, 0xfa, 0xe6, 0x4e, 0xed, 0xcd, 0x75, 0xab, 0xd9,
-	0x78, 0x7d, 0x23, 0x6f, 0xa0, 0x6b, 0x70, 0x65, 0x60, 0xec, 0x7b, 0x1b, 0xdb, 0x8d, 0xb7, 0xd6,
-	0xf3, 0xa9, 0xda, 0xdd, 0x8f, 0x1f, 0x2f, 0x18, 0x8f, 0x1e, 0x2f, 0x18, 0xff, 0x78, 0xbc, 0x60,
-	0xbc, 0xf7, 0x64, 0x61, 0xec, 0xd1, 0x93, 0x85, 0xb1, 0xbf, 0x3e, 0x59, 0x18, 0xfb, 0xc1, 0xab,
-	0x4f, 0x75, 0x78, 0x5c, 0x25, 0x95, 0xeb, 0x5b, 0x19, 0xb5, 0x47, 0x7d, 0xe3, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0xd7, 0xc2, 0x0d, 0x66, 0x18, 0x00, 0x00,
+	// 2022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x34, 0x25, 0x3e, 0x4a, 0x22, 0x35, 0x76, 0xec, 0x35, 0x9d, 0xbf, 0xc8, 0x30,
+	0xfe, 0x27, 0x8e, 0x11, 0x53, 0xb5, 0x0b, 0xf4, 0xa0, 0x06, 0x2d, 0x44, 0x51, 0x8e, 0x99, 0x3a,
+	0xb2, 0xb0, 0x94, 0xd4, 0xa6, 0x1f, 0x58, 0x0c, 0x77, 0x47, 0xd4, 0xd4, 0xcb, 0x5d, 0x76, 0x67,
+	0x68, 0x9b, 0x45, 0x4f, 0x45, 0x0f, 0x81, 0x0f, 0x6d, 0x80, 0x5e, 0x7a, 0x31, 0xe0, 0xa2, 0x97,
+	0xf4, 0x96, 0x83, 0xd1, 0x1c, 0x8a, 0x1e, 0x7a, 0x4b, 0xdb, 0x8b, 0x91, 0x53, 0xd1, 0x83, 0x5a,
+	0xd8, 0x87, 0x04, 0x3d, 0x15, 0xbd, 0xb5, 0xa7, 0x62, 0x3e, 0xf6, 0x83, 0xfa, 0xb0, 0xac, 0x80,
+	0x2d, 0x02, 0xe4, 0x22, 0x71, 0xdf, 0xbc, 0xf7, 0x9b, 0xf7, 0xde, 0xbc, 0xf7, 0x66, 0xde, 0x83,
+	0x8b, 0x4e, 0xc0, 0xfa, 0x01, 0x5b, 0x62, 0x1c, 0xdf, 0xa6, 0x7e, 0x6f, 0xe9, 0xce, 0xd5, 0x2e,
+	0xe1, 0xf8, 0x6a, 0xf4, 0xdd, 0x18, 0x84, 0x01, 0x0f, 0xd0, 0x59, 0xc5, 0xd5, 0x88, 0xa8, 0x9a,
+	0xab, 0x72, 0xa6, 0x17, 0xf4, 0x02, 0xc9, 0xb2, 0x24, 0x7e, 0x29, 0xee, 0xca, 0xf9, 0x5e, 0x10,
+	0xf4, 0x3c, 0xb2, 0x24, 0xbf, 0xba, 0xc3, 0x9d, 0x25, 0xec, 0x8f, 0xf4, 0xd2, 0xe2, 0xfe, 0x25,
+	0x77, 0x18, 0x62, 0x4e, 0x03, 0x5f, 0xaf, 0x57, 0xf7, 0xaf, 0x73, 0xda, 0x27, 0x8c, 0xe3, 0xfe,
+	0x20, 0xc2, 0x56, 0x9a, 0xd8, 0x6a, 0x53, 0xad, 0x96, 0xc6, 0xd6, 0xa6, 0x74, 0x31, 0x23, 0xb1,
+	0x1d, 0x4e, 0x40, 0x23, 0xec, 0x05, 0xdc, 0xa7, 0x7e, 0xb0, 0x24, 0xff, 0x6a, 0xd2, 0x8b, 0x9c,
+	0xf8, 0x2e, 0x09, 0xfb, 0xd4, 0xe7, 0x4b, 0x7c, 0x34, 0x20, 0x4c, 0xfd, 0xd5, 0xab, 0x17, 0x52,
+	0xab, 0xb8, 0xeb, 0xd0, 0xf4, 0x62, 0xfd, 0xe7, 0x06, 0xcc, 0xdf, 0xa0, 0x8c, 0x07, 0x21, 0x75,
+	0xb0, 0xd7, 0xf6, 0x77, 0x02, 0xf4, 0x55, 0xc8, 0xef, 0x12, 0xec, 0x92, 0xd0, 0x34, 0x6a, 0xc6,
+	0xa5, 0xe2, 0x35, 0xb3, 0x91, 0x00, 0x34, 0x94, 0xec, 0x0d, 0xb9, 0xde, 0x2c, 0x7c, 0xb4, 0x57,
+	0x9d, 0x7a, 0xff, 0x93, 0x0f, 0x2e, 0x1b, 0x96, 0x16, 0x41, 0x2d, 0xc8, 0xdf, 0xc1, 0x1e, 0x23,
+	0xdc, 0xcc, 0xd4, 0xb2, 0x97, 0x8a, 0xd7, 0x5e, 0x6a, 0x1c, 0xee, 0xf3, 0xc6, 0x36, 0xf6, 0xa8,
+	0x8b, 0x79, 0x30, 0x8e, 0xa2, 0x64, 0xeb, 0x1f, 0x66, 0xa0, 0xb4, 0x1a, 0xf4, 0xfb, 0x94, 0x31,
+	0x1a, 0xf8, 0x16, 0xe6, 0x84, 0xa1, 0x2d, 0xc8, 0x85, 0x98, 0x13, 0xa9, 0x54, 0xa1, 0xb9, 0x22,
+	0x84, 0xfe, 0xb2, 0x57, 0x7d, 0xa5, 0x47, 0xf9, 0xee, 0xb0, 0xdb, 0x70, 0x82, 0xbe, 0x76, 0xa3,
+	0xfe, 0x77, 0x85, 0xb9, 0xb7, 0xb5, 0xa5, 0x2d, 0xe2, 0x7c, 0xfc, 0xe8, 0x0a, 0x68, 0x45, 0x5a,
+	0xc4, 0x51, 0x9b, 0x49, 0x38, 0xf4, 0x5d, 0x98, 0xe9, 0xe3, 0x7b, 0xb6, 0x84, 0xce, 0x4c, 0x0a,
+	0x7a, 0xba, 0x8f, 0xef, 0x09, 0xad, 0x11, 0x85, 0x92, 0x40, 0x77, 0x76, 0xb1, 0xdf, 0x23, 0x6a,
+	0x93, 0xec, 0xa4, 0x36, 0x99, 0xeb, 0xe3, 0x7b, 0xab, 0x12, 0x58, 0x6c, 0xb5, 0x9c, 0xfb, 0xf4,
+	0x61, 0xd5, 0xa8, 0xff, 0xde, 0x00, 0x48, 0x3c, 0x87, 0x30, 0x94, 0x9d, 0xf8, 0x4b, 0xee, 0xcf,
+	0xf4, 0xa9, 0xbe, 0x7a, 0xd4, 0xc1, 0xec, 0xf3, 0x7b, 0x73, 0x4e, 0x68, 0xfa, 0x78, 0xaf, 0x6a,
+	0xa8, 0x5d, 0x4b, 0xce, 0xbe, 0x73, 0x79, 0x0b, 0x8a, 0xc3, 0x81, 0x8b, 0x39, 0xb1, 0x45, 0x90,
+	0x4b, 0x1f, 0x16, 0xaf, 0x55, 0x1a, 0x2a, 0x03, 0x1a, 0x51, 0x06, 0x34, 0x36, 0xa3, 0x0c, 0x50,
+	0x80, 0xef, 0xfd, 0x35, 0x02, 0x04, 0x25, 0x2d, 0xd6, 0xb5, 0x0d, 0xef, 0x1b, 0x50, 0x6c, 0x11,
+	0xe6, 0x84, 0x74, 0x20, 0x72, 0x0a, 0x99, 0x30, 0xdd, 0x0f, 0x7c, 0x7a, 0x5b, 0x47, 0x64, 0xc1,
+	0x8a, 0x3e, 0x51, 0x05, 0x66, 0xa8, 0x4b, 0x7c, 0x4e, 0xf9, 0x48, 0x1d, 0x9e, 0x15, 0x7f, 0x0b,
+	0xa9, 0xbb, 0xa4, 0xcb, 0x68, 0xe4, 0x72, 0x2b, 0xfa, 0x44, 0xaf, 0x41, 0x99, 0x11, 0x67, 0x18,
+	0x52, 0x3e, 0xb2, 0x9d, 0xc0, 0xe7, 0xd8, 0xe1, 0x66, 0x4e, 0xb2, 0x94, 0x22, 0xfa, 0xaa, 0x22,
+	0x0b, 0x10, 0x97, 0x70, 0x4c, 0x3d, 0x66, 0x9e, 0x52, 0x20, 0xfa, 0x53, 0xab, 0xfa, 0xe1, 0x34,
+	0x14, 0xe2, 0x48, 0x46, 0xab, 0x50, 0x0e, 0x06, 0x24, 0x14, 0xbf, 0x6d, 0xec, 0xba, 0x21, 0x61,
+	0x4c, 0x87, 0xab, 0xf9, 0xf1, 0xa3, 0x2b, 0x67, 0xb4, 0xc3, 0x57, 0xd4, 0x4a, 0x87, 0x87, 0xd4,
+	0xef, 0x59, 0xa5, 0x48, 0x42, 0x93, 0xd1, 0x3b, 0xe2, 0xc8, 0x7c, 0x46, 0x7c, 0x36, 0x64, 0xf6,
+	0x60, 0xd8, 0xbd, 0x4d, 0x46, 0xda, 0xa9, 0x67, 0x0e, 0x38, 0x75, 0xc5, 0x1f, 0x35, 0xcd, 0x3f,
+	0x26, 0xd0, 0x4e, 0x38, 0x1a, 0xf0, 0xa0, 0xb1, 0x31, 0xec, 0x7e, 0x83, 0x8c, 0xac, 0x52, 0x8c,
+	0xb3, 0x21, 0x61, 0xd0, 0x59, 0xc8, 0x7f, 0x1f, 0x53, 0x8f, 0xb8, 0xd2, 0x23, 0x33, 0x96, 0xfe,
+	0x42, 0xcb, 0x90, 0x67, 0x1c, 0xf3, 0x21, 0x93, 0x6e, 0x98, 0xbf, 0x56, 0x3f, 0x2a, 0x36, 0x9a,
+	0x81, 0xef, 0x76, 0x24, 0xa7, 0xa5, 0x25, 0xd0, 0x26, 0xe4, 0x79, 0x70, 0x9b, 0xf8, 0xda, 0x41,
+	0xcd, 0x37, 0x4e, 0x10, 0xd8, 0x6d, 0x9f, 0xa7, 0x02, 0xbb, 0xed, 0x73, 0x4b, 0x63, 0xa1, 0x1e,
+	0x94, 0x5d, 0xe2, 0x91, 0x9e, 0x74, 0x25, 0xdb, 0xc5, 0x21, 0x61, 0x66, 0xfe, 0xc4, 0xf8, 0x07,
+	0x12, 0xc7, 0x2a, 0xc5, 0xa8, 0x1d, 0x09, 0x8a, 0x36, 0xa0, 0xe8, 0x26, 0xa1, 0x66, 0x4e, 0x4b,
+	0x47, 0xbf, 0x7c, 0x94, 0xfd, 0xa9, 0xa8, 0x4c, 0x97, 0xad, 0x34, 0x84, 0x88, 0xae, 0xa1, 0xdf,
+	0x0d, 0x7c, 0x97, 0xfa, 0x3d, 0x7b, 0x97, 0xd0, 0xde, 0x2e, 0x37, 0x67, 0x6a, 0xc6, 0xa5, 0xac,
+	0x55, 0x8a, 0xe9, 0x37, 0x24, 0x19, 0x6d, 0xc0, 0x7c, 0xc2, 0x2a, 0xb3, 0xa7, 0x70, 0xd2, 0xec,
+	0x99, 0x8b, 0x01, 0x04, 0x0b, 0x7a, 0x1b, 0x20, 0xc9, 0x4f, 0x13, 0x24, 0x5a, 0xfd, 0xf8, 0x4c,
+	0x4f, 0x1b, 0x93, 0x02, 0x40, 0x1e, 0x9c, 0xee, 0x53, 0xdf, 0x66, 0xc4, 0xdb, 0xb1, 0xb5, 0xe7,
+	0x04, 0x6e, 0x71, 0x02, 0x27, 0xbd, 0xd0, 0xa7, 0x7e, 0x87, 0x78, 0x3b, 0xad, 0x18, 0x16, 0xbd,
+	0x01, 0x17, 0x12, 0x77, 0x04, 0xbe, 0xbd, 0x1b, 0x78, 0xae, 0x1d, 0x92, 0x1d, 0xdb, 0x09, 0x86,
+	0x3e, 0x37, 0x67, 0xa5, 0x13, 0xcf, 0xc5, 0x2c, 0xb7, 0xfc, 0x1b, 0x81, 0xe7, 0x5a, 0x64, 0x67,
+	0x55, 0x2c, 0xa3, 0x97, 0x21, 0xf1, 0x85, 0x4d, 0x5d, 0x66, 0xce, 0xd5, 0xb2, 0x97, 0x72, 0xd6,
+	0x6c, 0x4c, 0x6c, 0xbb, 0x6c, 0x79, 0xe6, 0xdd, 0x87, 0xd5, 0xa9, 0x4f, 0x1f, 0x56, 0xa7, 0xea,
+	0xd7, 0x61, 0x76, 0x1b, 0x7b, 0x3a, 0xe9, 0x08, 0x43, 0x5f, 0x81, 0x02, 0x8e, 0x3e, 0x4c, 0xa3,
+	0x96, 0x7d, 0x66, 0xd2, 0x26, 0xac, 0xf5, 0x87, 0x06, 0xe4, 0x5b, 0xdb, 0x1b, 0x98, 0x86, 0x68,
+	0x0d, 0x16, 0x92, 0xa0, 0x7d, 0xde, 0xfc, 0x4f, 0xe2, 0x5c, 0xd3, 0x05, 0xcc, 0x9d, 0xa8, 0xa4,
+	0xc4, 0x30, 0x99, 0xe3, 0x60, 0x62, 0x11, 0x4d, 0x4f, 0x99, 0xfa, 0x16, 0x4c, 0x2b, 0x0d, 0x19,
+	0xfa, 0x3a, 0x9c, 0x1a, 0x88, 0x1f, 0xd2, 0xc2, 0xe2, 0xb5, 0xc5, 0x23, 0x03, 0x5d, 0xf2, 0xa7,
+	0xc3, 0x42, 0xc9, 0xd5, 0xff, 0x65, 0x00, 0xb4, 0xb6, 0xb7, 0x37, 0x43, 0x3a, 0xf0, 0x08, 0x9f,
+	0x94, 0xc9, 0x37, 0xe1, 0x85, 0xc4, 0x64, 0x16, 0x3a, 0xcf, 0x6d, 0xf6, 0xe9, 0x58, 0xac, 0x13,
+	0x3a, 0x87, 0xa2, 0xb9, 0x8c, 0xc7, 0x68, 0xd9, 0xe7, 0x46, 0x6b, 0x31, 0x7e, 0xd0, 0x8f, 0xdf,
+	0x82, 0x62, 0x62, 0x3a, 0x43, 0x6d, 0x98, 0xe1, 0xfa, 0xb7, 0x76, 0x67, 0xfd, 0x68, 0x77, 0x46,
+	0x62, 0x69, 0x97, 0xc6, 0xe2, 0xf5, 0x7f, 0x0b, 0xaf, 0x26, 0x89, 0xf0, 0xb9, 0x0a, 0x24, 0x51,
+	0xe1, 0x75, 0x05, 0xce, 0x4e, 0xa0, 0x02, 0x6b, 0xac, 0x94, 0x5b, 0x7f, 0x92, 0x81, 0xd3, 0x5b,
+	0x51, 0x92, 0x7e, 0x6e, 0xbd, 0xb0, 0x05, 0xd3, 0xc4, 0xe7, 0x21, 0x95, 0x6e, 0x10, 0x87, 0xfd,
+	0xa5, 0xa3, 0x0e, 0xfb, 0x10, 0x5b, 0xd6, 0x7c, 0x1e, 0x8e, 0xd2, 0x47, 0x1f, 0x61, 0xa5, 0xdc,
+	0xf0, 0xbb, 0x2c, 0x98, 0x47, 0x89, 0xa2, 0x57, 0xa1, 0xe4, 0x84, 0x44, 0x12, 0xa2, 0x3b, 0xc5,
+	0x90, 0xe5, 0x70, 0x3e, 0x22, 0xeb, 0x2b, 0xc5, 0x02, 0xf1, 0x40, 0x13, 0x51, 0x25, 0x58, 0x3f,
+	0xdb, 0x8b, 0x6c, 0x3e, 0x41, 0x90, 0x97, 0x0a, 0x81, 0x12, 0xf5, 0x29, 0xa7, 0xd8, 0xb3, 0xbb,
+	0xd8, 0xc3, 0xbe, 0x43, 0xcc, 0xec, 0x04, 0x6e, 0x80, 0x79, 0x0d, 0xda, 0x54, 0x98, 0x68, 0x1b,
+	0xa6, 0x23, 0xf8, 0xdc, 0x04, 0xe0, 0x23, 0x30, 0xf4, 0x12, 0xcc, 0xa6, 0x2f, 0x06, 0xf9, 0x4e,
+	0xc9, 0x59, 0xc5, 0xd4, 0xbd, 0x70, 0xdc, 0xcd, 0x93, 0x7f, 0xe6, 0xcd, 0xa3, 0x9f, 0x82, 0xbf,
+	0xcd, 0xc2, 0x82, 0x45, 0xdc, 0x2f, 0xe0, 0xc1, 0x7d, 0x07, 0x40, 0x25, 0xb5, 0x28, 0xb6, 0x66,
+	0x6e, 0x02, 0x45, 0xa2, 0xa0, 0xf0, 0x5a, 0x8c, 0xff, 0xaf, 0x4e, 0xef, 0x4f, 0x19, 0x98, 0x4d,
+	0x9f, 0xde, 0x17, 0xe0, 0x66, 0x43, 0xeb, 0x49, 0x49, 0xcb, 0xc9, 0x92, 0xf6, 0xda, 0x51, 0x25,
+	0xed, 0x40, 0x5c, 0x1f, 0x53, 0xcb, 0x7e, 0x99, 0x87, 0xfc, 0x06, 0x0e, 0x71, 0x9f, 0xa1, 0x5b,
+	0x07, 0xde, 0xb8, 0xaa, 0xff, 0x3c, 0x7f, 0x20, 0xac, 0x5b, 0x7a, 0x86, 0xa2, 0xa2, 0xfa, 0x17,
+	0x47, 0x3d, 0x71, 0xff, 0x1f, 0xe6, 0x45, 0x4b, 0x1d, 0x1b, 0xa4, 0x5c, 0x39, 0x27, 0xdb, 0xe1,
+	0xb8, 0x15, 0x63, 0xa8, 0x0a, 0x45, 0xc1, 0x96, 0xd4, 0x6c, 0xc1, 0x03, 0x7d, 0x7c, 0x6f, 0x4d,
+	0x51, 0xd0, 0x15, 0x40, 0xbb, 0xf1, 0xe0, 0xc3, 0x4e, 0x1c, 0x21, 0xf8, 0x16, 0x92, 0x95, 0x88,
+	0xfd, 0xff, 0x00, 0x84, 0x16, 0xb6, 0x4b, 0xfc, 0xa0, 0xaf, 0x9b, 0xc1, 0x82, 0xa0, 0xb4, 0x04,
+	0x01, 0xfd, 0xcc, 0x50, 0x4f, 0xe5, 0x7d, 0xdd, 0xb6, 0x6e, 0x5a, 0xec, 0x93, 0x65, 0xc3, 0x3f,
+	0xf7, 0xaa, 0x95, 0x11, 0xee, 0x7b, 0xcb, 0xf5, 0x43, 0x20, 0xeb, 0x87, 0xcd, 0x02, 0xc4, 0x6b,
+	0x7a, 0xbc, 0x71, 0x47, 0xc3, 0x74, 0xac, 0x48, 0xd5, 0x77, 0xb0, 0xc3, 0x83, 0xd0, 0x9c, 0x9e,
+	0xd4, 0x00, 0x22, 0x09, 0x2a, 0xd1, 0x17, 0x5e, 0x97, 0xe8, 0xe8, 0x47, 0x70, 0xbe, 0xe7, 0x05,
+	0x5d, 0xec, 0xd9, 0x1e, 0xfd, 0xc1, 0x90, 0xba, 0xb6, 0x8e, 0x25, 0xdb, 0xc1, 0x03, 0x73, 0x66,
+	0x52, 0x5b, 0x9f, 0x55, 0x7b, 0xdc, 0x94, 0x5b, 0x74, 0xd4, 0x0e, 0xab, 0x78, 0x80, 0x7e, 0x6c,
+	0xc0, 0x8b, 0x89, 0xd5, 0x87, 0x68, 0x50, 0x98, 0x94, 0x06, 0xe7, 0xe3, 0x6d, 0xf6, 0x2b, 0xb1,
+	0x7c, 0x51, 0x54, 0x94, 0xfb, 0x9f, 0x7c, 0x70, 0xf9, 0x42, 0x0a, 0xec, 0x5e, 0x3c, 0x9b, 0x54,
+	0x89, 0x51, 0xff, 0xb5, 0x01, 0x28, 0xb9, 0xe6, 0x2d, 0xc2, 0x06, 0x81, 0xcf, 0x64, 0x07, 0x97,
+	0xea, 0xb4, 0x8c, 0x67, 0x77, 0x70, 0x89, 0xfc, 0x58, 0x07, 0x97, 0x2a, 0x63, 0x5f, 0x4b, 0x2e,
+	0xd5, 0x8c, 0xce, 0x3b, 0x8d, 0x25, 0xe6, 0x8b, 0xa9, 0x56, 0x90, 0x8e, 0x41, 0x44, 0x42, 0xb2,
+	0x3a, 0x4e, 0xd5, 0xf7, 0x0c, 0x38, 0x7f, 0xa0, 0x06, 0xc4, 0x2a, 0x3b, 0x80, 0xc2, 0xd4, 0xa2,
+	0xcc, 0xa5, 0x91, 0x56, 0xfd, 0xb3, 0x95, 0x94, 0x85, 0x70, 0xff, 0xea, 0x7f, 0xeb, 0x75, 0xa0,
+	0xcb, 0xff, 0x1f, 0x0c, 0x38, 0x93, 0xd6, 0x28, 0xb6, 0xad, 0x03, 0xb3, 0x69, 0x5d, 0xb4, 0x55,
+	0x17, 0x9f, 0xc7, 0xaa, 0xb4, 0x41, 0x63, 0x20, 0xc2, 0x96, 0xa8, 0xde, 0xa8, 0x29, 0xe9, 0xd5,
+	0xe7, 0xf6, 0x52, 0xa4, 0xd8, 0xa1, 0x05, 0x58, 0x1d, 0xd6, 0x4f, 0x33, 0x90, 0xdb, 0x08, 0x02,
+	0x4f, 0x24, 0xc3, 0x82, 0x1f, 0x70, 0x99, 0xfc, 0xc4, 0xb5, 0xf5, 0x98, 0x46, 0xdd, 0x61, 0xdb,
+	0x27, 0xf3, 0xde, 0xdf, 0xf7, 0xaa, 0x07, 0xa1, 0xc6, 0x5d, 0xaa, 0xc7, 0x83, 0x7e, 0xc0, 0x9b,
+	0x92, 0x69, 0x53, 0xf2, 0xa0, 0xbb, 0x30, 0x37, 0xbe, 0xbf, 0xba, 0xf8, 0xac, 0x13, 0xef, 0x3f,
+	0x77, 0xec, 0xde, 0xb3, 0xdd, 0xd4, 0xc6, 0xcb, 0x33, 0xe2, 0x60, 0xff, 0x21, 0x0e, 0xf7, 0x91,
+	0x01, 0xa7, 0x25, 0x91, 0xfe, 0x90, 0xc8, 0xb1, 0x8f, 0x45, 0x9c, 0x20, 0x74, 0xd1, 0x3c, 0x64,
+	0xa8, 0x2b, 0xfd, 0x91, 0xb3, 0x32, 0xd4, 0x45, 0x0d, 0x38, 0x15, 0xdc, 0xf5, 0x49, 0x78, 0xec,
+	0xdd, 0xac, 0xd8, 0xe4, 0x4d, 0x14, 0xb8, 0x43, 0x8f, 0xd8, 0xd8, 0x51, 0x4f, 0x0d, 0x35, 0x68,
+	0x9c, 0x53, 0xd4, 0x15, 0x45, 0x14, 0x93, 0x85, 0xb8, 0x56, 0x98, 0xb9, 0x63, 0xa0, 0x13, 0xd6,
+	0xfa, 0x3b, 0x50, 0x8e, 0xef, 0xb3, 0x2d, 0x39, 0x23, 0x15, 0xcd, 0xcc, 0xb4, 0x1a, 0x97, 0x46,
+	0x2d, 0x67, 0x2d, 0x3d, 0x9c, 0x17, 0xd3, 0xfd, 0xc6, 0x3e, 0x99, 0xb1, 0x40, 0xd1, 0xb2, 0x97,
+	0x7f, 0x63, 0x00, 0x24, 0xb3, 0x3c, 0xf4, 0x3a, 0x9c, 0x6b, 0xde, 0x5a, 0x6f, 0xd9, 0x9d, 0xcd,
+	0x95, 0xcd, 0xad, 0x8e, 0xbd, 0xb5, 0xde, 0xd9, 0x58, 0x5b, 0x6d, 0x5f, 0x6f, 0xaf, 0xb5, 0xca,
+	0x53, 0x95, 0xd2, 0xfd, 0x07, 0xb5, 0xe2, 0x96, 0xcf, 0x06, 0xc4, 0xa1, 0x3b, 0x94, 0xb8, 0xe8,
+	0x15, 0x38, 0x33, 0xce, 0x2d, 0xbe, 0xd6, 0x5a, 0x65, 0xa3, 0x32, 0x7b, 0xff, 0x41, 0x6d, 0x46,
+	0xf5, 0x30, 0xc4, 0x45, 0x97, 0xe0, 0x85, 0x83, 0x7c, 0xed, 0xf5, 0x37, 0xcb, 0x99, 0xca, 0xdc,
+	0xfd, 0x07, 0xb5, 0x42, 0xdc, 0xec, 0xa0, 0x3a, 0xa0, 0x34, 0xa7, 0xc6, 0xcb, 0x56, 0xe0, 0xfe,
+	0x83, 0x5a, 0x5e, 0x45, 0x53, 0x25, 0xf7, 0xee, 0xaf, 0x16, 0xa7, 0x2e, 0x7f, 0x0f, 0xa0, 0xed,
+	0xef, 0x84, 0xd8, 0x91, 0x79, 0x54, 0x81, 0xb3, 0xed, 0xf5, 0xeb, 0xd6, 0xca, 0xea, 0x66, 0xfb,
+	0xd6, 0xfa, 0xb8, 0xda, 0xfb, 0xd6, 0x5a, 0xb7, 0xb6, 0x9a, 0x37, 0xd7, 0xec, 0x4e, 0xfb, 0xcd,
+	0xf5, 0xb2, 0x81, 0xce, 0xc1, 0xe9, 0xb1, 0xb5, 0x6f, 0xae, 0x6f, 0xb6, 0xdf, 0x5e, 0x2b, 0x67,
+	0x9a, 0xd7, 0x3f, 0x7a, 0xb2, 0x68, 0x3c, 0x7e, 0xb2, 0x68, 0xfc, 0xed, 0xc9, 0xa2, 0xf1, 0xde,
+	0xd3, 0xc5, 0xa9, 0xc7, 0x4f, 0x17, 0xa7, 0xfe, 0xfc, 0x74, 0x71, 0xea, 0xdb, 0xaf, 0x3f, 0x33,
+	0x4e, 0x93, 0xe2, 0x2e, 0x23, 0xb6, 0x9b, 0x97, 0x8f, 0x9a, 0x2f, 0xff, 0x67, 0x00, 0x75, 0x73,
+	0xbf, 0x5d, 0x97, 0x1a, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1403,671 +1501,697 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10616 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x7b, 0x70, 0x24, 0xc7,
-		0x79, 0x18, 0x8e, 0x7d, 0xef, 0x7e, 0x8b, 0xdd, 0x1d, 0x34, 0xc0, 0x3b, 0xdc, 0x1e, 0x09, 0x80,
-		0xc3, 0xc7, 0x3d, 0x48, 0xe2, 0xc8, 0x23, 0xef, 0x48, 0xe2, 0x44, 0xd1, 0xbb, 0x8b, 0xbd, 0x3b,
-		0x1c, 0xf1, 0xd2, 0x2c, 0x70, 0x7c, 0xd8, 0xd2, 0x68, 0xb0, 0xdb, 0x00, 0x86, 0xb7, 0x3b, 0x33,
-		0x9a, 0x99, 0xbd, 0x03, 0x58, 0xbf, 0xfa, 0x15, 0x6d, 0xd9, 0x8e, 0x7c, 0x71, 0x64, 0xd9, 0x4a,
-		0xd9, 0xb2, 0xa4, 0x53, 0x28, 0x2b, 0xd6, 0xc3, 0x79, 0xc9, 0x96, 0x22, 0xc9, 0x71, 0xd9, 0x25,
-		0xa7, 0xf2, 0x90, 0x9d, 0x54, 0x4a, 0x72, 0xa5, 0x12, 0x57, 0x2a, 0x61, 0x1c, 0x4a, 0x65, 0x29,
-		0xb2, 0x1c, 0xdb, 0x32, 0x9d, 0xc4, 0x51, 0xa5, 0x92, 0xea, 0xd7, 0x3c, 0xf6, 0x81, 0x59, 0x9c,
-		0x48, 0xda, 0x29, 0xfd, 0x73, 0xb7, 0xdd, 0xfd, 0x7d, 0x5f, 0x77, 0x7f, 0xfd, 0xf5, 0xf7, 0xea,
-		0x9e, 0x06, 0xfc, 0xf9, 0x05, 0x98, 0xdb, 0x31, 0xcd, 0x9d, 0x36, 0x3e, 0x63, 0xd9, 0xa6, 0x6b,
-		0x6e, 0x75, 0xb7, 0xcf, 0xb4, 0xb0, 0xd3, 0xb4, 0x75, 0xcb, 0x35, 0xed, 0x79, 0x5a, 0x87, 0x4a,
-		0x0c, 0x62, 0x5e, 0x40, 0xc8, 0x2b, 0x30, 0x71, 0x51, 0x6f, 0xe3, 0x45, 0x0f, 0xb0, 0x81, 0x5d,
-		0xf4, 0x04, 0x24, 0xb7, 0xf5, 0x36, 0x9e, 0x8e, 0xcd, 0x25, 0x4e, 0xe6, 0xcf, 0xde, 0x3b, 0xdf,
-		0x83, 0x34, 0x1f, 0xc6, 0x58, 0x27, 0xd5, 0x0a, 0xc5, 0x90, 0xbf, 0x91, 0x84, 0xc9, 0x01, 0xad,
-		0x08, 0x41, 0xd2, 0xd0, 0x3a, 0x84, 0x62, 0xec, 0x64, 0x4e, 0xa1, 0xbf, 0xd1, 0x34, 0x64, 0x2c,
-		0xad, 0x79, 0x4d, 0xdb, 0xc1, 0xd3, 0x71, 0x5a, 0x2d, 0x8a, 0x68, 0x06, 0xa0, 0x85, 0x2d, 0x6c,
-		0xb4, 0xb0, 0xd1, 0xdc, 0x9f, 0x4e, 0xcc, 0x25, 0x4e, 0xe6, 0x94, 0x40, 0x0d, 0x7a, 0x00, 0x26,
-		0xac, 0xee, 0x56, 0x5b, 0x6f, 0xaa, 0x01, 0x30, 0x98, 0x4b, 0x9c, 0x4c, 0x29, 0x12, 0x6b, 0x58,
-		0xf4, 0x81, 0x4f, 0x40, 0xe9, 0x06, 0xd6, 0xae, 0x05, 0x41, 0xf3, 0x14, 0xb4, 0x48, 0xaa, 0x03,
-		0x80, 0x35, 0x18, 0xef, 0x60, 0xc7, 0xd1, 0x76, 0xb0, 0xea, 0xee, 0x5b, 0x78, 0x3a, 0x49, 0x67,
-		0x3f, 0xd7, 0x37, 0xfb, 0xde, 0x99, 0xe7, 0x39, 0xd6, 0xc6, 0xbe, 0x85, 0x51, 0x05, 0x72, 0xd8,
-		0xe8, 0x76, 0x18, 0x85, 0xd4, 0x10, 0xfe, 0xd5, 0x8d, 0x6e, 0xa7, 0x97, 0x4a, 0x96, 0xa0, 0x71,
-		0x12, 0x19, 0x07, 0xdb, 0xd7, 0xf5, 0x26, 0x9e, 0x4e, 0x53, 0x02, 0x27, 0xfa, 0x08, 0x34, 0x58,
-		0x7b, 0x2f, 0x0d, 0x81, 0x87, 0x6a, 0x90, 0xc3, 0x7b, 0x2e, 0x36, 0x1c, 0xdd, 0x34, 0xa6, 0x33,
-		0x94, 0xc8, 0x7d, 0x03, 0x56, 0x11, 0xb7, 0x5b, 0xbd, 0x24, 0x7c, 0x3c, 0x74, 0x1e, 0x32, 0xa6,
-		0xe5, 0xea, 0xa6, 0xe1, 0x4c, 0x67, 0xe7, 0x62, 0x27, 0xf3, 0x67, 0xef, 0x1c, 0x28, 0x08, 0x6b,
-		0x0c, 0x46, 0x11, 0xc0, 0x68, 0x09, 0x24, 0xc7, 0xec, 0xda, 0x4d, 0xac, 0x36, 0xcd, 0x16, 0x56,
-		0x75, 0x63, 0xdb, 0x9c, 0xce, 0x51, 0x02, 0xb3, 0xfd, 0x13, 0xa1, 0x80, 0x35, 0xb3, 0x85, 0x97,
-		0x8c, 0x6d, 0x53, 0x29, 0x3a, 0xa1, 0x32, 0x3a, 0x02, 0x69, 0x67, 0xdf, 0x70, 0xb5, 0xbd, 0xe9,
-		0x71, 0x2a, 0x21, 0xbc, 0x24, 0xff, 0x7a, 0x1a, 0x4a, 0xa3, 0x88, 0xd8, 0x05, 0x48, 0x6d, 0x93,
-		0x59, 0x4e, 0xc7, 0x0f, 0xc3, 0x03, 0x86, 0x13, 0x66, 0x62, 0xfa, 0x36, 0x99, 0x58, 0x81, 0xbc,
-		0x81, 0x1d, 0x17, 0xb7, 0x98, 0x44, 0x24, 0x46, 0x94, 0x29, 0x60, 0x48, 0xfd, 0x22, 0x95, 0xbc,
-		0x2d, 0x91, 0x7a, 0x0e, 0x4a, 0xde, 0x90, 0x54, 0x5b, 0x33, 0x76, 0x84, 0x6c, 0x9e, 0x89, 0x1a,
-		0xc9, 0x7c, 0x5d, 0xe0, 0x29, 0x04, 0x4d, 0x29, 0xe2, 0x50, 0x19, 0x2d, 0x02, 0x98, 0x06, 0x36,
-		0xb7, 0xd5, 0x16, 0x6e, 0xb6, 0xa7, 0xb3, 0x43, 0xb8, 0xb4, 0x46, 0x40, 0xfa, 0xb8, 0x64, 0xb2,
-		0xda, 0x66, 0x1b, 0x3d, 0xe9, 0x8b, 0x5a, 0x66, 0x88, 0xa4, 0xac, 0xb0, 0x4d, 0xd6, 0x27, 0x6d,
-		0x9b, 0x50, 0xb4, 0x31, 0x91, 0x7b, 0xdc, 0xe2, 0x33, 0xcb, 0xd1, 0x41, 0xcc, 0x47, 0xce, 0x4c,
-		0xe1, 0x68, 0x6c, 0x62, 0x05, 0x3b, 0x58, 0x44, 0xf7, 0x80, 0x57, 0xa1, 0x52, 0xb1, 0x02, 0xaa,
-		0x85, 0xc6, 0x45, 0xe5, 0xaa, 0xd6, 0xc1, 0xe5, 0x97, 0xa0, 0x18, 0x66, 0x0f, 0x9a, 0x82, 0x94,
-		0xe3, 0x6a, 0xb6, 0x4b, 0xa5, 0x30, 0xa5, 0xb0, 0x02, 0x92, 0x20, 0x81, 0x8d, 0x16, 0xd5, 0x72,
-		0x29, 0x85, 0xfc, 0x44, 0x3f, 0xe4, 0x4f, 0x38, 0x41, 0x27, 0x7c, 0x7f, 0xff, 0x8a, 0x86, 0x28,
-		0xf7, 0xce, 0xbb, 0xfc, 0x38, 0x14, 0x42, 0x13, 0x18, 0xb5, 0x6b, 0xf9, 0xff, 0x83, 0x3b, 0x06,
-		0x92, 0x46, 0xcf, 0xc1, 0x54, 0xd7, 0xd0, 0x0d, 0x17, 0xdb, 0x96, 0x8d, 0x89, 0xc4, 0xb2, 0xae,
-		0xa6, 0xbf, 0x99, 0x19, 0x22, 0x73, 0x9b, 0x41, 0x68, 0x46, 0x45, 0x99, 0xec, 0xf6, 0x57, 0x9e,
-		0xce, 0x65, 0xbf, 0x95, 0x91, 0x5e, 0x7e, 0xf9, 0xe5, 0x97, 0xe3, 0xf2, 0x6f, 0xa7, 0x61, 0x6a,
-		0xd0, 0x9e, 0x19, 0xb8, 0x7d, 0x8f, 0x40, 0xda, 0xe8, 0x76, 0xb6, 0xb0, 0x4d, 0x99, 0x94, 0x52,
-		0x78, 0x09, 0x55, 0x20, 0xd5, 0xd6, 0xb6, 0x70, 0x7b, 0x3a, 0x39, 0x17, 0x3b, 0x59, 0x3c, 0xfb,
-		0xc0, 0x48, 0xbb, 0x72, 0x7e, 0x99, 0xa0, 0x28, 0x0c, 0x13, 0xbd, 0x1d, 0x92, 0x5c, 0x45, 0x13,
-		0x0a, 0xa7, 0x47, 0xa3, 0x40, 0xf6, 0x92, 0x42, 0xf1, 0xd0, 0x71, 0xc8, 0x91, 0xff, 0x99, 0x6c,
-		0xa4, 0xe9, 0x98, 0xb3, 0xa4, 0x82, 0xc8, 0x05, 0x2a, 0x43, 0x96, 0x6e, 0x93, 0x16, 0x16, 0xa6,
-		0xcd, 0x2b, 0x13, 0xc1, 0x6a, 0xe1, 0x6d, 0xad, 0xdb, 0x76, 0xd5, 0xeb, 0x5a, 0xbb, 0x8b, 0xa9,
-		0xc0, 0xe7, 0x94, 0x71, 0x5e, 0x79, 0x95, 0xd4, 0xa1, 0x59, 0xc8, 0xb3, 0x5d, 0xa5, 0x1b, 0x2d,
-		0xbc, 0x47, 0xb5, 0x67, 0x4a, 0x61, 0x1b, 0x6d, 0x89, 0xd4, 0x90, 0xee, 0x5f, 0x74, 0x4c, 0x43,
-		0x88, 0x26, 0xed, 0x82, 0x54, 0xd0, 0xee, 0x1f, 0xef, 0x55, 0xdc, 0x77, 0x0d, 0x9e, 0x5e, 0xdf,
-		0x5e, 0x3a, 0x01, 0x25, 0x0a, 0xf1, 0x28, 0x5f, 0x7a, 0xad, 0x3d, 0x3d, 0x31, 0x17, 0x3b, 0x99,
-		0x55, 0x8a, 0xac, 0x7a, 0x8d, 0xd7, 0xca, 0x5f, 0x88, 0x43, 0x92, 0x2a, 0x96, 0x12, 0xe4, 0x37,
-		0x9e, 0x5f, 0xaf, 0xab, 0x8b, 0x6b, 0x9b, 0xd5, 0xe5, 0xba, 0x14, 0x43, 0x45, 0x00, 0x5a, 0x71,
-		0x71, 0x79, 0xad, 0xb2, 0x21, 0xc5, 0xbd, 0xf2, 0xd2, 0xea, 0xc6, 0xf9, 0xc7, 0xa4, 0x84, 0x87,
-		0xb0, 0xc9, 0x2a, 0x92, 0x41, 0x80, 0x47, 0xcf, 0x4a, 0x29, 0x24, 0xc1, 0x38, 0x23, 0xb0, 0xf4,
-		0x5c, 0x7d, 0xf1, 0xfc, 0x63, 0x52, 0x3a, 0x5c, 0xf3, 0xe8, 0x59, 0x29, 0x83, 0x0a, 0x90, 0xa3,
-		0x35, 0xd5, 0xb5, 0xb5, 0x65, 0x29, 0xeb, 0xd1, 0x6c, 0x6c, 0x28, 0x4b, 0xab, 0x97, 0xa4, 0x9c,
-		0x47, 0xf3, 0x92, 0xb2, 0xb6, 0xb9, 0x2e, 0x81, 0x47, 0x61, 0xa5, 0xde, 0x68, 0x54, 0x2e, 0xd5,
-		0xa5, 0xbc, 0x07, 0x51, 0x7d, 0x7e, 0xa3, 0xde, 0x90, 0xc6, 0x43, 0xc3, 0x7a, 0xf4, 0xac, 0x54,
-		0xf0, 0xba, 0xa8, 0xaf, 0x6e, 0xae, 0x48, 0x45, 0x34, 0x01, 0x05, 0xd6, 0x85, 0x18, 0x44, 0xa9,
-		0xa7, 0xea, 0xfc, 0x63, 0x92, 0xe4, 0x0f, 0x84, 0x51, 0x99, 0x08, 0x55, 0x9c, 0x7f, 0x4c, 0x42,
-		0x72, 0x0d, 0x52, 0x54, 0x0c, 0x11, 0x82, 0xe2, 0x72, 0xa5, 0x5a, 0x5f, 0x56, 0xd7, 0xd6, 0x37,
-		0x96, 0xd6, 0x56, 0x2b, 0xcb, 0x52, 0xcc, 0xaf, 0x53, 0xea, 0xef, 0xd8, 0x5c, 0x52, 0xea, 0x8b,
-		0x52, 0x3c, 0x58, 0xb7, 0x5e, 0xaf, 0x6c, 0xd4, 0x17, 0xa5, 0x84, 0xdc, 0x84, 0xa9, 0x41, 0x0a,
-		0x75, 0xe0, 0x16, 0x0a, 0xc8, 0x42, 0x7c, 0x88, 0x2c, 0x50, 0x5a, 0xbd, 0xb2, 0x20, 0x7f, 0x3d,
-		0x0e, 0x93, 0x03, 0x8c, 0xca, 0xc0, 0x4e, 0x9e, 0x86, 0x14, 0x93, 0x65, 0x66, 0x66, 0x4f, 0x0d,
-		0xb4, 0x4e, 0x54, 0xb2, 0xfb, 0x4c, 0x2d, 0xc5, 0x0b, 0xba, 0x1a, 0x89, 0x21, 0xae, 0x06, 0x21,
-		0xd1, 0x27, 0xb0, 0xef, 0xec, 0x53, 0xfe, 0xcc, 0x3e, 0x9e, 0x1f, 0xc5, 0x3e, 0xd2, 0xba, 0xc3,
-		0x19, 0x81, 0xd4, 0x00, 0x23, 0x70, 0x01, 0x26, 0xfa, 0x08, 0x8d, 0xac, 0x8c, 0xdf, 0x1b, 0x83,
-		0xe9, 0x61, 0xcc, 0x89, 0x50, 0x89, 0xf1, 0x90, 0x4a, 0xbc, 0xd0, 0xcb, 0xc1, 0xbb, 0x87, 0x2f,
-		0x42, 0xdf, 0x5a, 0x7f, 0x2a, 0x06, 0x47, 0x06, 0xbb, 0x94, 0x03, 0xc7, 0xf0, 0x76, 0x48, 0x77,
-		0xb0, 0xbb, 0x6b, 0x0a, 0xb7, 0xea, 0xfe, 0x01, 0xc6, 0x9a, 0x34, 0xf7, 0x2e, 0x36, 0xc7, 0x0a,
-		0x5a, 0xfb, 0xc4, 0x30, 0xbf, 0x90, 0x8d, 0xa6, 0x6f, 0xa4, 0x3f, 0x15, 0x87, 0x3b, 0x06, 0x12,
-		0x1f, 0x38, 0xd0, 0xbb, 0x00, 0x74, 0xc3, 0xea, 0xba, 0xcc, 0x75, 0x62, 0x9a, 0x38, 0x47, 0x6b,
-		0xa8, 0xf2, 0x22, 0x5a, 0xb6, 0xeb, 0x7a, 0xed, 0x09, 0xda, 0x0e, 0xac, 0x8a, 0x02, 0x3c, 0xe1,
-		0x0f, 0x34, 0x49, 0x07, 0x3a, 0x33, 0x64, 0xa6, 0x7d, 0x82, 0xf9, 0x30, 0x48, 0xcd, 0xb6, 0x8e,
-		0x0d, 0x57, 0x75, 0x5c, 0x1b, 0x6b, 0x1d, 0xdd, 0xd8, 0xa1, 0xa6, 0x26, 0xbb, 0x90, 0xda, 0xd6,
-		0xda, 0x0e, 0x56, 0x4a, 0xac, 0xb9, 0x21, 0x5a, 0x09, 0x06, 0x15, 0x20, 0x3b, 0x80, 0x91, 0x0e,
-		0x61, 0xb0, 0x66, 0x0f, 0x43, 0xfe, 0xd9, 0x1c, 0xe4, 0x03, 0x0e, 0x38, 0xba, 0x1b, 0xc6, 0x5f,
-		0xd4, 0xae, 0x6b, 0xaa, 0x08, 0xaa, 0x18, 0x27, 0xf2, 0xa4, 0x6e, 0x9d, 0x07, 0x56, 0x0f, 0xc3,
-		0x14, 0x05, 0x31, 0xbb, 0x2e, 0xb6, 0xd5, 0x66, 0x5b, 0x73, 0x1c, 0xca, 0xb4, 0x2c, 0x05, 0x45,
-		0xa4, 0x6d, 0x8d, 0x34, 0xd5, 0x44, 0x0b, 0x3a, 0x07, 0x93, 0x14, 0xa3, 0xd3, 0x6d, 0xbb, 0xba,
-		0xd5, 0xc6, 0x2a, 0x09, 0xf3, 0x1c, 0x6a, 0x72, 0xbc, 0x91, 0x4d, 0x10, 0x88, 0x15, 0x0e, 0x40,
-		0x46, 0xe4, 0xa0, 0x45, 0xb8, 0x8b, 0xa2, 0xed, 0x60, 0x03, 0xdb, 0x9a, 0x8b, 0x55, 0xfc, 0x9e,
-		0xae, 0xd6, 0x76, 0x54, 0xcd, 0x68, 0xa9, 0xbb, 0x9a, 0xb3, 0x3b, 0x3d, 0x45, 0x08, 0x54, 0xe3,
-		0xd3, 0x31, 0xe5, 0x18, 0x01, 0xbc, 0xc4, 0xe1, 0xea, 0x14, 0xac, 0x62, 0xb4, 0x2e, 0x6b, 0xce,
-		0x2e, 0x5a, 0x80, 0x23, 0x94, 0x8a, 0xe3, 0xda, 0xba, 0xb1, 0xa3, 0x36, 0x77, 0x71, 0xf3, 0x9a,
-		0xda, 0x75, 0xb7, 0x9f, 0x98, 0x3e, 0x1e, 0xec, 0x9f, 0x8e, 0xb0, 0x41, 0x61, 0x6a, 0x04, 0x64,
-		0xd3, 0xdd, 0x7e, 0x02, 0x35, 0x60, 0x9c, 0x2c, 0x46, 0x47, 0x7f, 0x09, 0xab, 0xdb, 0xa6, 0x4d,
-		0x6d, 0x68, 0x71, 0x80, 0x6a, 0x0a, 0x70, 0x70, 0x7e, 0x8d, 0x23, 0xac, 0x98, 0x2d, 0xbc, 0x90,
-		0x6a, 0xac, 0xd7, 0xeb, 0x8b, 0x4a, 0x5e, 0x50, 0xb9, 0x68, 0xda, 0x44, 0xa0, 0x76, 0x4c, 0x8f,
-		0xc1, 0x79, 0x26, 0x50, 0x3b, 0xa6, 0x60, 0xef, 0x39, 0x98, 0x6c, 0x36, 0xd9, 0x9c, 0xf5, 0xa6,
-		0xca, 0x83, 0x31, 0x67, 0x5a, 0x0a, 0x31, 0xab, 0xd9, 0xbc, 0xc4, 0x00, 0xb8, 0x8c, 0x3b, 0xe8,
-		0x49, 0xb8, 0xc3, 0x67, 0x56, 0x10, 0x71, 0xa2, 0x6f, 0x96, 0xbd, 0xa8, 0xe7, 0x60, 0xd2, 0xda,
-		0xef, 0x47, 0x44, 0xa1, 0x1e, 0xad, 0xfd, 0x5e, 0xb4, 0xc7, 0x61, 0xca, 0xda, 0xb5, 0xfa, 0xf1,
-		0x4e, 0x07, 0xf1, 0x90, 0xb5, 0x6b, 0xf5, 0x22, 0xde, 0x47, 0x23, 0x73, 0x1b, 0x37, 0x35, 0x17,
-		0xb7, 0xa6, 0x8f, 0x06, 0xc1, 0x03, 0x0d, 0x68, 0x1e, 0xa4, 0x66, 0x53, 0xc5, 0x86, 0xb6, 0xd5,
-		0xc6, 0xaa, 0x66, 0x63, 0x43, 0x73, 0xa6, 0x67, 0x29, 0x70, 0xd2, 0xb5, 0xbb, 0x58, 0x29, 0x36,
-		0x9b, 0x75, 0xda, 0x58, 0xa1, 0x6d, 0xe8, 0x34, 0x4c, 0x98, 0x5b, 0x2f, 0x36, 0x99, 0x44, 0xaa,
-		0x96, 0x8d, 0xb7, 0xf5, 0xbd, 0xe9, 0x7b, 0x29, 0x7b, 0x4b, 0xa4, 0x81, 0xca, 0xe3, 0x3a, 0xad,
-		0x46, 0xa7, 0x40, 0x6a, 0x3a, 0xbb, 0x9a, 0x6d, 0x51, 0x95, 0xec, 0x58, 0x5a, 0x13, 0x4f, 0xdf,
-		0xc7, 0x40, 0x59, 0xfd, 0xaa, 0xa8, 0x26, 0x3b, 0xc2, 0xb9, 0xa1, 0x6f, 0xbb, 0x82, 0xe2, 0x09,
-		0xb6, 0x23, 0x68, 0x1d, 0xa7, 0x76, 0x12, 0x24, 0xc2, 0x89, 0x50, 0xc7, 0x27, 0x29, 0x58, 0xd1,
-		0xda, 0xb5, 0x82, 0xfd, 0xde, 0x03, 0x05, 0x02, 0xe9, 0x77, 0x7a, 0x8a, 0x39, 0x6e, 0xd6, 0x6e,
-		0xa0, 0xc7, 0xc7, 0xe0, 0x08, 0x01, 0xea, 0x60, 0x57, 0x6b, 0x69, 0xae, 0x16, 0x80, 0x7e, 0x90,
-		0x42, 0x13, 0xb6, 0xaf, 0xf0, 0xc6, 0xd0, 0x38, 0xed, 0xee, 0xd6, 0xbe, 0x27, 0x58, 0x0f, 0xb1,
-		0x71, 0x92, 0x3a, 0x21, 0x5a, 0x6f, 0x9a, 0x73, 0x2e, 0x2f, 0xc0, 0x78, 0x50, 0xee, 0x51, 0x0e,
-		0x98, 0xe4, 0x4b, 0x31, 0xe2, 0x04, 0xd5, 0xd6, 0x16, 0x89, 0xfb, 0xf2, 0x42, 0x5d, 0x8a, 0x13,
-		0x37, 0x6a, 0x79, 0x69, 0xa3, 0xae, 0x2a, 0x9b, 0xab, 0x1b, 0x4b, 0x2b, 0x75, 0x29, 0x11, 0x70,
-		0xec, 0xaf, 0x24, 0xb3, 0xf7, 0x4b, 0x27, 0x88, 0xd7, 0x50, 0x0c, 0x47, 0x6a, 0xe8, 0x6d, 0x70,
-		0x54, 0xa4, 0x55, 0x1c, 0xec, 0xaa, 0x37, 0x74, 0x9b, 0x6e, 0xc8, 0x8e, 0xc6, 0x8c, 0xa3, 0x27,
-		0x3f, 0x53, 0x1c, 0xaa, 0x81, 0xdd, 0x67, 0x75, 0x9b, 0x6c, 0xb7, 0x8e, 0xe6, 0xa2, 0x65, 0x98,
-		0x35, 0x4c, 0xd5, 0x71, 0x35, 0xa3, 0xa5, 0xd9, 0x2d, 0xd5, 0x4f, 0x68, 0xa9, 0x5a, 0xb3, 0x89,
-		0x1d, 0xc7, 0x64, 0x86, 0xd0, 0xa3, 0x72, 0xa7, 0x61, 0x36, 0x38, 0xb0, 0x6f, 0x21, 0x2a, 0x1c,
-		0xb4, 0x47, 0x7c, 0x13, 0xc3, 0xc4, 0xf7, 0x38, 0xe4, 0x3a, 0x9a, 0xa5, 0x62, 0xc3, 0xb5, 0xf7,
-		0xa9, 0x7f, 0x9e, 0x55, 0xb2, 0x1d, 0xcd, 0xaa, 0x93, 0xf2, 0x5b, 0x12, 0x26, 0x5d, 0x49, 0x66,
-		0x93, 0x52, 0xea, 0x4a, 0x32, 0x9b, 0x92, 0xd2, 0x57, 0x92, 0xd9, 0xb4, 0x94, 0xb9, 0x92, 0xcc,
-		0x66, 0xa5, 0xdc, 0x95, 0x64, 0x36, 0x27, 0x81, 0xfc, 0x73, 0x49, 0x18, 0x0f, 0x7a, 0xf0, 0x24,
-		0x20, 0x6a, 0x52, 0x1b, 0x16, 0xa3, 0x5a, 0xee, 0x9e, 0x03, 0xfd, 0xfd, 0xf9, 0x1a, 0x31, 0x6e,
-		0x0b, 0x69, 0xe6, 0x2e, 0x2b, 0x0c, 0x93, 0x38, 0x16, 0x44, 0xfc, 0x30, 0x73, 0x4f, 0xb2, 0x0a,
-		0x2f, 0xa1, 0x4b, 0x90, 0x7e, 0xd1, 0xa1, 0xb4, 0xd3, 0x94, 0xf6, 0xbd, 0x07, 0xd3, 0xbe, 0xd2,
-		0xa0, 0xc4, 0x73, 0x57, 0x1a, 0xea, 0xea, 0x9a, 0xb2, 0x52, 0x59, 0x56, 0x38, 0x3a, 0x3a, 0x06,
-		0xc9, 0xb6, 0xf6, 0xd2, 0x7e, 0xd8, 0x0c, 0xd2, 0x2a, 0x34, 0x0f, 0xa5, 0xae, 0x71, 0x1d, 0xdb,
-		0xfa, 0xb6, 0x8e, 0x5b, 0x2a, 0x85, 0x2a, 0x05, 0xa1, 0x8a, 0x7e, 0xeb, 0x32, 0x81, 0x1f, 0x71,
-		0x19, 0x8f, 0x41, 0xf2, 0x06, 0xd6, 0xae, 0x85, 0x8d, 0x15, 0xad, 0x7a, 0x13, 0xb7, 0xd3, 0x19,
-		0x48, 0x51, 0xfe, 0x22, 0x00, 0xce, 0x61, 0x69, 0x0c, 0x65, 0x21, 0x59, 0x5b, 0x53, 0xc8, 0x96,
-		0x92, 0x60, 0x9c, 0xd5, 0xaa, 0xeb, 0x4b, 0xf5, 0x5a, 0x5d, 0x8a, 0xcb, 0xe7, 0x20, 0xcd, 0x98,
-		0x46, 0xb6, 0x9b, 0xc7, 0x36, 0x69, 0x8c, 0x17, 0x39, 0x8d, 0x98, 0x68, 0xdd, 0x5c, 0xa9, 0xd6,
-		0x15, 0x29, 0xde, 0x27, 0x2c, 0xb2, 0x03, 0xe3, 0x41, 0x4f, 0xfe, 0xad, 0x09, 0xe7, 0xbf, 0x1c,
-		0x83, 0x7c, 0xc0, 0x33, 0x27, 0x2e, 0x95, 0xd6, 0x6e, 0x9b, 0x37, 0x54, 0xad, 0xad, 0x6b, 0x0e,
-		0x17, 0x25, 0xa0, 0x55, 0x15, 0x52, 0x33, 0xea, 0xd2, 0xbd, 0x45, 0x9b, 0x2c, 0x25, 0xa5, 0xe5,
-		0x8f, 0xc5, 0x40, 0xea, 0x75, 0x8d, 0x7b, 0x86, 0x19, 0xfb, 0xab, 0x1c, 0xa6, 0xfc, 0xd1, 0x18,
-		0x14, 0xc3, 0xfe, 0x70, 0xcf, 0xf0, 0xee, 0xfe, 0x2b, 0x1d, 0xde, 0x1f, 0xc4, 0xa1, 0x10, 0xf2,
-		0x82, 0x47, 0x1d, 0xdd, 0x7b, 0x60, 0x42, 0x6f, 0xe1, 0x8e, 0x65, 0xba, 0xd8, 0x68, 0xee, 0xab,
-		0x6d, 0x7c, 0x1d, 0xb7, 0xa7, 0x65, 0xaa, 0x64, 0xce, 0x1c, 0xec, 0x67, 0xcf, 0x2f, 0xf9, 0x78,
-		0xcb, 0x04, 0x6d, 0x61, 0x72, 0x69, 0xb1, 0xbe, 0xb2, 0xbe, 0xb6, 0x51, 0x5f, 0xad, 0x3d, 0xaf,
-		0x6e, 0xae, 0x3e, 0xb3, 0xba, 0xf6, 0xec, 0xaa, 0x22, 0xe9, 0x3d, 0x60, 0x6f, 0xe2, 0xb6, 0x5f,
-		0x07, 0xa9, 0x77, 0x50, 0xe8, 0x28, 0x0c, 0x1a, 0x96, 0x34, 0x86, 0x26, 0xa1, 0xb4, 0xba, 0xa6,
-		0x36, 0x96, 0x16, 0xeb, 0x6a, 0xfd, 0xe2, 0xc5, 0x7a, 0x6d, 0xa3, 0xc1, 0x32, 0x27, 0x1e, 0xf4,
-		0x46, 0x68, 0x83, 0xcb, 0x1f, 0x4e, 0xc0, 0xe4, 0x80, 0x91, 0xa0, 0x0a, 0x8f, 0x79, 0x58, 0x18,
-		0xf6, 0xd0, 0x28, 0xa3, 0x9f, 0x27, 0x5e, 0xc7, 0xba, 0x66, 0xbb, 0x3c, 0x44, 0x3a, 0x05, 0x84,
-		0x4b, 0x86, 0x4b, 0x94, 0xab, 0xcd, 0x33, 0x52, 0x2c, 0x10, 0x2a, 0xf9, 0xf5, 0x2c, 0x29, 0xf5,
-		0x20, 0x20, 0xcb, 0x74, 0x74, 0x57, 0xbf, 0x8e, 0x55, 0xdd, 0x10, 0xe9, 0x2b, 0x12, 0x18, 0x25,
-		0x15, 0x49, 0xb4, 0x2c, 0x19, 0xae, 0x07, 0x6d, 0xe0, 0x1d, 0xad, 0x07, 0x9a, 0x28, 0xff, 0x84,
-		0x22, 0x89, 0x16, 0x0f, 0xfa, 0x6e, 0x18, 0x6f, 0x99, 0x5d, 0xe2, 0x2d, 0x32, 0x38, 0x62, 0x6b,
-		0x62, 0x4a, 0x9e, 0xd5, 0x79, 0x20, 0x3c, 0x0e, 0xf0, 0xf3, 0x66, 0xe3, 0x4a, 0x9e, 0xd5, 0x31,
-		0x90, 0x13, 0x50, 0xd2, 0x76, 0x76, 0x6c, 0x42, 0x5c, 0x10, 0x62, 0x91, 0x4d, 0xd1, 0xab, 0xa6,
-		0x80, 0xe5, 0x2b, 0x90, 0x15, 0x7c, 0x20, 0xc6, 0x9e, 0x70, 0x42, 0xb5, 0x58, 0xb8, 0x1e, 0x3f,
-		0x99, 0x53, 0xb2, 0x86, 0x68, 0xbc, 0x1b, 0xc6, 0x75, 0x47, 0xf5, 0x8f, 0x01, 0xe2, 0x73, 0xf1,
-		0x93, 0x59, 0x25, 0xaf, 0x3b, 0x5e, 0x0a, 0x55, 0xfe, 0x54, 0x1c, 0x8a, 0xe1, 0x63, 0x0c, 0xb4,
-		0x08, 0xd9, 0xb6, 0xd9, 0xd4, 0xa8, 0x68, 0xb1, 0x33, 0xb4, 0x93, 0x11, 0x27, 0x1f, 0xf3, 0xcb,
-		0x1c, 0x5e, 0xf1, 0x30, 0xcb, 0xff, 0x26, 0x06, 0x59, 0x51, 0x8d, 0x8e, 0x40, 0xd2, 0xd2, 0xdc,
-		0x5d, 0x4a, 0x2e, 0x55, 0x8d, 0x4b, 0x31, 0x85, 0x96, 0x49, 0xbd, 0x63, 0x69, 0x06, 0x15, 0x01,
-		0x5e, 0x4f, 0xca, 0x64, 0x5d, 0xdb, 0x58, 0x6b, 0xd1, 0xb0, 0xc9, 0xec, 0x74, 0xb0, 0xe1, 0x3a,
-		0x62, 0x5d, 0x79, 0x7d, 0x8d, 0x57, 0xa3, 0x07, 0x60, 0xc2, 0xb5, 0x35, 0xbd, 0x1d, 0x82, 0x4d,
-		0x52, 0x58, 0x49, 0x34, 0x78, 0xc0, 0x0b, 0x70, 0x4c, 0xd0, 0x6d, 0x61, 0x57, 0x6b, 0xee, 0xe2,
-		0x96, 0x8f, 0x94, 0xa6, 0xe9, 0x91, 0xa3, 0x1c, 0x60, 0x91, 0xb7, 0x0b, 0x5c, 0xf9, 0x6b, 0x31,
-		0x98, 0x10, 0x81, 0x5e, 0xcb, 0x63, 0xd6, 0x0a, 0x80, 0x66, 0x18, 0xa6, 0x1b, 0x64, 0x57, 0xbf,
-		0x28, 0xf7, 0xe1, 0xcd, 0x57, 0x3c, 0x24, 0x25, 0x40, 0xa0, 0xdc, 0x01, 0xf0, 0x5b, 0x86, 0xb2,
-		0x6d, 0x16, 0xf2, 0xfc, 0x8c, 0x8a, 0x1e, 0x74, 0xb2, 0xd4, 0x00, 0xb0, 0x2a, 0x12, 0x11, 0xa2,
-		0x29, 0x48, 0x6d, 0xe1, 0x1d, 0xdd, 0xe0, 0x99, 0x67, 0x56, 0x10, 0x09, 0x9c, 0xa4, 0x97, 0xc0,
-		0xa9, 0xfe, 0xff, 0x30, 0xd9, 0x34, 0x3b, 0xbd, 0xc3, 0xad, 0x4a, 0x3d, 0xe9, 0x09, 0xe7, 0x72,
-		0xec, 0x85, 0x87, 0x38, 0xd0, 0x8e, 0xd9, 0xd6, 0x8c, 0x9d, 0x79, 0xd3, 0xde, 0xf1, 0x0f, 0x6a,
-		0x89, 0x87, 0xe4, 0x04, 0x8e, 0x6b, 0xad, 0xad, 0xff, 0x19, 0x8b, 0xfd, 0x52, 0x3c, 0x71, 0x69,
-		0xbd, 0xfa, 0x2b, 0xf1, 0xf2, 0x25, 0x86, 0xb8, 0x2e, 0x98, 0xa1, 0xe0, 0xed, 0x36, 0x6e, 0x92,
-		0x09, 0xc2, 0x1f, 0x3f, 0x00, 0x53, 0x3b, 0xe6, 0x8e, 0x49, 0x29, 0x9d, 0x21, 0xbf, 0xf8, 0x49,
-		0x6f, 0xce, 0xab, 0x2d, 0x47, 0x1e, 0x0b, 0x2f, 0xac, 0xc2, 0x24, 0x07, 0x56, 0xe9, 0x51, 0x13,
-		0x0b, 0x84, 0xd0, 0x81, 0x59, 0xb8, 0xe9, 0x5f, 0xfd, 0x06, 0x35, 0xdf, 0xca, 0x04, 0x47, 0x25,
-		0x6d, 0x2c, 0x56, 0x5a, 0x50, 0xe0, 0x8e, 0x10, 0x3d, 0xb6, 0x49, 0xb1, 0x1d, 0x41, 0xf1, 0x9f,
-		0x73, 0x8a, 0x93, 0x01, 0x8a, 0x0d, 0x8e, 0xba, 0x50, 0x83, 0xc2, 0x61, 0x68, 0xfd, 0x0b, 0x4e,
-		0x6b, 0x1c, 0x07, 0x89, 0x5c, 0x82, 0x12, 0x25, 0xd2, 0xec, 0x3a, 0xae, 0xd9, 0xa1, 0x1a, 0xf0,
-		0x60, 0x32, 0xff, 0xf2, 0x1b, 0x6c, 0xd7, 0x14, 0x09, 0x5a, 0xcd, 0xc3, 0x5a, 0x58, 0x00, 0x7a,
-		0xba, 0xd6, 0xc2, 0xcd, 0x76, 0x04, 0x85, 0xaf, 0xf0, 0x81, 0x78, 0xf0, 0x0b, 0x57, 0x61, 0x8a,
-		0xfc, 0xa6, 0x0a, 0x2a, 0x38, 0x92, 0xe8, 0x94, 0xdd, 0xf4, 0xd7, 0xde, 0xcb, 0x36, 0xe6, 0xa4,
-		0x47, 0x20, 0x30, 0xa6, 0xc0, 0x2a, 0xee, 0x60, 0xd7, 0xc5, 0xb6, 0xa3, 0x6a, 0xed, 0x41, 0xc3,
-		0x0b, 0xe4, 0x3c, 0xa6, 0x7f, 0xf1, 0x3b, 0xe1, 0x55, 0xbc, 0xc4, 0x30, 0x2b, 0xed, 0xf6, 0xc2,
-		0x26, 0x1c, 0x1d, 0x20, 0x15, 0x23, 0xd0, 0xfc, 0x30, 0xa7, 0x39, 0xd5, 0x27, 0x19, 0x84, 0xec,
-		0x3a, 0x88, 0x7a, 0x6f, 0x2d, 0x47, 0xa0, 0xf9, 0x11, 0x4e, 0x13, 0x71, 0x5c, 0xb1, 0xa4, 0x84,
-		0xe2, 0x15, 0x98, 0xb8, 0x8e, 0xed, 0x2d, 0xd3, 0xe1, 0x79, 0xa6, 0x11, 0xc8, 0x7d, 0x94, 0x93,
-		0x2b, 0x71, 0x44, 0x9a, 0x78, 0x22, 0xb4, 0x9e, 0x84, 0xec, 0xb6, 0xd6, 0xc4, 0x23, 0x90, 0xb8,
-		0xc5, 0x49, 0x64, 0x08, 0x3c, 0x41, 0xad, 0xc0, 0xf8, 0x8e, 0xc9, 0x6d, 0x54, 0x34, 0xfa, 0xc7,
-		0x38, 0x7a, 0x5e, 0xe0, 0x70, 0x12, 0x96, 0x69, 0x75, 0xdb, 0xc4, 0x80, 0x45, 0x93, 0xf8, 0x3b,
-		0x82, 0x84, 0xc0, 0xe1, 0x24, 0x0e, 0xc1, 0xd6, 0x57, 0x04, 0x09, 0x27, 0xc0, 0xcf, 0xa7, 0x21,
-		0x6f, 0x1a, 0xed, 0x7d, 0xd3, 0x18, 0x65, 0x10, 0x1f, 0xe7, 0x14, 0x80, 0xa3, 0x10, 0x02, 0x17,
-		0x20, 0x37, 0xea, 0x42, 0xfc, 0xf2, 0x77, 0xc4, 0xf6, 0x10, 0x2b, 0x70, 0x09, 0x4a, 0x42, 0x41,
-		0xe9, 0xa6, 0x31, 0x02, 0x89, 0x4f, 0x72, 0x12, 0xc5, 0x00, 0x1a, 0x9f, 0x86, 0x8b, 0x1d, 0x77,
-		0x07, 0x8f, 0x42, 0xe4, 0x53, 0x62, 0x1a, 0x1c, 0x85, 0xb3, 0x72, 0x0b, 0x1b, 0xcd, 0xdd, 0xd1,
-		0x28, 0x7c, 0x5a, 0xb0, 0x52, 0xe0, 0x10, 0x12, 0x35, 0x28, 0x74, 0x34, 0xdb, 0xd9, 0xd5, 0xda,
-		0x23, 0x2d, 0xc7, 0x67, 0x38, 0x8d, 0x71, 0x0f, 0x89, 0x73, 0xa4, 0x6b, 0x1c, 0x86, 0xcc, 0xaf,
-		0x08, 0x8e, 0x04, 0xd0, 0xf8, 0xd6, 0x73, 0x5c, 0x9a, 0x94, 0x3b, 0x0c, 0xb5, 0xbf, 0x27, 0xb6,
-		0x1e, 0xc3, 0x5d, 0x09, 0x52, 0xbc, 0x00, 0x39, 0x47, 0x7f, 0x69, 0x24, 0x32, 0x7f, 0x5f, 0xac,
-		0x34, 0x45, 0x20, 0xc8, 0xcf, 0xc3, 0xb1, 0x81, 0x66, 0x62, 0x04, 0x62, 0xff, 0x80, 0x13, 0x3b,
-		0x32, 0xc0, 0x54, 0x70, 0x95, 0x70, 0x58, 0x92, 0xff, 0x50, 0xa8, 0x04, 0xdc, 0x43, 0x6b, 0x9d,
-		0x44, 0x0d, 0x8e, 0xb6, 0x7d, 0x38, 0xae, 0xfd, 0x23, 0xc1, 0x35, 0x86, 0x1b, 0xe2, 0xda, 0x06,
-		0x1c, 0xe1, 0x14, 0x0f, 0xb7, 0xae, 0x9f, 0x15, 0x8a, 0x95, 0x61, 0x6f, 0x86, 0x57, 0xf7, 0x87,
-		0xa1, 0xec, 0xb1, 0x53, 0xb8, 0xa7, 0x8e, 0xda, 0xd1, 0xac, 0x11, 0x28, 0xff, 0x2a, 0xa7, 0x2c,
-		0x34, 0xbe, 0xe7, 0xdf, 0x3a, 0x2b, 0x9a, 0x45, 0x88, 0x3f, 0x07, 0xd3, 0x82, 0x78, 0xd7, 0xb0,
-		0x71, 0xd3, 0xdc, 0x31, 0xf4, 0x97, 0x70, 0x6b, 0x04, 0xd2, 0xbf, 0xd6, 0xb3, 0x54, 0x9b, 0x01,
-		0x74, 0x42, 0x79, 0x09, 0x24, 0xcf, 0x57, 0x51, 0xf5, 0x8e, 0x65, 0xda, 0x6e, 0x04, 0xc5, 0xcf,
-		0x89, 0x95, 0xf2, 0xf0, 0x96, 0x28, 0xda, 0x42, 0x1d, 0xd8, 0x49, 0xf5, 0xa8, 0x22, 0xf9, 0x79,
-		0x4e, 0xa8, 0xe0, 0x63, 0x71, 0xc5, 0xd1, 0x34, 0x3b, 0x96, 0x66, 0x8f, 0xa2, 0xff, 0xfe, 0xb1,
-		0x50, 0x1c, 0x1c, 0x85, 0x2b, 0x0e, 0xe2, 0xd1, 0x11, 0x6b, 0x3f, 0x02, 0x85, 0x2f, 0x08, 0xc5,
-		0x21, 0x70, 0x38, 0x09, 0xe1, 0x30, 0x8c, 0x40, 0xe2, 0x8b, 0x82, 0x84, 0xc0, 0x21, 0x24, 0xde,
-		0xe1, 0x1b, 0x5a, 0x1b, 0xef, 0xe8, 0x8e, 0x6b, 0x33, 0xa7, 0xf8, 0x60, 0x52, 0x5f, 0xfa, 0x4e,
-		0xd8, 0x09, 0x53, 0x02, 0xa8, 0x44, 0x13, 0xf1, 0x34, 0x2d, 0x8d, 0x99, 0xa2, 0x07, 0xf6, 0xeb,
-		0x42, 0x13, 0x05, 0xd0, 0xc8, 0xd8, 0x02, 0x1e, 0x22, 0x61, 0x7b, 0x93, 0x44, 0x0a, 0x23, 0x90,
-		0xfb, 0x27, 0x3d, 0x83, 0x6b, 0x08, 0x5c, 0x42, 0x33, 0xe0, 0xff, 0x74, 0x8d, 0x6b, 0x78, 0x7f,
-		0x24, 0xe9, 0xfc, 0x8d, 0x1e, 0xff, 0x67, 0x93, 0x61, 0x32, 0x1d, 0x52, 0xea, 0xf1, 0xa7, 0x50,
-		0xd4, 0xbd, 0xa4, 0xe9, 0x1f, 0x7d, 0x9d, 0xcf, 0x37, 0xec, 0x4e, 0x2d, 0x2c, 0x13, 0x21, 0x0f,
-		0x3b, 0x3d, 0xd1, 0xc4, 0xde, 0xfb, 0xba, 0x27, 0xe7, 0x21, 0x9f, 0x67, 0xe1, 0x22, 0x14, 0x42,
-		0x0e, 0x4f, 0x34, 0xa9, 0x1f, 0xe7, 0xa4, 0xc6, 0x83, 0xfe, 0xce, 0xc2, 0x39, 0x48, 0x12, 0xe7,
-		0x25, 0x1a, 0xfd, 0x27, 0x38, 0x3a, 0x05, 0x5f, 0x78, 0x0a, 0xb2, 0xc2, 0x69, 0x89, 0x46, 0xfd,
-		0x49, 0x8e, 0xea, 0xa1, 0x10, 0x74, 0xe1, 0xb0, 0x44, 0xa3, 0xff, 0x0d, 0x81, 0x2e, 0x50, 0x08,
-		0xfa, 0xe8, 0x2c, 0xfc, 0xf2, 0xdf, 0x4c, 0x72, 0xa3, 0x23, 0x78, 0x77, 0x01, 0x32, 0xdc, 0x53,
-		0x89, 0xc6, 0xfe, 0x29, 0xde, 0xb9, 0xc0, 0x58, 0x78, 0x1c, 0x52, 0x23, 0x32, 0xfc, 0x6f, 0x71,
-		0x54, 0x06, 0xbf, 0x50, 0x83, 0x7c, 0xc0, 0x3b, 0x89, 0x46, 0x7f, 0x3f, 0x47, 0x0f, 0x62, 0x91,
-		0xa1, 0x73, 0xef, 0x24, 0x9a, 0xc0, 0xcf, 0x88, 0xa1, 0x73, 0x0c, 0xc2, 0x36, 0xe1, 0x98, 0x44,
-		0x63, 0x7f, 0x40, 0x70, 0x5d, 0xa0, 0x2c, 0x3c, 0x0d, 0x39, 0xcf, 0xd8, 0x44, 0xe3, 0xff, 0x2c,
-		0xc7, 0xf7, 0x71, 0x08, 0x07, 0x02, 0xc6, 0x2e, 0x9a, 0xc4, 0xcf, 0x09, 0x0e, 0x04, 0xb0, 0xc8,
-		0x36, 0xea, 0x75, 0x60, 0xa2, 0x29, 0x7d, 0x50, 0x6c, 0xa3, 0x1e, 0xff, 0x85, 0xac, 0x26, 0xd5,
-		0xf9, 0xd1, 0x24, 0xfe, 0xb6, 0x58, 0x4d, 0x0a, 0x4f, 0x86, 0xd1, 0xeb, 0x11, 0x44, 0xd3, 0xf8,
-		0x05, 0x31, 0x8c, 0x1e, 0x87, 0x60, 0x61, 0x1d, 0x50, 0xbf, 0x37, 0x10, 0x4d, 0xef, 0x43, 0x9c,
-		0xde, 0x44, 0x9f, 0x33, 0xb0, 0xf0, 0x2c, 0x1c, 0x19, 0xec, 0x09, 0x44, 0x53, 0xfd, 0xc5, 0xd7,
-		0x7b, 0x62, 0xb7, 0xa0, 0x23, 0xb0, 0xb0, 0xe1, 0x9b, 0x94, 0xa0, 0x17, 0x10, 0x4d, 0xf6, 0xc3,
-		0xaf, 0x87, 0x15, 0x77, 0xd0, 0x09, 0x58, 0xa8, 0x00, 0xf8, 0x06, 0x38, 0x9a, 0xd6, 0x47, 0x39,
-		0xad, 0x00, 0x12, 0xd9, 0x1a, 0xdc, 0xfe, 0x46, 0xe3, 0xdf, 0x12, 0x5b, 0x83, 0x63, 0x90, 0xad,
-		0x21, 0x4c, 0x6f, 0x34, 0xf6, 0xc7, 0xc4, 0xd6, 0x10, 0x28, 0x44, 0xb2, 0x03, 0xd6, 0x2d, 0x9a,
-		0xc2, 0xc7, 0x85, 0x64, 0x07, 0xb0, 0x16, 0x56, 0x61, 0xa2, 0xcf, 0x20, 0x46, 0x93, 0xfa, 0x25,
-		0x4e, 0x4a, 0xea, 0xb5, 0x87, 0x41, 0xe3, 0xc5, 0x8d, 0x61, 0x34, 0xb5, 0x4f, 0xf4, 0x18, 0x2f,
-		0x6e, 0x0b, 0x17, 0x2e, 0x40, 0xd6, 0xe8, 0xb6, 0xdb, 0x64, 0xf3, 0xa0, 0x83, 0xef, 0x12, 0x4e,
-		0xff, 0xd7, 0xef, 0x71, 0xee, 0x08, 0x84, 0x85, 0x73, 0x90, 0xc2, 0x9d, 0x2d, 0xdc, 0x8a, 0xc2,
-		0xfc, 0xf6, 0xf7, 0x84, 0xc2, 0x24, 0xd0, 0x0b, 0x4f, 0x03, 0xb0, 0xd4, 0x08, 0x3d, 0x3c, 0x8c,
-		0xc0, 0xfd, 0xa3, 0xef, 0xf1, 0xcb, 0x3b, 0x3e, 0x8a, 0x4f, 0x80, 0x5d, 0x05, 0x3a, 0x98, 0xc0,
-		0x77, 0xc2, 0x04, 0xe8, 0x8a, 0x3c, 0x09, 0x99, 0x17, 0x1d, 0xd3, 0x70, 0xb5, 0x9d, 0x28, 0xec,
-		0x3f, 0xe6, 0xd8, 0x02, 0x9e, 0x30, 0xac, 0x63, 0xda, 0xd8, 0xd5, 0x76, 0x9c, 0x28, 0xdc, 0xff,
-		0xc6, 0x71, 0x3d, 0x04, 0x82, 0xdc, 0xd4, 0x1c, 0x77, 0x94, 0x79, 0xff, 0x89, 0x40, 0x16, 0x08,
-		0x64, 0xd0, 0xe4, 0xf7, 0x35, 0xbc, 0x1f, 0x85, 0xfb, 0xa7, 0x62, 0xd0, 0x1c, 0x7e, 0xe1, 0x29,
-		0xc8, 0x91, 0x9f, 0xec, 0x46, 0x5e, 0x04, 0xf2, 0x9f, 0x71, 0x64, 0x1f, 0x83, 0xf4, 0xec, 0xb8,
-		0x2d, 0x57, 0x8f, 0x66, 0xf6, 0x77, 0xf9, 0x4a, 0x0b, 0xf8, 0x85, 0x0a, 0xe4, 0x1d, 0xb7, 0xd5,
-		0xea, 0x72, 0xff, 0x34, 0x02, 0xfd, 0xcf, 0xbf, 0xe7, 0xa5, 0x2c, 0x3c, 0x1c, 0xb2, 0xda, 0x37,
-		0xae, 0xb9, 0x96, 0x49, 0x0f, 0x3c, 0xa2, 0x28, 0xbc, 0xce, 0x29, 0x04, 0x50, 0x16, 0x6a, 0x30,
-		0x4e, 0xe6, 0x62, 0x63, 0x0b, 0xd3, 0xd3, 0xa9, 0x08, 0x12, 0x7f, 0xc1, 0x19, 0x10, 0x42, 0xaa,
-		0xbe, 0xfb, 0x2b, 0xaf, 0xcd, 0xc4, 0xbe, 0xfa, 0xda, 0x4c, 0xec, 0x0f, 0x5e, 0x9b, 0x89, 0x7d,
-		0xe0, 0xeb, 0x33, 0x63, 0x5f, 0xfd, 0xfa, 0xcc, 0xd8, 0xef, 0x7f, 0x7d, 0x66, 0x6c, 0x70, 0x96,
-		0x18, 0x2e, 0x99, 0x97, 0x4c, 0x96, 0x1f, 0x7e, 0xe1, 0xbe, 0x1d, 0xdd, 0xdd, 0xed, 0x6e, 0xcd,
-		0x37, 0xcd, 0xce, 0x99, 0xa6, 0xe9, 0x74, 0x4c, 0xe7, 0x4c, 0x38, 0xaf, 0x4b, 0x7f, 0xc1, 0xff,
-		0x8e, 0x91, 0x98, 0x39, 0x9c, 0xce, 0xd5, 0x8c, 0xfd, 0x61, 0x9f, 0xf7, 0x9c, 0x87, 0x44, 0xc5,
-		0xd8, 0x47, 0xc7, 0x98, 0x82, 0x53, 0xbb, 0x76, 0x9b, 0x5f, 0x0b, 0xcb, 0x90, 0xf2, 0xa6, 0xdd,
-		0x46, 0x53, 0xfe, 0xdd, 0xcd, 0xd8, 0xc9, 0x71, 0x7e, 0x21, 0xb3, 0xfa, 0xfe, 0xd8, 0xe1, 0x66,
-		0x92, 0xad, 0x18, 0xfb, 0x74, 0x22, 0xeb, 0xb1, 0x17, 0x1e, 0x8c, 0xcc, 0x73, 0x5f, 0x33, 0xcc,
-		0x1b, 0x06, 0x19, 0xb6, 0xb5, 0x25, 0x72, 0xdc, 0x33, 0xbd, 0x39, 0xee, 0x67, 0x71, 0xbb, 0xfd,
-		0x0c, 0x81, 0xdb, 0x20, 0x28, 0x5b, 0x69, 0x76, 0x03, 0x19, 0x3e, 0x18, 0x87, 0x99, 0xbe, 0x74,
-		0x36, 0x17, 0x82, 0x61, 0x4c, 0x58, 0x80, 0xec, 0xa2, 0x90, 0xad, 0x69, 0xc8, 0x38, 0xb8, 0x69,
-		0x1a, 0x2d, 0x87, 0x32, 0x22, 0xa1, 0x88, 0x22, 0x61, 0x84, 0xa1, 0x19, 0xa6, 0xc3, 0x2f, 0x56,
-		0xb2, 0x42, 0xf5, 0x23, 0x87, 0x64, 0x44, 0x41, 0xf4, 0x24, 0xb8, 0xf1, 0xc8, 0x88, 0xdc, 0x10,
-		0x93, 0x08, 0x65, 0xfe, 0x47, 0xe5, 0xca, 0x2f, 0xc4, 0x61, 0xb6, 0x97, 0x2b, 0x64, 0x67, 0x39,
-		0xae, 0xd6, 0xb1, 0x86, 0xb1, 0xe5, 0x02, 0xe4, 0x36, 0x04, 0xcc, 0xa1, 0xf9, 0x72, 0xeb, 0x90,
-		0x7c, 0x29, 0x7a, 0x5d, 0x09, 0xc6, 0x9c, 0x1d, 0x91, 0x31, 0xde, 0x3c, 0x6e, 0x8b, 0x33, 0x7f,
-		0x99, 0x86, 0x63, 0x6c, 0x3b, 0xa9, 0x6c, 0x2b, 0xb1, 0x02, 0xe7, 0xc9, 0x78, 0xb0, 0x29, 0xfa,
-		0x9c, 0x44, 0x7e, 0x06, 0x26, 0x97, 0x88, 0xb6, 0x20, 0x51, 0x90, 0x7f, 0xc2, 0x33, 0xf0, 0xee,
-		0xe9, 0x5c, 0xc8, 0xe1, 0xe7, 0x27, 0x4c, 0xc1, 0x2a, 0xf9, 0x47, 0x63, 0x20, 0x35, 0x9a, 0x5a,
-		0x5b, 0xb3, 0xbf, 0x5f, 0x52, 0xe8, 0x71, 0x00, 0xfa, 0xcd, 0x92, 0xff, 0x91, 0x51, 0xf1, 0xec,
-		0xf4, 0x7c, 0x70, 0x72, 0xf3, 0xac, 0x27, 0xfa, 0x05, 0x43, 0x8e, 0xc2, 0x92, 0x9f, 0xa7, 0x9f,
-		0x03, 0xf0, 0x1b, 0xd0, 0x71, 0x38, 0xda, 0xa8, 0x55, 0x96, 0x2b, 0x8a, 0xca, 0x2e, 0xc3, 0xaf,
-		0x36, 0xd6, 0xeb, 0xb5, 0xa5, 0x8b, 0x4b, 0xf5, 0x45, 0x69, 0x0c, 0x1d, 0x01, 0x14, 0x6c, 0xf4,
-		0xee, 0xa5, 0xdc, 0x01, 0x13, 0xc1, 0x7a, 0x76, 0xa3, 0x3e, 0x4e, 0x3c, 0x45, 0xbd, 0x63, 0xb5,
-		0x31, 0x3d, 0xfa, 0x53, 0x75, 0xc1, 0xb5, 0x68, 0x27, 0xe4, 0x77, 0xfe, 0x1d, 0xbb, 0x65, 0x3d,
-		0xe9, 0xa3, 0x7b, 0x3c, 0x5f, 0x58, 0x86, 0x09, 0xad, 0xd9, 0xc4, 0x56, 0x88, 0x64, 0x84, 0xaa,
-		0x26, 0x04, 0xe9, 0x61, 0x26, 0xc7, 0xf4, 0xa9, 0x3d, 0x0e, 0x69, 0x87, 0xce, 0x3e, 0x8a, 0xc4,
-		0xef, 0x72, 0x12, 0x1c, 0x7c, 0xc1, 0x80, 0x09, 0xe2, 0xf9, 0x69, 0x36, 0x0e, 0x0c, 0xe3, 0xe0,
-		0x3c, 0xc3, 0x6f, 0x7e, 0xee, 0x61, 0x7a, 0xb4, 0x79, 0x77, 0x78, 0x59, 0x06, 0x88, 0x93, 0x22,
-		0x71, 0xda, 0xfe, 0x40, 0x31, 0x14, 0x45, 0x7f, 0x7c, 0xc0, 0x07, 0x77, 0xf6, 0x5b, 0xbc, 0xb3,
-		0x99, 0x41, 0x32, 0x10, 0xe8, 0xa9, 0xc0, 0xa9, 0xb2, 0x86, 0x6a, 0x7d, 0xd8, 0x9e, 0x7e, 0xe1,
-		0x81, 0x7e, 0xeb, 0xc4, 0xfe, 0x7b, 0x88, 0x52, 0xbe, 0x10, 0xec, 0xc6, 0xdb, 0x7b, 0x1f, 0x49,
-		0xc2, 0x84, 0xd6, 0xd1, 0x0d, 0xf3, 0x0c, 0xfd, 0x97, 0xef, 0xb9, 0x14, 0x2d, 0x8c, 0x70, 0x28,
-		0x79, 0x9e, 0x6d, 0x85, 0x68, 0x89, 0xf9, 0xb3, 0x9f, 0xfe, 0x64, 0xca, 0xdf, 0x2e, 0x0b, 0x2b,
-		0x20, 0x89, 0x0b, 0x87, 0xd8, 0x68, 0x9a, 0xad, 0x91, 0xb2, 0x14, 0xdf, 0x15, 0x34, 0x44, 0x7e,
-		0xab, 0xce, 0x51, 0x17, 0xde, 0x06, 0x59, 0x8f, 0x4c, 0x94, 0x67, 0x22, 0x88, 0x78, 0x18, 0xc4,
-		0x2f, 0x61, 0x3b, 0x73, 0x14, 0x2f, 0xf4, 0x75, 0x81, 0xcf, 0x76, 0xe8, 0x2a, 0x99, 0xcd, 0x25,
-		0x28, 0xb6, 0x4c, 0xc3, 0x55, 0xcd, 0x8e, 0xee, 0xe2, 0x8e, 0xe5, 0x46, 0xfa, 0x75, 0x7f, 0xc1,
-		0x88, 0x64, 0x95, 0x02, 0xc1, 0x5b, 0x13, 0x68, 0x64, 0x24, 0xec, 0x9b, 0xa2, 0x51, 0x46, 0xf2,
-		0xdf, 0xbd, 0x91, 0x50, 0x1c, 0x32, 0x92, 0xdb, 0x92, 0x0e, 0xa7, 0x75, 0x8d, 0x2b, 0x73, 0x77,
-		0x8f, 0x49, 0x81, 0x27, 0x1d, 0x7f, 0x92, 0x80, 0x19, 0x0e, 0xbc, 0xa5, 0x39, 0xf8, 0xcc, 0xf5,
-		0x47, 0xb6, 0xb0, 0xab, 0x3d, 0x72, 0xa6, 0x69, 0xea, 0xc2, 0x92, 0x4f, 0x72, 0x65, 0x4d, 0xda,
-		0xe7, 0x79, 0x7b, 0x79, 0xe0, 0x71, 0x77, 0x79, 0xb8, 0x92, 0x2f, 0xf7, 0xcb, 0xa0, 0xfc, 0x02,
-		0x24, 0x6b, 0xa6, 0x6e, 0x10, 0xdb, 0xd6, 0xc2, 0x86, 0xd9, 0xe1, 0xea, 0x96, 0x15, 0xd0, 0x79,
-		0x48, 0x6b, 0x1d, 0xb3, 0x6b, 0xb8, 0x4c, 0xd5, 0x56, 0x67, 0xbe, 0xf2, 0xea, 0xec, 0xd8, 0x7f,
-		0x78, 0x75, 0x36, 0xb1, 0x64, 0xb8, 0xbf, 0xf7, 0xf9, 0x87, 0x80, 0x53, 0x5f, 0x32, 0xdc, 0x4f,
-		0x7f, 0xf3, 0xb3, 0xa7, 0x63, 0x0a, 0x87, 0x5e, 0x48, 0x7e, 0xeb, 0x95, 0xd9, 0x98, 0xfc, 0x1c,
-		0x64, 0x16, 0x71, 0xf3, 0x00, 0xf2, 0x8f, 0xf4, 0x90, 0x3f, 0x26, 0xc8, 0x2f, 0xe2, 0x66, 0x80,
-		0xfc, 0x22, 0x6e, 0xf6, 0x50, 0x7e, 0x1c, 0xb2, 0x4b, 0x86, 0xcb, 0x3e, 0x77, 0x78, 0x00, 0x12,
-		0xba, 0xc1, 0x6e, 0xd0, 0x06, 0x28, 0xf4, 0x0d, 0x50, 0x21, 0x50, 0x04, 0x71, 0x11, 0x37, 0x3d,
-		0xc4, 0x16, 0x6e, 0xf6, 0x22, 0xf6, 0x77, 0x4d, 0xa0, 0xaa, 0x8b, 0xbf, 0xff, 0x5f, 0x66, 0xc6,
-		0x5e, 0x7e, 0x6d, 0x66, 0x6c, 0xe8, 0xd2, 0xcb, 0xd1, 0x4b, 0xef, 0xad, 0xf8, 0x27, 0x93, 0x70,
-		0x17, 0xfd, 0x0a, 0xce, 0xee, 0xe8, 0x86, 0x7b, 0xa6, 0x69, 0xef, 0x5b, 0xae, 0x49, 0x14, 0x80,
-		0xb9, 0xcd, 0x17, 0x7c, 0xc2, 0x6f, 0x9e, 0x67, 0xcd, 0x83, 0x97, 0x5b, 0xde, 0x86, 0xd4, 0x3a,
-		0xc1, 0x23, 0x2c, 0x76, 0x4d, 0x57, 0x6b, 0x73, 0xaf, 0x85, 0x15, 0x48, 0x2d, 0xfb, 0x72, 0x2e,
-		0xce, 0x6a, 0x75, 0xf1, 0xd1, 0x5c, 0x1b, 0x6b, 0xdb, 0xec, 0x03, 0x84, 0x04, 0x75, 0x77, 0xb3,
-		0xa4, 0x82, 0x7e, 0x6b, 0x30, 0x05, 0x29, 0xad, 0xcb, 0x6e, 0xbe, 0x24, 0x88, 0x1f, 0x4c, 0x0b,
-		0xf2, 0x33, 0x90, 0xe1, 0xe7, 0xef, 0x48, 0x82, 0xc4, 0x35, 0xbc, 0x4f, 0xfb, 0x19, 0x57, 0xc8,
-		0x4f, 0x34, 0x0f, 0x29, 0x3a, 0x78, 0xfe, 0x65, 0xd5, 0xf4, 0x7c, 0xdf, 0xe8, 0xe7, 0xe9, 0x20,
-		0x15, 0x06, 0x26, 0x5f, 0x81, 0xec, 0xa2, 0x49, 0xa4, 0x30, 0x4c, 0x2d, 0xc7, 0xa8, 0xd1, 0x31,
-		0x5b, 0x5d, 0x2e, 0x15, 0x0a, 0x2b, 0xa0, 0x23, 0x90, 0x66, 0x1f, 0xa4, 0xf0, 0xdb, 0x3b, 0xbc,
-		0x24, 0xd7, 0x20, 0x43, 0x69, 0xaf, 0x59, 0xc4, 0x65, 0xf0, 0xee, 0xfe, 0xe6, 0xf8, 0xe7, 0x89,
-		0x9c, 0x7c, 0xdc, 0x1f, 0x2c, 0x82, 0x64, 0x4b, 0x73, 0x35, 0x3e, 0x6f, 0xfa, 0x5b, 0x7e, 0x3b,
-		0x64, 0x39, 0x11, 0x07, 0x9d, 0x85, 0x84, 0x69, 0x39, 0xfc, 0xfe, 0x4d, 0x79, 0xd8, 0x54, 0xd6,
-		0xac, 0x6a, 0x92, 0xc8, 0x8c, 0x42, 0x80, 0xab, 0xab, 0x43, 0xc5, 0xe2, 0xb1, 0x90, 0x58, 0x74,
-		0xb0, 0xbb, 0xb5, 0xed, 0xfa, 0x3f, 0xd8, 0x72, 0xf6, 0x89, 0x82, 0x27, 0x28, 0xb7, 0xe2, 0x30,
-		0x13, 0x68, 0xbd, 0x8e, 0x6d, 0x47, 0x37, 0x0d, 0x26, 0x4d, 0x5c, 0x52, 0x50, 0x60, 0x80, 0xbc,
-		0x7d, 0x88, 0xa8, 0x3c, 0x05, 0x89, 0x8a, 0x65, 0xa1, 0x32, 0x64, 0x69, 0xb9, 0x69, 0x32, 0x59,
-		0x49, 0x2a, 0x5e, 0x99, 0xb4, 0x39, 0xe6, 0xb6, 0x7b, 0x43, 0xb3, 0xbd, 0xef, 0x35, 0x45, 0x59,
-		0x7e, 0x12, 0x72, 0x35, 0xd3, 0x70, 0xb0, 0xe1, 0x74, 0xa9, 0x2f, 0xbc, 0xd5, 0x36, 0x9b, 0xd7,
-		0x38, 0x05, 0x56, 0x20, 0xcc, 0xd6, 0x2c, 0x8b, 0x62, 0x26, 0x15, 0xf2, 0x93, 0xed, 0xd7, 0xea,
-		0xda, 0x50, 0xf6, 0x9c, 0x3b, 0x1c, 0x7b, 0xf8, 0x04, 0x7d, 0xa7, 0x36, 0x06, 0x77, 0xf6, 0x6f,
-		0xa4, 0x6b, 0x78, 0xdf, 0x39, 0xec, 0x3e, 0x7a, 0x0e, 0x72, 0xeb, 0xf4, 0xc1, 0x84, 0x67, 0xf0,
-		0x3e, 0x2a, 0x43, 0x06, 0xb7, 0xce, 0x9e, 0x3b, 0xf7, 0xc8, 0x93, 0x4c, 0xca, 0x2f, 0x8f, 0x29,
-		0xa2, 0x02, 0xcd, 0x40, 0xce, 0xc1, 0x4d, 0xeb, 0xec, 0xb9, 0xf3, 0xd7, 0x1e, 0x61, 0x62, 0x75,
-		0x79, 0x4c, 0xf1, 0xab, 0x16, 0xb2, 0x64, 0xc6, 0xdf, 0xfa, 0xf8, 0x6c, 0xac, 0x9a, 0x82, 0x84,
-		0xd3, 0xed, 0xbc, 0x69, 0xb2, 0xf1, 0xf3, 0x29, 0x98, 0x0b, 0xb4, 0x32, 0xe3, 0x72, 0x5d, 0x6b,
-		0xeb, 0x2d, 0xcd, 0x7f, 0xe6, 0x42, 0x0a, 0xcc, 0x9f, 0x42, 0x0c, 0xb1, 0x1a, 0x07, 0x72, 0x51,
-		0xfe, 0xb5, 0x18, 0x8c, 0x5f, 0x15, 0x94, 0x1b, 0xd8, 0x45, 0x17, 0x00, 0xbc, 0x9e, 0xc4, 0x56,
-		0x39, 0x3e, 0xdf, 0xdb, 0xd7, 0xbc, 0x87, 0xa3, 0x04, 0xc0, 0xd1, 0xe3, 0x54, 0x00, 0x2d, 0xd3,
-		0xe1, 0xdf, 0xee, 0x45, 0xa0, 0x7a, 0xc0, 0xe8, 0x41, 0x40, 0x54, 0xab, 0xa9, 0xd7, 0x4d, 0x57,
-		0x37, 0x76, 0x54, 0xcb, 0xbc, 0xc1, 0xbf, 0x88, 0x4e, 0x28, 0x12, 0x6d, 0xb9, 0x4a, 0x1b, 0xd6,
-		0x49, 0x3d, 0x19, 0x74, 0xce, 0xa3, 0x42, 0xc2, 0x3a, 0xad, 0xd5, 0xb2, 0xb1, 0xe3, 0x70, 0xc5,
-		0x25, 0x8a, 0xe8, 0x02, 0x64, 0xac, 0xee, 0x96, 0x2a, 0xb4, 0x44, 0xfe, 0xec, 0x9d, 0x83, 0xf6,
-		0xbc, 0x90, 0x0d, 0xbe, 0xeb, 0xd3, 0x56, 0x77, 0x8b, 0x48, 0xca, 0xdd, 0x30, 0x3e, 0x60, 0x30,
-		0xf9, 0xeb, 0xfe, 0x38, 0xe8, 0x1b, 0x1d, 0x7c, 0x06, 0xaa, 0x65, 0xeb, 0xa6, 0xad, 0xbb, 0xfb,
-		0xf4, 0xe2, 0x5c, 0x42, 0x91, 0x44, 0xc3, 0x3a, 0xaf, 0x97, 0xaf, 0x41, 0xa9, 0x41, 0xdd, 0x7d,
-		0x7f, 0xe4, 0xe7, 0xfc, 0xf1, 0xc5, 0xa2, 0xc7, 0x37, 0x74, 0x64, 0xf1, 0xbe, 0x91, 0x55, 0x57,
-		0x86, 0x4a, 0xe6, 0xa3, 0x87, 0x93, 0xcc, 0xb0, 0x75, 0xfb, 0xa3, 0x63, 0xa1, 0x4d, 0xc9, 0xbd,
-		0x9e, 0x80, 0xca, 0x1a, 0x55, 0x28, 0xa3, 0x22, 0xf9, 0xf2, 0xc1, 0x46, 0xb4, 0x1c, 0xa1, 0x3a,
-		0xcb, 0x91, 0xdb, 0x47, 0x7e, 0x12, 0x0a, 0xeb, 0x9a, 0xed, 0x36, 0xb0, 0x7b, 0x19, 0x6b, 0x2d,
-		0x6c, 0x87, 0xad, 0x6c, 0x41, 0x58, 0x59, 0x04, 0x49, 0x6a, 0x4a, 0x99, 0x95, 0xa1, 0xbf, 0xe5,
-		0x5d, 0x48, 0xd2, 0x8b, 0xb3, 0x9e, 0x05, 0xe6, 0x18, 0xcc, 0x02, 0x13, 0xfd, 0xb9, 0xef, 0x62,
-		0x47, 0x24, 0x9b, 0x68, 0x01, 0x3d, 0x26, 0xec, 0x68, 0xe2, 0x60, 0x3b, 0xca, 0x85, 0x90, 0x5b,
-		0xd3, 0x36, 0x64, 0xaa, 0x44, 0xfd, 0x2e, 0x2d, 0x7a, 0x03, 0x89, 0xf9, 0x03, 0x41, 0x2b, 0x50,
-		0xb2, 0x34, 0xdb, 0xa5, 0xdf, 0x1c, 0xed, 0xd2, 0x59, 0x70, 0x39, 0x9f, 0xed, 0xdf, 0x75, 0xa1,
-		0xc9, 0xf2, 0x5e, 0x0a, 0x56, 0xb0, 0x52, 0xfe, 0xc3, 0x24, 0xa4, 0x39, 0x33, 0x9e, 0x82, 0x0c,
-		0x67, 0x2b, 0x97, 0xcc, 0xbb, 0xe6, 0xfb, 0x8d, 0xd1, 0xbc, 0x67, 0x34, 0x38, 0x3d, 0x81, 0x83,
-		0xee, 0x87, 0x6c, 0x73, 0x57, 0xd3, 0x0d, 0x55, 0x6f, 0x71, 0x07, 0x30, 0xff, 0xda, 0xab, 0xb3,
-		0x99, 0x1a, 0xa9, 0x5b, 0x5a, 0x54, 0x32, 0xb4, 0x71, 0xa9, 0x45, 0x2c, 0xff, 0x2e, 0xd6, 0x77,
-		0x76, 0x5d, 0xbe, 0xbb, 0x78, 0x09, 0x3d, 0x01, 0x49, 0x22, 0x10, 0xfc, 0x8b, 0xd4, 0x72, 0x9f,
-		0x1f, 0xef, 0x25, 0x5a, 0xaa, 0x59, 0xd2, 0xf1, 0x07, 0xfe, 0xf3, 0x6c, 0x4c, 0xa1, 0x18, 0xa8,
-		0x06, 0x85, 0xb6, 0xe6, 0xb8, 0x2a, 0xb5, 0x5a, 0xa4, 0xfb, 0x14, 0x25, 0x71, 0xac, 0x9f, 0x21,
-		0x9c, 0xb1, 0x7c, 0xe8, 0x79, 0x82, 0xc5, 0xaa, 0x5a, 0xe8, 0x24, 0x48, 0x94, 0x48, 0xd3, 0xec,
-		0x74, 0x74, 0x97, 0xf9, 0x52, 0x69, 0xca, 0xf7, 0x22, 0xa9, 0xaf, 0xd1, 0x6a, 0xea, 0x51, 0x1d,
-		0x87, 0x1c, 0xfd, 0x06, 0x8e, 0x82, 0xb0, 0xdb, 0xda, 0x59, 0x52, 0x41, 0x1b, 0x4f, 0x40, 0xc9,
-		0xd7, 0x8d, 0x0c, 0x24, 0xcb, 0xa8, 0xf8, 0xd5, 0x14, 0xf0, 0x61, 0x98, 0x32, 0xf0, 0x1e, 0xbd,
-		0x3f, 0x1e, 0x82, 0xce, 0x51, 0x68, 0x44, 0xda, 0xae, 0x86, 0x31, 0xee, 0x83, 0x62, 0x53, 0x30,
-		0x9f, 0xc1, 0x02, 0x85, 0x2d, 0x78, 0xb5, 0x14, 0xec, 0x18, 0x64, 0x35, 0xcb, 0x62, 0x00, 0x79,
-		0xae, 0x1b, 0x2d, 0x8b, 0x36, 0x9d, 0x86, 0x09, 0x3a, 0x47, 0x1b, 0x3b, 0xdd, 0xb6, 0xcb, 0x89,
-		0x8c, 0x53, 0x98, 0x12, 0x69, 0x50, 0x58, 0x3d, 0x85, 0xbd, 0x07, 0x0a, 0xf8, 0xba, 0xde, 0xc2,
-		0x46, 0x13, 0x33, 0xb8, 0x02, 0x85, 0x1b, 0x17, 0x95, 0x14, 0xe8, 0x14, 0x78, 0x3a, 0x4f, 0x15,
-		0xfa, 0xb8, 0xc8, 0xe8, 0x89, 0xfa, 0x0a, 0xab, 0x96, 0xa7, 0x21, 0xb9, 0xa8, 0xb9, 0x1a, 0x71,
-		0x2a, 0xdc, 0x3d, 0x66, 0x64, 0xc6, 0x15, 0xf2, 0x53, 0xfe, 0x56, 0x1c, 0x92, 0x57, 0x4d, 0x17,
-		0xa3, 0x47, 0x03, 0x0e, 0x5f, 0x71, 0x90, 0x3c, 0x37, 0xf4, 0x1d, 0x03, 0xb7, 0x56, 0x9c, 0x9d,
-		0xc0, 0x83, 0x15, 0xbe, 0x38, 0xc5, 0x43, 0xe2, 0x34, 0x05, 0x29, 0xdb, 0xec, 0x1a, 0x2d, 0x71,
-		0xd1, 0x99, 0x16, 0x50, 0x1d, 0xb2, 0x9e, 0x94, 0x24, 0xa3, 0xa4, 0xa4, 0x44, 0xa4, 0x84, 0xc8,
-		0x30, 0xaf, 0x50, 0x32, 0x5b, 0x5c, 0x58, 0xaa, 0x90, 0xf3, 0x94, 0x17, 0x97, 0xb6, 0xd1, 0x04,
-		0xd6, 0x47, 0x23, 0x86, 0xc4, 0x5b, 0x7b, 0x8f, 0x79, 0x4c, 0xe2, 0x24, 0xaf, 0x81, 0x73, 0x2f,
-		0x24, 0x56, 0xfc, 0xf1, 0x8c, 0x0c, 0x9d, 0x97, 0x2f, 0x56, 0xec, 0x01, 0x8d, 0x3b, 0x21, 0xe7,
-		0xe8, 0x3b, 0x86, 0xe6, 0x76, 0x6d, 0xcc, 0x25, 0xcf, 0xaf, 0x90, 0xbf, 0x1c, 0x83, 0x34, 0x93,
-		0xe4, 0x00, 0xdf, 0x62, 0x83, 0xf9, 0x16, 0x1f, 0xc6, 0xb7, 0xc4, 0xed, 0xf3, 0xad, 0x02, 0xe0,
-		0x0d, 0xc6, 0xe1, 0x6f, 0x1a, 0x0c, 0xf0, 0x16, 0xd8, 0x10, 0x1b, 0xfa, 0x0e, 0xdf, 0xa8, 0x01,
-		0x24, 0xf9, 0x3f, 0xc5, 0x88, 0xe3, 0xca, 0xdb, 0x51, 0x05, 0x0a, 0x62, 0x5c, 0xea, 0x76, 0x5b,
-		0xdb, 0xe1, 0xb2, 0x73, 0xd7, 0xd0, 0xc1, 0x5d, 0x6c, 0x6b, 0x3b, 0x4a, 0x9e, 0x8f, 0x87, 0x14,
-		0x06, 0xaf, 0x43, 0x7c, 0xc8, 0x3a, 0x84, 0x16, 0x3e, 0x71, 0x7b, 0x0b, 0x1f, 0x5a, 0xa2, 0x64,
-		0xef, 0x12, 0x7d, 0x2e, 0x4e, 0x83, 0x17, 0xcb, 0x74, 0xb4, 0xf6, 0x5b, 0xb1, 0x23, 0x8e, 0x43,
-		0xce, 0x32, 0xdb, 0x2a, 0x6b, 0x61, 0x1f, 0x00, 0x64, 0x2d, 0xb3, 0xad, 0xf4, 0x2d, 0x7b, 0xea,
-		0x0d, 0xda, 0x2e, 0xe9, 0x37, 0x80, 0x6b, 0x99, 0x5e, 0xae, 0xd9, 0x30, 0xce, 0x58, 0xc1, 0x6d,
-		0xd9, 0xc3, 0x84, 0x07, 0xd4, 0x38, 0xc6, 0xfa, 0x6d, 0x2f, 0x1b, 0x36, 0x83, 0x54, 0x38, 0x1c,
-		0xc1, 0x60, 0xaa, 0x7f, 0x50, 0xd4, 0x1b, 0x14, 0x4b, 0x85, 0xc3, 0xc9, 0x3f, 0x1f, 0x03, 0x58,
-		0x26, 0x9c, 0xa5, 0xf3, 0x25, 0x56, 0xc8, 0xa1, 0x43, 0x50, 0x43, 0x3d, 0xcf, 0x0c, 0x5b, 0x34,
-		0xde, 0xff, 0xb8, 0x13, 0x1c, 0x77, 0x0d, 0x0a, 0xbe, 0x30, 0x3a, 0x58, 0x0c, 0x66, 0xe6, 0x00,
-		0x8f, 0xba, 0x81, 0x5d, 0x65, 0xfc, 0x7a, 0xa0, 0x24, 0xff, 0xd3, 0x18, 0xe4, 0xe8, 0x98, 0x56,
-		0xb0, 0xab, 0x85, 0xd6, 0x30, 0x76, 0xfb, 0x6b, 0x78, 0x17, 0x00, 0x23, 0xe3, 0xe8, 0x2f, 0x61,
-		0x2e, 0x59, 0x39, 0x5a, 0xd3, 0xd0, 0x5f, 0xc2, 0xe8, 0xbc, 0xc7, 0xf0, 0xc4, 0xc1, 0x0c, 0x17,
-		0x1e, 0x37, 0x67, 0xfb, 0x51, 0xc8, 0xd0, 0x37, 0xc0, 0xf6, 0x1c, 0xee, 0x44, 0xa7, 0x8d, 0x6e,
-		0x67, 0x63, 0xcf, 0x91, 0x5f, 0x84, 0xcc, 0xc6, 0x1e, 0xcb, 0x85, 0x1c, 0x87, 0x9c, 0x6d, 0x9a,
-		0xdc, 0x26, 0x33, 0x5f, 0x28, 0x4b, 0x2a, 0xa8, 0x09, 0x12, 0xf1, 0x7f, 0xdc, 0x8f, 0xff, 0xfd,
-		0x04, 0x46, 0x62, 0xa4, 0x04, 0xc6, 0xe9, 0x7f, 0x1f, 0x83, 0x7c, 0x40, 0x3f, 0xa0, 0x47, 0xe0,
-		0x8e, 0xea, 0xf2, 0x5a, 0xed, 0x19, 0x75, 0x69, 0x51, 0xbd, 0xb8, 0x5c, 0xb9, 0xe4, 0x7f, 0xe2,
-		0x56, 0x3e, 0x72, 0xf3, 0xd6, 0x1c, 0x0a, 0xc0, 0x6e, 0x1a, 0xf4, 0x34, 0x07, 0x9d, 0x81, 0xa9,
-		0x30, 0x4a, 0xa5, 0xda, 0xa8, 0xaf, 0x6e, 0x48, 0xb1, 0xf2, 0x1d, 0x37, 0x6f, 0xcd, 0x4d, 0x04,
-		0x30, 0x2a, 0x5b, 0x0e, 0x36, 0xdc, 0x7e, 0x84, 0xda, 0xda, 0xca, 0xca, 0xd2, 0x86, 0x14, 0xef,
-		0x43, 0xe0, 0x0a, 0xfb, 0x14, 0x4c, 0x84, 0x11, 0x56, 0x97, 0x96, 0xa5, 0x44, 0x19, 0xdd, 0xbc,
-		0x35, 0x57, 0x0c, 0x40, 0xaf, 0xea, 0xed, 0x72, 0xf6, 0x7d, 0x9f, 0x98, 0x19, 0xfb, 0xf4, 0xdf,
-		0x9d, 0x89, 0x91, 0x99, 0x15, 0x42, 0x3a, 0x02, 0x3d, 0x08, 0x47, 0x1b, 0x4b, 0x97, 0x56, 0xeb,
-		0x8b, 0xea, 0x4a, 0xe3, 0x92, 0x38, 0x0f, 0x11, 0xb3, 0x2b, 0xdd, 0xbc, 0x35, 0x97, 0xe7, 0x53,
-		0x1a, 0x06, 0xbd, 0xae, 0xd4, 0xaf, 0xae, 0x6d, 0xd4, 0xa5, 0x18, 0x83, 0x5e, 0xb7, 0xf1, 0x75,
-		0xd3, 0x65, 0x8f, 0x04, 0x3e, 0x0c, 0xc7, 0x06, 0x40, 0x7b, 0x13, 0x9b, 0xb8, 0x79, 0x6b, 0xae,
-		0xb0, 0x6e, 0x63, 0xb6, 0x7f, 0x28, 0xc6, 0x3c, 0x4c, 0xf7, 0x63, 0xac, 0xad, 0xaf, 0x35, 0x2a,
-		0xcb, 0xd2, 0x5c, 0x59, 0xba, 0x79, 0x6b, 0x6e, 0x5c, 0x28, 0x43, 0x02, 0xef, 0xcf, 0xec, 0xcd,
-		0x8a, 0x76, 0x7e, 0x32, 0x13, 0xca, 0xe5, 0xb1, 0x38, 0xc2, 0xd2, 0x6c, 0xad, 0x73, 0xd8, 0x70,
-		0x27, 0xe2, 0x38, 0x57, 0x7e, 0x39, 0x0e, 0x25, 0xcf, 0x99, 0x5e, 0xa7, 0x3d, 0xa0, 0x47, 0x83,
-		0x79, 0x98, 0xfc, 0x50, 0x33, 0xc6, 0xa0, 0x45, 0x9a, 0xe6, 0x6d, 0x90, 0x15, 0x4e, 0x19, 0x57,
-		0x17, 0x73, 0xfd, 0x78, 0x75, 0x0e, 0xc1, 0x51, 0x3d, 0x0c, 0xf4, 0x34, 0xe4, 0x3c, 0xe5, 0xe1,
-		0x3d, 0xb1, 0x33, 0x5c, 0xdb, 0x70, 0x7c, 0x1f, 0x07, 0x3d, 0xe9, 0x87, 0x0d, 0xc9, 0x61, 0x81,
-		0xc8, 0x55, 0x06, 0xc0, 0x91, 0x05, 0xbc, 0xbc, 0xc4, 0xb7, 0x1d, 0x9f, 0x3d, 0x7d, 0x9e, 0x60,
-		0x4f, 0x65, 0x91, 0x14, 0xf3, 0x4a, 0xb2, 0x1d, 0x6d, 0xaf, 0x4a, 0x83, 0xa9, 0xa3, 0x90, 0x21,
-		0x8d, 0x3b, 0xfc, 0xeb, 0xeb, 0x84, 0x92, 0xee, 0x68, 0x7b, 0x97, 0x34, 0xe7, 0x4a, 0x32, 0x9b,
-		0x90, 0x92, 0xf2, 0x67, 0x62, 0x50, 0x0c, 0xcf, 0x11, 0x3d, 0x00, 0x88, 0x60, 0x68, 0x3b, 0x58,
-		0x25, 0x2a, 0x86, 0x32, 0x4b, 0xd0, 0x2d, 0x75, 0xb4, 0xbd, 0xca, 0x0e, 0x5e, 0xed, 0x76, 0xe8,
-		0x00, 0x1c, 0xb4, 0x02, 0x92, 0x00, 0x16, 0xeb, 0xc4, 0x99, 0x79, 0xac, 0xff, 0xc1, 0x3d, 0x0e,
-		0xc0, 0x0c, 0xd5, 0x87, 0x88, 0xa1, 0x2a, 0x32, 0x7a, 0xde, 0x71, 0x7c, 0x68, 0x2a, 0x89, 0xf0,
-		0x54, 0xe4, 0xa7, 0xa1, 0xd4, 0xc3, 0x4f, 0x24, 0x43, 0x81, 0x67, 0x05, 0xe8, 0x51, 0x27, 0xf3,
-		0x8f, 0x73, 0x4a, 0x9e, 0x45, 0xff, 0xf4, 0xe8, 0x77, 0x21, 0xfb, 0xa5, 0x57, 0x66, 0x63, 0x34,
-		0x61, 0xfe, 0x00, 0x14, 0x42, 0x1c, 0x15, 0x99, 0xba, 0x98, 0x9f, 0xa9, 0xf3, 0x81, 0x5f, 0x80,
-		0x71, 0xa2, 0x28, 0x71, 0x8b, 0xc3, 0xde, 0x0f, 0x25, 0xa6, 0xc8, 0x7b, 0x79, 0xcd, 0x3c, 0xa9,
-		0x15, 0xc1, 0x70, 0x59, 0xb8, 0x56, 0x61, 0xb6, 0xe7, 0x05, 0xd4, 0x25, 0xcd, 0xa9, 0xbe, 0xe3,
-		0xd3, 0xaf, 0xcd, 0xc4, 0xde, 0x9c, 0x8d, 0xf8, 0xb5, 0x77, 0xc0, 0xf1, 0x40, 0xa3, 0xb6, 0xd5,
-		0xd4, 0x43, 0x59, 0x87, 0x52, 0x40, 0xc8, 0x48, 0x63, 0x54, 0xf6, 0xe0, 0xc0, 0x1c, 0xc6, 0xc1,
-		0x09, 0xb3, 0xf2, 0xc1, 0x1a, 0x21, 0x3a, 0xb1, 0x31, 0x38, 0x47, 0xf9, 0x7f, 0xb2, 0x90, 0x51,
-		0xf0, 0x7b, 0xba, 0xd8, 0x71, 0xd1, 0x59, 0x48, 0xe2, 0xe6, 0xae, 0x39, 0x28, 0x25, 0x44, 0x26,
-		0x37, 0xcf, 0xe1, 0xea, 0xcd, 0x5d, 0xf3, 0xf2, 0x98, 0x42, 0x61, 0xd1, 0x39, 0x48, 0x6d, 0xb7,
-		0xbb, 0x3c, 0x4f, 0xd1, 0xa3, 0x2c, 0x82, 0x48, 0x17, 0x09, 0xd0, 0xe5, 0x31, 0x85, 0x41, 0x93,
-		0xae, 0xe8, 0xd3, 0xa5, 0x89, 0x83, 0xbb, 0x5a, 0x32, 0xb6, 0x69, 0x57, 0x04, 0x16, 0x55, 0x01,
-		0x74, 0x43, 0x77, 0x55, 0x1a, 0xc3, 0x73, 0x4f, 0xf0, 0xee, 0xe1, 0x98, 0xba, 0x4b, 0xa3, 0xfe,
-		0xcb, 0x63, 0x4a, 0x4e, 0x17, 0x05, 0x32, 0xdc, 0xf7, 0x74, 0xb1, 0xbd, 0xcf, 0x1d, 0xc0, 0xa1,
-		0xc3, 0x7d, 0x07, 0x01, 0x22, 0xc3, 0xa5, 0xd0, 0xa8, 0x0e, 0x79, 0xfa, 0x8d, 0x2a, 0xdb, 0xbf,
-		0xfc, 0x19, 0x4d, 0x79, 0x18, 0x72, 0x95, 0x80, 0xd2, 0x2d, 0x7d, 0x79, 0x4c, 0x81, 0x2d, 0xaf,
-		0x44, 0x94, 0x24, 0x7b, 0x66, 0xc9, 0xdd, 0xe3, 0x8f, 0x07, 0xce, 0x0e, 0xa3, 0x41, 0xdf, 0x5a,
-		0xda, 0xd8, 0xbb, 0x3c, 0xa6, 0x64, 0x9a, 0xec, 0x27, 0x99, 0x7f, 0x0b, 0xb7, 0xf5, 0xeb, 0xd8,
-		0x26, 0xf8, 0xb9, 0x83, 0xe7, 0xbf, 0xc8, 0x20, 0x29, 0x85, 0x5c, 0x4b, 0x14, 0x88, 0xa2, 0xc5,
-		0x46, 0x8b, 0x4f, 0x03, 0xfa, 0xf5, 0x74, 0x68, 0x9d, 0x8d, 0x96, 0x98, 0x44, 0x16, 0xf3, 0xdf,
-		0xe8, 0x09, 0xcf, 0x43, 0xcd, 0xf7, 0x3b, 0x85, 0xa1, 0x09, 0xb0, 0x5c, 0xc5, 0x98, 0xf0, 0x54,
-		0xd1, 0x2a, 0x14, 0xdb, 0xba, 0xe3, 0xaa, 0x8e, 0xa1, 0x59, 0xce, 0xae, 0xe9, 0x3a, 0x34, 0xe8,
-		0xcf, 0x9f, 0xbd, 0x6f, 0x18, 0x85, 0x65, 0xdd, 0x71, 0x1b, 0x02, 0xf8, 0xf2, 0x98, 0x52, 0x68,
-		0x07, 0x2b, 0x08, 0x3d, 0x73, 0x7b, 0x1b, 0xdb, 0x1e, 0x41, 0x9a, 0x1c, 0x38, 0x80, 0xde, 0x1a,
-		0x81, 0x16, 0xf8, 0x84, 0x9e, 0x19, 0xac, 0x40, 0x3f, 0x0c, 0x93, 0x6d, 0x53, 0x6b, 0x79, 0xe4,
-		0xd4, 0xe6, 0x6e, 0xd7, 0xb8, 0x46, 0x33, 0x09, 0xf9, 0xb3, 0xa7, 0x86, 0x0e, 0xd2, 0xd4, 0x5a,
-		0x82, 0x44, 0x8d, 0x20, 0x5c, 0x1e, 0x53, 0x26, 0xda, 0xbd, 0x95, 0xe8, 0x5d, 0x30, 0xa5, 0x59,
-		0x56, 0x7b, 0xbf, 0x97, 0x7a, 0x89, 0x52, 0x3f, 0x3d, 0x8c, 0x7a, 0x85, 0xe0, 0xf4, 0x92, 0x47,
-		0x5a, 0x5f, 0x2d, 0xda, 0x00, 0xc9, 0xb2, 0x31, 0xfd, 0xa8, 0xc6, 0xe2, 0xae, 0x0a, 0x7d, 0x19,
-		0x2b, 0x7f, 0xf6, 0xc4, 0x30, 0xda, 0xeb, 0x0c, 0x5e, 0x78, 0x36, 0x97, 0xc7, 0x94, 0x92, 0x15,
-		0xae, 0x62, 0x54, 0xcd, 0x26, 0xa6, 0xaf, 0x37, 0x71, 0xaa, 0x13, 0x51, 0x54, 0x29, 0x7c, 0x98,
-		0x6a, 0xa8, 0xaa, 0x9a, 0xe1, 0x97, 0xe2, 0xf8, 0xd3, 0x2a, 0x27, 0x20, 0x1f, 0x50, 0x2c, 0x68,
-		0x1a, 0x32, 0xfc, 0x92, 0x80, 0xb8, 0x4c, 0xc7, 0x8b, 0x72, 0x11, 0xc6, 0x83, 0xca, 0x44, 0xfe,
-		0x40, 0xcc, 0xc3, 0xa4, 0xdf, 0xba, 0x4f, 0x87, 0x53, 0x87, 0x39, 0x3f, 0x2b, 0x78, 0x8f, 0xb0,
-		0x22, 0xa2, 0x9d, 0x9d, 0x26, 0x8d, 0xd3, 0x4a, 0x6e, 0xc4, 0xd0, 0x2c, 0xe4, 0xad, 0xb3, 0x96,
-		0x07, 0x92, 0xa0, 0x20, 0x60, 0x9d, 0xb5, 0x04, 0xc0, 0xdd, 0x30, 0x4e, 0x66, 0xaa, 0x06, 0x1d,
-		0x8d, 0x9c, 0x92, 0x27, 0x75, 0x1c, 0x44, 0xfe, 0xd7, 0x71, 0x90, 0x7a, 0x15, 0x90, 0x97, 0x53,
-		0x8c, 0x1d, 0x3a, 0xa7, 0x78, 0xac, 0x37, 0x9b, 0xe9, 0x27, 0x30, 0x97, 0x41, 0xf2, 0xf3, 0x70,
-		0xcc, 0x10, 0x0c, 0x77, 0x9c, 0x7a, 0x3c, 0x3c, 0xa5, 0xd4, 0xec, 0x71, 0xf9, 0x2e, 0x86, 0xce,
-		0x5e, 0xc4, 0xdb, 0xdc, 0xbd, 0x4b, 0xec, 0xf9, 0x0b, 0x9b, 0x56, 0x4b, 0x73, 0xb1, 0xc8, 0x8b,
-		0x04, 0x8e, 0x61, 0xee, 0x87, 0x92, 0x66, 0x59, 0xaa, 0xe3, 0x6a, 0x2e, 0xe6, 0x66, 0x3d, 0xc5,
-		0xd2, 0x83, 0x9a, 0x65, 0x35, 0x48, 0x2d, 0x33, 0xeb, 0xf7, 0x41, 0x91, 0xe8, 0x64, 0x5d, 0x6b,
-		0xab, 0x3c, 0x4b, 0x90, 0x66, 0xd6, 0x9f, 0xd7, 0x5e, 0xa6, 0x95, 0x72, 0xcb, 0x5b, 0x71, 0xaa,
-		0x8f, 0xbd, 0x30, 0x2b, 0x16, 0x08, 0xb3, 0x10, 0x7f, 0x84, 0x80, 0xf1, 0x47, 0xbc, 0xdb, 0x30,
-		0x38, 0xbb, 0x3b, 0x45, 0x43, 0xb2, 0xeb, 0x2c, 0xe1, 0x91, 0x55, 0x58, 0x41, 0x7e, 0x6f, 0x1c,
-		0x26, 0xfa, 0x34, 0xf7, 0xc0, 0xb4, 0xb7, 0x1f, 0x5f, 0xc6, 0x0f, 0x15, 0x5f, 0x3e, 0x13, 0x4e,
-		0xeb, 0x06, 0x2c, 0xdf, 0xf1, 0x3e, 0x26, 0x33, 0xbd, 0x49, 0x04, 0x9a, 0x13, 0x09, 0x64, 0x7e,
-		0xa9, 0x98, 0x6f, 0xc2, 0xd4, 0xd6, 0xfe, 0x4b, 0x9a, 0xe1, 0xea, 0x06, 0x56, 0xfb, 0x56, 0xad,
-		0xdf, 0x94, 0xae, 0xe8, 0xce, 0x16, 0xde, 0xd5, 0xae, 0xeb, 0xa6, 0x18, 0xd6, 0xa4, 0x87, 0xef,
-		0x27, 0x77, 0x65, 0x05, 0x8a, 0x61, 0xd3, 0x83, 0x8a, 0x10, 0x77, 0xf7, 0xf8, 0xfc, 0xe3, 0xee,
-		0x1e, 0x7a, 0x98, 0xe7, 0x81, 0xe2, 0x34, 0x0f, 0xd4, 0xdf, 0x11, 0xc7, 0xf3, 0x93, 0x40, 0xb2,
-		0xec, 0xed, 0x06, 0xcf, 0x1c, 0xf5, 0x52, 0x95, 0x4f, 0x41, 0xa9, 0xc7, 0xde, 0x0c, 0xcb, 0x0a,
-		0xca, 0x25, 0x28, 0x84, 0x8c, 0x8b, 0x7c, 0x04, 0xa6, 0x06, 0xd9, 0x0a, 0x79, 0xd7, 0xab, 0x0f,
-		0xe9, 0x7c, 0x74, 0x0e, 0xb2, 0x9e, 0xb1, 0x18, 0x90, 0x85, 0xa0, 0xb3, 0x10, 0xc0, 0x8a, 0x07,
-		0x1a, 0x4a, 0x66, 0xc7, 0x43, 0xc9, 0x6c, 0xf9, 0xdd, 0x30, 0x3d, 0xcc, 0x10, 0xf4, 0x4c, 0x23,
-		0xe9, 0x49, 0xe1, 0x11, 0x48, 0xf3, 0x27, 0xda, 0xe2, 0xf4, 0xf8, 0x86, 0x97, 0x88, 0x74, 0x32,
-		0xa3, 0x90, 0x60, 0xa7, 0x3a, 0xb4, 0x20, 0xab, 0x70, 0x6c, 0xa8, 0x31, 0x18, 0x7e, 0x10, 0xc4,
-		0x08, 0xf1, 0x83, 0xa0, 0xa6, 0x18, 0x8e, 0x43, 0xe7, 0x2a, 0x2e, 0x3b, 0xb0, 0x92, 0xfc, 0xa1,
-		0x04, 0x1c, 0x19, 0x6c, 0x12, 0xd0, 0x1c, 0x8c, 0x13, 0xbf, 0xdb, 0x0d, 0xbb, 0xe8, 0xd0, 0xd1,
-		0xf6, 0x36, 0xb8, 0x7f, 0xce, 0x13, 0xe9, 0x71, 0x2f, 0x91, 0x8e, 0x36, 0x61, 0xa2, 0x6d, 0x36,
-		0xb5, 0xb6, 0x1a, 0x90, 0x78, 0x2e, 0xec, 0xf7, 0xf4, 0x31, 0xbb, 0xce, 0x1e, 0x6e, 0x6e, 0xf5,
-		0x09, 0x7d, 0x89, 0xd2, 0x58, 0xf6, 0x24, 0x1f, 0x2d, 0x42, 0xbe, 0xe3, 0x0b, 0xf2, 0x21, 0x84,
-		0x3d, 0x88, 0x16, 0x58, 0x92, 0xd4, 0xc0, 0x63, 0x9f, 0xf4, 0xa1, 0x55, 0xf4, 0xb0, 0x13, 0x94,
-		0xcc, 0xd0, 0x13, 0x94, 0x41, 0xc7, 0x15, 0xd9, 0xc1, 0xc7, 0x15, 0xef, 0x0b, 0x2e, 0x4d, 0xc8,
-		0x88, 0xf6, 0x9f, 0x60, 0xa0, 0x06, 0x4c, 0x71, 0xfc, 0x56, 0x88, 0xf7, 0xf1, 0x51, 0x15, 0x0d,
-		0x12, 0xe8, 0xc3, 0xd9, 0x9e, 0xb8, 0x3d, 0xb6, 0x0b, 0x5d, 0x9a, 0x0c, 0xe8, 0xd2, 0xff, 0xc7,
-		0x96, 0xe2, 0xdf, 0xe6, 0x20, 0xab, 0x60, 0xc7, 0x22, 0x86, 0x13, 0x55, 0x21, 0x87, 0xf7, 0x9a,
-		0xd8, 0x72, 0xfd, 0x63, 0xca, 0x41, 0xc1, 0x00, 0x83, 0xae, 0x0b, 0x48, 0xe2, 0x89, 0x7b, 0x68,
-		0xe8, 0x51, 0x1e, 0x6c, 0x0d, 0x8f, 0x9b, 0x38, 0x7a, 0x30, 0xda, 0x3a, 0x2f, 0xa2, 0xad, 0xc4,
-		0x50, 0xe7, 0x9b, 0x61, 0xf5, 0x84, 0x5b, 0x8f, 0xf2, 0x70, 0x2b, 0x19, 0xd1, 0x59, 0x28, 0xde,
-		0xaa, 0x85, 0xe2, 0xad, 0x74, 0xc4, 0x34, 0x87, 0x04, 0x5c, 0xe7, 0x45, 0xc0, 0x95, 0x89, 0x18,
-		0x71, 0x4f, 0xc4, 0x75, 0x31, 0x1c, 0x71, 0x65, 0x87, 0x28, 0x10, 0x81, 0x3d, 0x34, 0xe4, 0x7a,
-		0x2a, 0x10, 0x72, 0xe5, 0x86, 0xc6, 0x3b, 0x8c, 0xc8, 0x80, 0x98, 0xab, 0x16, 0x8a, 0xb9, 0x20,
-		0x82, 0x07, 0x43, 0x82, 0xae, 0x1f, 0x0a, 0x06, 0x5d, 0xf9, 0xa1, 0x71, 0x1b, 0x5f, 0xef, 0x41,
-		0x51, 0xd7, 0x93, 0x5e, 0xd4, 0x35, 0x3e, 0x34, 0x6c, 0xe4, 0x73, 0xe8, 0x0d, 0xbb, 0xd6, 0xfa,
-		0xc2, 0xae, 0x02, 0xff, 0x9b, 0x08, 0xc3, 0x48, 0x44, 0xc4, 0x5d, 0x6b, 0x7d, 0x71, 0x57, 0x31,
-		0x82, 0x60, 0x44, 0xe0, 0xf5, 0x23, 0x83, 0x03, 0xaf, 0xe1, 0xa1, 0x11, 0x1f, 0xe6, 0x68, 0x91,
-		0x97, 0x3a, 0x24, 0xf2, 0x62, 0xd1, 0xd1, 0x03, 0x43, 0xc9, 0x8f, 0x1c, 0x7a, 0x6d, 0x0e, 0x08,
-		0xbd, 0x58, 0x90, 0x74, 0x72, 0x28, 0xf1, 0x11, 0x62, 0xaf, 0xcd, 0x01, 0xb1, 0x17, 0x8a, 0x24,
-		0x7b, 0x98, 0xe0, 0x2b, 0x25, 0xa5, 0xe5, 0x53, 0xc4, 0xf5, 0xed, 0xd1, 0x53, 0xc4, 0x7f, 0xc0,
-		0xb6, 0x6d, 0xda, 0xe2, 0x66, 0x2d, 0x2d, 0xc8, 0x27, 0x89, 0x33, 0xee, 0xeb, 0xa4, 0x03, 0x02,
-		0x35, 0xea, 0xa7, 0x05, 0xf4, 0x90, 0xfc, 0xa5, 0x98, 0x8f, 0x4b, 0x7d, 0xd8, 0xa0, 0x23, 0x9f,
-		0xe3, 0x8e, 0x7c, 0x20, 0x7c, 0x8b, 0x87, 0xc3, 0xb7, 0x59, 0xc8, 0x13, 0xff, 0xab, 0x27, 0x32,
-		0xd3, 0x2c, 0x2f, 0x32, 0x13, 0x57, 0x0a, 0x58, 0x90, 0xc7, 0xcd, 0x0a, 0x3b, 0xc9, 0x29, 0x79,
-		0xd7, 0x2b, 0x58, 0x4c, 0x81, 0x1e, 0x82, 0xc9, 0x00, 0xac, 0xe7, 0xd7, 0xb1, 0x30, 0x45, 0xf2,
-		0xa0, 0x2b, 0xdc, 0xc1, 0xfb, 0x67, 0x31, 0x9f, 0x43, 0x7e, 0x48, 0x37, 0x28, 0xfa, 0x8a, 0xbd,
-		0x41, 0xd1, 0x57, 0xfc, 0xb6, 0xa3, 0xaf, 0xa0, 0x9f, 0x9a, 0x08, 0xfb, 0xa9, 0xff, 0x23, 0xe6,
-		0xaf, 0x89, 0x17, 0x4b, 0x35, 0xcd, 0x16, 0xe6, 0x9e, 0x23, 0xfd, 0x4d, 0x9c, 0x8a, 0xb6, 0xb9,
-		0xc3, 0xfd, 0x43, 0xf2, 0x93, 0x40, 0x79, 0x86, 0x23, 0xc7, 0xed, 0x82, 0xe7, 0x74, 0xa6, 0x82,
-		0xf7, 0x7f, 0xf9, 0xa5, 0xd8, 0xb4, 0x7f, 0x29, 0xd6, 0xfb, 0xf8, 0x2d, 0x13, 0xf8, 0xf8, 0x0d,
-		0x3d, 0x01, 0x39, 0x9a, 0x11, 0x55, 0x4d, 0x4b, 0xfc, 0x05, 0x8d, 0xe3, 0xc3, 0x2f, 0xc4, 0x3a,
-		0xf4, 0xaa, 0x1e, 0xbb, 0x44, 0xeb, 0x7b, 0x0c, 0xb9, 0x90, 0xc7, 0x70, 0x27, 0xe4, 0xc8, 0xe8,
-		0xd9, 0x4b, 0xd0, 0xc0, 0xbf, 0x9c, 0x14, 0x15, 0xf2, 0xbb, 0x00, 0xf5, 0x1b, 0x09, 0x74, 0x19,
-		0xd2, 0xf8, 0x3a, 0x7d, 0x56, 0x8f, 0x5d, 0x34, 0x3c, 0xd2, 0xef, 0x9a, 0x92, 0xe6, 0xea, 0x34,
-		0x61, 0xf2, 0xb7, 0x5f, 0x9d, 0x95, 0x18, 0xf4, 0x83, 0xde, 0x87, 0x02, 0x0a, 0xc7, 0x97, 0xff,
-		0x63, 0x9c, 0x04, 0x30, 0x21, 0x03, 0x32, 0x90, 0xb7, 0x83, 0x8e, 0x08, 0x47, 0xe3, 0xf7, 0x0c,
-		0xc0, 0x8e, 0xe6, 0xa8, 0x37, 0x34, 0xc3, 0xc5, 0x2d, 0xce, 0xf4, 0x40, 0x0d, 0x2a, 0x43, 0x96,
-		0x94, 0xba, 0x0e, 0x6e, 0xf1, 0x30, 0xda, 0x2b, 0x07, 0xe6, 0x99, 0xf9, 0xfe, 0xe6, 0x19, 0xe6,
-		0x72, 0xb6, 0x87, 0xcb, 0x81, 0xe0, 0x22, 0x17, 0x0c, 0x2e, 0xd8, 0xc5, 0x60, 0x7e, 0x3f, 0x11,
-		0xd8, 0xd8, 0x44, 0x19, 0xdd, 0x03, 0x85, 0x0e, 0xee, 0x58, 0xa6, 0xd9, 0x56, 0x99, 0xba, 0x61,
-		0x4f, 0xbe, 0x8f, 0xf3, 0xca, 0x3a, 0xd5, 0x3a, 0x3f, 0x11, 0xf7, 0xf7, 0x9f, 0x1f, 0x44, 0xfe,
-		0xc0, 0x31, 0x58, 0xfe, 0x69, 0x9a, 0x59, 0x0a, 0xbb, 0x08, 0xa8, 0x11, 0xbc, 0x35, 0xd2, 0xa5,
-		0x6a, 0x41, 0x08, 0xf4, 0xa8, 0xfa, 0xc3, 0xbf, 0x5d, 0xc2, 0xaa, 0x1d, 0xf4, 0x3c, 0x1c, 0xed,
-		0xd1, 0x6d, 0x1e, 0xe9, 0xf8, 0xa8, 0x2a, 0xee, 0x8e, 0xb0, 0x8a, 0x13, 0xa4, 0x7d, 0x66, 0x25,
-		0xbe, 0xcf, 0x5d, 0xb7, 0x04, 0xc5, 0xb0, 0xc7, 0x33, 0x70, 0xf9, 0xe9, 0x9f, 0x18, 0x71, 0x35,
-		0xdd, 0x50, 0x43, 0xe9, 0xa0, 0x71, 0x56, 0xc9, 0x93, 0x4c, 0xeb, 0x70, 0xc7, 0x40, 0xcf, 0x07,
-		0x3d, 0x0e, 0x39, 0xdf, 0x69, 0x62, 0x5c, 0x3d, 0x20, 0x5d, 0xe0, 0xc3, 0xca, 0xbf, 0x15, 0xf3,
-		0x49, 0x86, 0x13, 0x10, 0x75, 0x48, 0xb3, 0x6b, 0x6f, 0xfc, 0x32, 0xcd, 0x43, 0xa3, 0xf9, 0x4c,
-		0xf3, 0xec, 0x4e, 0x9c, 0xc2, 0x91, 0xe5, 0x77, 0x41, 0x9a, 0xd5, 0xa0, 0x3c, 0x64, 0xfc, 0x97,
-		0x6f, 0x01, 0xd2, 0x95, 0x5a, 0xad, 0xbe, 0xbe, 0x21, 0xc5, 0x50, 0x0e, 0x52, 0x95, 0xea, 0x9a,
-		0xb2, 0x21, 0xc5, 0x49, 0xb5, 0x52, 0xbf, 0x52, 0xaf, 0x6d, 0x48, 0x09, 0x34, 0x01, 0x05, 0xf6,
-		0x5b, 0xbd, 0xb8, 0xa6, 0xac, 0x54, 0x36, 0xa4, 0x64, 0xa0, 0xaa, 0x51, 0x5f, 0x5d, 0xac, 0x2b,
-		0x52, 0x4a, 0x7e, 0x04, 0x8e, 0x0d, 0xf5, 0xb2, 0xfc, 0xec, 0x42, 0x2c, 0x90, 0x5d, 0x90, 0x3f,
-		0x14, 0x87, 0xf2, 0x70, 0xd7, 0x09, 0x5d, 0xe9, 0x99, 0xf8, 0xd9, 0x43, 0xf8, 0x5d, 0x3d, 0xb3,
-		0x47, 0xf7, 0x41, 0xd1, 0xc6, 0xdb, 0xd8, 0x6d, 0xee, 0x32, 0x57, 0x8e, 0x99, 0xcc, 0x82, 0x52,
-		0xe0, 0xb5, 0x14, 0xc9, 0x61, 0x60, 0x2f, 0xe2, 0xa6, 0xab, 0x32, 0x5d, 0xe4, 0xf0, 0xbf, 0x75,
-		0x58, 0x60, 0xb5, 0x0d, 0x56, 0x29, 0xbf, 0xfb, 0x50, 0xbc, 0xcc, 0x41, 0x4a, 0xa9, 0x6f, 0x28,
-		0xcf, 0x4b, 0x09, 0x84, 0xa0, 0x48, 0x7f, 0xaa, 0x8d, 0xd5, 0xca, 0x7a, 0xe3, 0xf2, 0x1a, 0xe1,
-		0xe5, 0x24, 0x94, 0x04, 0x2f, 0x45, 0x65, 0x4a, 0x7e, 0x00, 0x8e, 0x0e, 0xf1, 0xfb, 0x06, 0xdc,
-		0x43, 0xfc, 0x78, 0x2c, 0x08, 0x1d, 0x8e, 0xf9, 0xd7, 0x20, 0xed, 0xb8, 0x9a, 0xdb, 0x75, 0x38,
-		0x13, 0x1f, 0x1f, 0xd5, 0x11, 0x9c, 0x17, 0x3f, 0x1a, 0x14, 0x5d, 0xe1, 0x64, 0xe4, 0x73, 0x50,
-		0x0c, 0xb7, 0x0c, 0xe7, 0x81, 0x2f, 0x44, 0x71, 0xf9, 0x79, 0x80, 0x40, 0x3e, 0xd2, 0xbb, 0xd1,
-		0x15, 0x0b, 0xde, 0xe8, 0x3a, 0x07, 0xa9, 0xeb, 0x26, 0xd3, 0x19, 0x83, 0x37, 0xce, 0x55, 0xd3,
-		0xc5, 0x81, 0xe4, 0x03, 0x83, 0x96, 0x75, 0x40, 0xfd, 0x39, 0xa1, 0x21, 0x5d, 0x3c, 0x15, 0xee,
-		0xe2, 0xee, 0xa1, 0xd9, 0xa5, 0xc1, 0x5d, 0xbd, 0x04, 0x29, 0xaa, 0x6d, 0x06, 0x7e, 0xe2, 0xf3,
-		0x4e, 0x00, 0xcd, 0x75, 0x6d, 0x7d, 0xab, 0xeb, 0x77, 0x30, 0x3b, 0x58, 0x5b, 0x55, 0x04, 0x5c,
-		0xf5, 0x4e, 0xae, 0xb6, 0xa6, 0x7c, 0xd4, 0x80, 0xea, 0x0a, 0x10, 0x94, 0x57, 0xa1, 0x18, 0xc6,
-		0x1d, 0xfc, 0xc9, 0x92, 0xff, 0x76, 0x40, 0x4e, 0xb8, 0x4f, 0x9e, 0xf3, 0xc5, 0xdf, 0xf3, 0xa0,
-		0x05, 0xf9, 0x66, 0x0c, 0xb2, 0x1b, 0x7b, 0x5c, 0x8e, 0x0f, 0xb8, 0x54, 0xe9, 0x7f, 0xb7, 0xe5,
-		0x25, 0x0b, 0x59, 0x3e, 0x36, 0xe1, 0x65, 0x79, 0x7f, 0xc8, 0xdb, 0xa9, 0xc9, 0x51, 0xa3, 0x5d,
-		0x91, 0xed, 0xe6, 0xda, 0xe9, 0xc2, 0x68, 0xdf, 0x48, 0x4c, 0x41, 0x2a, 0xf8, 0x7d, 0x03, 0x2b,
-		0xc8, 0xad, 0xc0, 0xb5, 0x04, 0x66, 0x36, 0x82, 0x1f, 0x53, 0xc4, 0x0e, 0xfd, 0x31, 0x85, 0xd7,
-		0x4b, 0x3c, 0xd8, 0xcb, 0x75, 0xc8, 0x0a, 0xa1, 0x40, 0x6f, 0x0f, 0xde, 0x3d, 0x11, 0x67, 0x34,
-		0x43, 0x8d, 0x27, 0x27, 0x1f, 0xb8, 0x7a, 0x72, 0x1a, 0x26, 0xf8, 0x95, 0x3b, 0x3f, 0xae, 0xe0,
-		0x6f, 0xf3, 0x97, 0x58, 0xc3, 0xb2, 0x08, 0x2a, 0xe4, 0x5f, 0x8e, 0x81, 0xd4, 0x2b, 0x95, 0x6f,
-		0xe5, 0x00, 0x88, 0x52, 0x24, 0xd2, 0x1f, 0x78, 0x74, 0x9b, 0xad, 0x7c, 0x81, 0xd4, 0xfa, 0xcf,
-		0x6e, 0xbf, 0x37, 0x0e, 0xf9, 0x40, 0x4e, 0x0f, 0x3d, 0x16, 0xba, 0x02, 0x3a, 0x77, 0x50, 0xfe,
-		0x2f, 0x70, 0x07, 0x34, 0x34, 0xb1, 0xf8, 0xe1, 0x27, 0xf6, 0xc6, 0x5f, 0xd2, 0x1f, 0xfc, 0xb5,
-		0x4f, 0x6a, 0xc8, 0xd7, 0x3e, 0x3f, 0x16, 0x83, 0xac, 0x67, 0xba, 0x0f, 0x9b, 0xcd, 0x3f, 0x02,
-		0x69, 0x6e, 0x9d, 0x58, 0x3a, 0x9f, 0x97, 0x06, 0xe6, 0x42, 0xcb, 0x90, 0x15, 0x7f, 0xd4, 0x86,
-		0x07, 0xa2, 0x5e, 0xf9, 0xf4, 0x93, 0x90, 0x0f, 0x1c, 0xac, 0x10, 0x3d, 0xb1, 0x5a, 0x7f, 0x56,
-		0x1a, 0x2b, 0x67, 0x6e, 0xde, 0x9a, 0x4b, 0xac, 0xe2, 0x1b, 0x64, 0x87, 0x29, 0xf5, 0xda, 0xe5,
-		0x7a, 0xed, 0x19, 0x29, 0x56, 0xce, 0xdf, 0xbc, 0x35, 0x97, 0x51, 0x30, 0x4d, 0x5f, 0x9d, 0x7e,
-		0x06, 0x4a, 0x3d, 0x0b, 0x13, 0xd6, 0xef, 0x08, 0x8a, 0x8b, 0x9b, 0xeb, 0xcb, 0x4b, 0xb5, 0xca,
-		0x46, 0x5d, 0x65, 0xd7, 0xe9, 0xd0, 0x51, 0x98, 0x5c, 0x5e, 0xba, 0x74, 0x79, 0x43, 0xad, 0x2d,
-		0x2f, 0xd5, 0x57, 0x37, 0xd4, 0xca, 0xc6, 0x46, 0xa5, 0xf6, 0x8c, 0x14, 0x3f, 0xfb, 0x3d, 0x80,
-		0x52, 0xa5, 0x5a, 0x5b, 0x22, 0xf6, 0x59, 0xe7, 0xef, 0xa6, 0xd7, 0x20, 0x49, 0x53, 0x01, 0x07,
-		0x5e, 0x15, 0x29, 0x1f, 0x9c, 0xdb, 0x44, 0x17, 0x21, 0x45, 0xb3, 0x04, 0xe8, 0xe0, 0xbb, 0x23,
-		0xe5, 0x88, 0x64, 0x27, 0x19, 0x0c, 0xdd, 0x4e, 0x07, 0x5e, 0x26, 0x29, 0x1f, 0x9c, 0xfb, 0x44,
-		0x0a, 0xe4, 0xfc, 0x28, 0x23, 0xfa, 0x72, 0x45, 0x79, 0x04, 0xed, 0x88, 0x96, 0x21, 0x23, 0x02,
-		0xc3, 0xa8, 0xeb, 0x1e, 0xe5, 0xc8, 0xe4, 0x24, 0x61, 0x17, 0x0b, 0xe0, 0x0f, 0xbe, 0xbb, 0x52,
-		0x8e, 0xc8, 0xb4, 0xa2, 0x25, 0xef, 0x12, 0x7e, 0xc4, 0x15, 0x8e, 0x72, 0x54, 0xb2, 0x91, 0x30,
-		0xcd, 0x4f, 0x8d, 0x44, 0xdf, 0xc8, 0x29, 0x8f, 0x90, 0x44, 0x46, 0x9b, 0x00, 0x81, 0x70, 0x7d,
-		0x84, 0xab, 0x36, 0xe5, 0x51, 0x92, 0xc3, 0x68, 0x0d, 0xb2, 0x5e, 0xf4, 0x14, 0x79, 0xf1, 0xa5,
-		0x1c, 0x9d, 0xa5, 0x45, 0xef, 0x82, 0x42, 0x38, 0x6a, 0x18, 0xed, 0x3a, 0x4b, 0x79, 0xc4, 0xf4,
-		0x2b, 0xa1, 0x1f, 0x0e, 0x21, 0x46, 0xbb, 0xde, 0x52, 0x1e, 0x31, 0x1b, 0x8b, 0x5e, 0x84, 0x89,
-		0x7e, 0x17, 0x7f, 0xf4, 0xdb, 0x2e, 0xe5, 0x43, 0xe4, 0x67, 0x51, 0x07, 0xd0, 0x80, 0xd0, 0xe0,
-		0x10, 0x97, 0x5f, 0xca, 0x87, 0x49, 0xd7, 0xa2, 0x16, 0x94, 0x7a, 0xfd, 0xed, 0x51, 0x2f, 0xc3,
-		0x94, 0x47, 0x4e, 0xdd, 0xb2, 0x5e, 0xc2, 0x7e, 0xfa, 0xa8, 0x97, 0x63, 0xca, 0x23, 0x67, 0x72,
-		0xab, 0x95, 0xa1, 0xf7, 0x1b, 0x4f, 0x1c, 0x78, 0xbf, 0xd1, 0xbf, 0xb1, 0xe8, 0xdd, 0x69, 0xfc,
-		0xc3, 0x87, 0xe1, 0x5e, 0xfe, 0x98, 0x80, 0xe3, 0x6a, 0xd7, 0x74, 0x63, 0xc7, 0x7b, 0x1d, 0x82,
-		0x97, 0xf9, 0xe5, 0xc6, 0x23, 0xfc, 0xc1, 0x02, 0x51, 0x1b, 0xf1, 0x46, 0xc4, 0xd0, 0x87, 0xb3,
-		0xa2, 0x2e, 0x21, 0x47, 0x5f, 0x5d, 0x3c, 0xe0, 0xfd, 0x89, 0x88, 0x57, 0x2e, 0x06, 0xbc, 0x4f,
-		0x11, 0x71, 0x09, 0xf3, 0xa0, 0xfb, 0x9e, 0xf2, 0x07, 0x63, 0x50, 0xbc, 0xac, 0x3b, 0xae, 0x69,
-		0xeb, 0x4d, 0xad, 0x4d, 0x2d, 0xc6, 0x85, 0x51, 0x3f, 0xf2, 0xa8, 0xe6, 0x88, 0x33, 0xc2, 0x1f,
-		0xb5, 0xe0, 0x17, 0x43, 0x16, 0x21, 0x7d, 0x5d, 0x6b, 0xb3, 0x4f, 0x2c, 0x82, 0xef, 0xd7, 0xf4,
-		0xf2, 0x3c, 0xe0, 0x25, 0x05, 0xa9, 0x30, 0x5c, 0xf9, 0x8b, 0xf4, 0x12, 0x77, 0xa7, 0xa3, 0x3b,
-		0xec, 0xcf, 0x4e, 0xbb, 0xd8, 0x41, 0x9b, 0x90, 0xb4, 0x35, 0x97, 0x07, 0x35, 0xd5, 0x0a, 0x7f,
-		0x8a, 0xe2, 0xfe, 0xe8, 0x07, 0x25, 0xe6, 0xfb, 0x5e, 0xab, 0x60, 0x9d, 0x51, 0x72, 0xe8, 0x47,
-		0x20, 0xdb, 0xd1, 0xf6, 0x54, 0x4a, 0x3a, 0xfe, 0x46, 0x91, 0xce, 0x74, 0xb4, 0x3d, 0x32, 0x6a,
-		0xa4, 0x43, 0x89, 0x50, 0x6f, 0xee, 0x6a, 0xc6, 0x0e, 0x66, 0x9d, 0x24, 0xde, 0xa8, 0x4e, 0x0a,
-		0x1d, 0x6d, 0xaf, 0x46, 0x09, 0x93, 0xae, 0xf8, 0xa3, 0x1f, 0xbf, 0x1d, 0xe3, 0xa1, 0x2c, 0xe5,
-		0x1c, 0xd2, 0x40, 0x6a, 0x7a, 0x25, 0xda, 0xbf, 0x48, 0xeb, 0x9f, 0x18, 0xb6, 0x30, 0x3d, 0x7c,
-		0xaf, 0x16, 0xc8, 0x48, 0xbf, 0xfa, 0xea, 0x6c, 0x8c, 0xf5, 0x5a, 0x6a, 0xf6, 0xac, 0xcb, 0x15,
-		0xc8, 0xb3, 0x6c, 0x9a, 0x4a, 0x5d, 0xd8, 0x78, 0xa4, 0x0b, 0x5b, 0x10, 0x2e, 0x2c, 0x23, 0x08,
-		0x0c, 0x9b, 0xb4, 0xf3, 0x39, 0x7c, 0x3a, 0x06, 0xf9, 0xc5, 0xc0, 0x73, 0x55, 0xd3, 0x90, 0xe9,
-		0x98, 0x86, 0x7e, 0x0d, 0xdb, 0xde, 0xb9, 0x0c, 0x2b, 0x12, 0x37, 0x93, 0xfd, 0xd9, 0x21, 0x77,
-		0x5f, 0xbc, 0xc4, 0x20, 0xca, 0x04, 0xeb, 0x06, 0xde, 0x72, 0x74, 0xc1, 0x72, 0x45, 0x14, 0xd1,
-		0x29, 0x90, 0x1c, 0xdc, 0xec, 0xda, 0xba, 0xbb, 0xaf, 0x36, 0x4d, 0xc3, 0xd5, 0x9a, 0x2e, 0xcf,
-		0x9e, 0x96, 0x44, 0x7d, 0x8d, 0x55, 0x13, 0x22, 0x2d, 0xec, 0x6a, 0x7a, 0x9b, 0x5d, 0xfb, 0xca,
-		0x29, 0xa2, 0xc8, 0x87, 0xfa, 0xc5, 0x4c, 0x30, 0x34, 0xac, 0x81, 0x64, 0x5a, 0xd8, 0x0e, 0x7d,
-		0xf2, 0xc6, 0xc4, 0x75, 0xfa, 0xf7, 0x3e, 0xff, 0xd0, 0x14, 0x67, 0x38, 0x3f, 0x80, 0x67, 0xaf,
-		0x33, 0x2b, 0x25, 0x81, 0x21, 0xbe, 0x85, 0x7b, 0x3e, 0x74, 0x12, 0xd3, 0xdd, 0xf2, 0x3f, 0xb9,
-		0x9f, 0xea, 0x63, 0x6a, 0xc5, 0xd8, 0xaf, 0x4e, 0xff, 0xae, 0x4f, 0xda, 0x0f, 0x1d, 0x9f, 0xc1,
-		0xfb, 0xc1, 0x63, 0x19, 0x4a, 0x86, 0x78, 0xf0, 0x2f, 0x6a, 0x7a, 0x5b, 0xfc, 0x25, 0x35, 0x85,
-		0x97, 0xd0, 0x82, 0x97, 0x86, 0x61, 0x7f, 0x21, 0x5d, 0x1e, 0x26, 0x1b, 0x55, 0xd3, 0x68, 0x85,
-		0x33, 0x2e, 0x68, 0x03, 0xd2, 0xae, 0x79, 0x0d, 0x1b, 0x9c, 0x41, 0xd5, 0xb7, 0x1d, 0x42, 0xb0,
-		0xfb, 0xdf, 0x9f, 0xe1, 0xb4, 0xd0, 0x0e, 0x48, 0x2d, 0xdc, 0xc6, 0x3b, 0xec, 0x83, 0xad, 0x5d,
-		0xcd, 0xc6, 0xec, 0x23, 0xce, 0xc3, 0xd1, 0xef, 0x7f, 0xa6, 0xa6, 0xe4, 0x51, 0x6d, 0x50, 0xa2,
-		0x68, 0x3d, 0xfc, 0x58, 0x5a, 0x86, 0x9f, 0xca, 0x0f, 0x99, 0x7f, 0x40, 0x2a, 0x83, 0x6a, 0x2b,
-		0xf4, 0xb8, 0xda, 0x29, 0x90, 0xba, 0xc6, 0x96, 0x69, 0xd0, 0x3f, 0x7a, 0xc4, 0x03, 0xac, 0x2c,
-		0x3b, 0xb9, 0xf3, 0xea, 0xf9, 0xc9, 0xdd, 0x3a, 0x14, 0x7d, 0x50, 0xba, 0x7b, 0x72, 0x87, 0xdd,
-		0x3d, 0x05, 0x8f, 0x00, 0x01, 0x41, 0x2b, 0x00, 0xfe, 0xfe, 0xf4, 0x4e, 0xf7, 0x23, 0x77, 0x7a,
-		0x70, 0x32, 0x01, 0x02, 0xa8, 0x0d, 0x93, 0x1d, 0xdd, 0x50, 0x1d, 0xdc, 0xde, 0x56, 0x39, 0xe7,
-		0x08, 0xdd, 0xfc, 0x1b, 0xb0, 0xd2, 0x13, 0x1d, 0xdd, 0x68, 0xe0, 0xf6, 0xf6, 0xa2, 0x47, 0x16,
-		0xbd, 0x0d, 0x8e, 0xfb, 0xec, 0x30, 0x0d, 0x75, 0xd7, 0x6c, 0xb7, 0x54, 0x1b, 0x6f, 0xab, 0x4d,
-		0xfa, 0xfc, 0xd1, 0x38, 0x65, 0xe2, 0x51, 0x0f, 0x64, 0xcd, 0xb8, 0x6c, 0xb6, 0x5b, 0x0a, 0xde,
-		0xae, 0x91, 0x66, 0x74, 0x0f, 0xf8, 0xbc, 0x50, 0xf5, 0x96, 0x33, 0x5d, 0x98, 0x4b, 0x9c, 0x4c,
-		0x2a, 0xe3, 0x5e, 0xe5, 0x52, 0xcb, 0x59, 0xc8, 0xbe, 0xef, 0x95, 0xd9, 0xb1, 0x6f, 0xbd, 0x32,
-		0x3b, 0x26, 0x5f, 0xa4, 0x8f, 0x75, 0xf0, 0x4d, 0x87, 0x1d, 0x74, 0x1e, 0x72, 0x9a, 0x28, 0xb0,
-		0xcf, 0x44, 0x0e, 0xd8, 0xb4, 0x3e, 0xa8, 0xfc, 0x4a, 0x0c, 0xd2, 0x8b, 0x57, 0xd7, 0x35, 0xdd,
-		0x46, 0x75, 0x98, 0xf0, 0x85, 0x76, 0xd4, 0xfd, 0xef, 0xcb, 0xb9, 0x50, 0x00, 0xf5, 0x61, 0x5f,
-		0xce, 0x1e, 0x48, 0xa6, 0xf7, 0x9b, 0xda, 0xc0, 0x54, 0xaf, 0x40, 0x86, 0x8d, 0xd0, 0x41, 0x4f,
-		0x43, 0xca, 0x22, 0x3f, 0x78, 0xf6, 0x7f, 0x66, 0xa8, 0xa0, 0x53, 0xf8, 0xa0, 0x58, 0x30, 0x3c,
-		0xf9, 0x2f, 0x63, 0x00, 0x8b, 0x57, 0xaf, 0x6e, 0xd8, 0xba, 0xd5, 0xc6, 0xee, 0x1b, 0x35, 0xe5,
-		0x65, 0xb8, 0x23, 0xf0, 0x7d, 0xa6, 0xdd, 0x1c, 0x79, 0xda, 0x93, 0xfe, 0x17, 0x9a, 0x76, 0x73,
-		0x20, 0xb5, 0x96, 0xe3, 0x7a, 0xd4, 0x12, 0x23, 0x53, 0x5b, 0x74, 0xdc, 0x7e, 0x3e, 0x3e, 0x07,
-		0x79, 0x7f, 0xea, 0x0e, 0x5a, 0x82, 0xac, 0xcb, 0x7f, 0x73, 0x76, 0xca, 0xc3, 0xd9, 0x29, 0xd0,
-		0x82, 0x2c, 0xf5, 0xd0, 0xe5, 0xff, 0x45, 0xb8, 0xea, 0x6f, 0x84, 0xbf, 0x56, 0x82, 0x44, 0x34,
-		0x3c, 0xd7, 0xc0, 0x89, 0x37, 0x40, 0x03, 0x73, 0x5a, 0x01, 0xb6, 0xfe, 0x78, 0x1c, 0x26, 0x37,
-		0xc5, 0x26, 0xfd, 0x6b, 0xcb, 0x85, 0x4d, 0xc8, 0x60, 0xc3, 0xb5, 0x75, 0x2c, 0x8e, 0xfa, 0x1e,
-		0x1e, 0xb6, 0xd8, 0x03, 0xe6, 0x42, 0xff, 0x14, 0x70, 0x70, 0xe9, 0x05, 0xad, 0x00, 0x1b, 0x7e,
-		0x33, 0x01, 0xd3, 0xc3, 0x50, 0xd1, 0x09, 0x28, 0x35, 0x6d, 0x4c, 0x2b, 0xd4, 0x50, 0x2a, 0xbc,
-		0x28, 0xaa, 0xb9, 0x49, 0x51, 0x80, 0x38, 0x68, 0x44, 0xaa, 0x08, 0xe8, 0xed, 0x79, 0x64, 0x45,
-		0x9f, 0x02, 0x35, 0x2a, 0x18, 0x4a, 0xe2, 0x6e, 0xfb, 0x96, 0xd6, 0xd6, 0x8c, 0x26, 0xbe, 0x0d,
-		0x49, 0xe8, 0xb7, 0x00, 0xe2, 0xc2, 0x7c, 0x95, 0xd1, 0x44, 0x57, 0x21, 0x23, 0xc8, 0x27, 0xdf,
-		0x00, 0xf2, 0x82, 0x18, 0xba, 0x1b, 0xc6, 0x83, 0x86, 0x81, 0xfa, 0x29, 0x49, 0x25, 0x1f, 0xb0,
-		0x0b, 0x51, 0x96, 0x27, 0x7d, 0xa0, 0xe5, 0xe1, 0xae, 0xe0, 0x6f, 0x24, 0x60, 0x42, 0xc1, 0xad,
-		0x1f, 0xc0, 0x85, 0xfb, 0x61, 0x00, 0xb6, 0xa9, 0x89, 0xb2, 0xbd, 0x8d, 0xb5, 0xeb, 0x57, 0x12,
-		0x39, 0x46, 0x6f, 0xd1, 0x71, 0xdf, 0xaa, 0xd5, 0xfb, 0x57, 0x71, 0x18, 0x0f, 0xae, 0xde, 0x0f,
-		0x80, 0x65, 0x43, 0xab, 0xbe, 0x4a, 0x63, 0xb7, 0xcf, 0x4f, 0x0d, 0x53, 0x69, 0x7d, 0x72, 0x1d,
-		0xa1, 0xcb, 0x3e, 0x95, 0x80, 0x34, 0xbf, 0x0a, 0xb6, 0xd6, 0xe7, 0xe3, 0xc6, 0xa2, 0xbe, 0xff,
-		0x2d, 0x88, 0xef, 0x7f, 0x07, 0xba, 0xb8, 0xf7, 0x41, 0x91, 0x84, 0xd4, 0xa1, 0xfb, 0x65, 0xb1,
-		0x93, 0x05, 0x1a, 0x0e, 0xfb, 0xb7, 0xa1, 0xd1, 0x2c, 0xe4, 0x09, 0x98, 0xaf, 0xb3, 0x09, 0x0c,
-		0x74, 0xb4, 0xbd, 0x3a, 0xab, 0x41, 0x0f, 0x01, 0xda, 0xf5, 0x12, 0x1f, 0xaa, 0xcf, 0x08, 0x02,
-		0x37, 0xe1, 0xb7, 0x08, 0xf0, 0xbb, 0x00, 0xc8, 0x28, 0x54, 0xf6, 0x42, 0x27, 0x7f, 0xed, 0x94,
-		0xd4, 0x2c, 0xd2, 0x57, 0x3a, 0x7f, 0x26, 0xc6, 0x5c, 0xe5, 0x9e, 0x68, 0x9b, 0x07, 0x2d, 0xea,
-		0xe1, 0x76, 0xc3, 0x77, 0x5f, 0x9d, 0x2d, 0xef, 0x6b, 0x9d, 0xf6, 0x82, 0x3c, 0x80, 0xa4, 0x3c,
-		0x28, 0x17, 0x40, 0xbc, 0xe9, 0x70, 0xe0, 0xbe, 0x70, 0x2f, 0x91, 0xeb, 0x9b, 0xdf, 0xfc, 0xec,
-		0xe9, 0xe3, 0x81, 0x2e, 0xf6, 0xbc, 0x0c, 0x19, 0x5b, 0x1e, 0xf9, 0x33, 0x31, 0x40, 0xbe, 0xb1,
-		0xf1, 0xee, 0x83, 0xaf, 0xd0, 0x5b, 0xc2, 0xc2, 0xdf, 0x8f, 0x1d, 0x1c, 0x47, 0xf8, 0xf8, 0xa1,
-		0x38, 0x22, 0xb0, 0x99, 0xde, 0xee, 0xab, 0x76, 0xf1, 0xf5, 0xf7, 0x80, 0xb7, 0x5a, 0xe7, 0x6b,
-		0xa6, 0x1e, 0x22, 0x21, 0x90, 0xe8, 0x1e, 0x1d, 0x93, 0x5f, 0x8d, 0xc1, 0xb1, 0x3e, 0x49, 0xf4,
-		0x86, 0xdc, 0x04, 0x64, 0x07, 0x1a, 0xf9, 0x9f, 0xe3, 0x8f, 0xf1, 0x8f, 0x1d, 0x6f, 0x47, 0xb0,
-		0x27, 0xec, 0x3e, 0x75, 0xfe, 0x26, 0xd9, 0x28, 0xae, 0x84, 0x7e, 0x27, 0x06, 0x53, 0xc1, 0x11,
-		0x79, 0x73, 0x6b, 0xc0, 0x78, 0x70, 0x2c, 0x7c, 0x56, 0xf7, 0x8e, 0x32, 0xab, 0xe0, 0x84, 0x42,
-		0x44, 0xc8, 0x5c, 0x84, 0xd4, 0xb3, 0x5c, 0xdd, 0x23, 0x23, 0x73, 0xc9, 0x3b, 0x87, 0x18, 0xa4,
-		0x06, 0xd8, 0x62, 0xbd, 0x3f, 0x0e, 0xc9, 0x75, 0xd3, 0x6c, 0xa3, 0x1f, 0x8b, 0xc1, 0x84, 0x61,
-		0xba, 0x2a, 0xd9, 0x2b, 0xb8, 0xa5, 0xf2, 0x64, 0x01, 0xd3, 0xa4, 0x57, 0x0f, 0xc7, 0xbd, 0x6f,
-		0xbf, 0x3a, 0xdb, 0x4f, 0x6a, 0xd0, 0x13, 0xbb, 0x25, 0xc3, 0x74, 0xab, 0x14, 0x68, 0x83, 0xe5,
-		0x13, 0x6e, 0x40, 0x21, 0xdc, 0x3f, 0x53, 0xbf, 0xca, 0xa1, 0xfb, 0x2f, 0x44, 0xf6, 0x3d, 0xbe,
-		0x15, 0xe8, 0x98, 0x3d, 0x74, 0xf9, 0xa7, 0x64, 0x71, 0x9f, 0x07, 0xe9, 0x6a, 0xef, 0xcd, 0xb4,
-		0x3a, 0x64, 0x0e, 0x7b, 0xc9, 0x2d, 0xc8, 0x71, 0x8e, 0x7b, 0xfa, 0x0b, 0x31, 0x00, 0x3f, 0x35,
-		0x83, 0x1e, 0x84, 0xa3, 0xd5, 0xb5, 0xd5, 0x45, 0xb5, 0xb1, 0x51, 0xd9, 0xd8, 0x6c, 0x84, 0xdf,
-		0x65, 0x17, 0x6f, 0x91, 0x38, 0x16, 0x6e, 0xea, 0xdb, 0x3a, 0x6e, 0xa1, 0xfb, 0x61, 0x2a, 0x0c,
-		0x4d, 0x4a, 0xf5, 0x45, 0x29, 0x56, 0x1e, 0xbf, 0x79, 0x6b, 0x2e, 0xcb, 0x5c, 0x52, 0xdc, 0x42,
-		0x27, 0xe1, 0x8e, 0x7e, 0xb8, 0xa5, 0xd5, 0x4b, 0x52, 0xbc, 0x5c, 0xb8, 0x79, 0x6b, 0x2e, 0xe7,
-		0xf9, 0xae, 0x48, 0x06, 0x14, 0x84, 0xe4, 0xf4, 0x12, 0x65, 0xb8, 0x79, 0x6b, 0x2e, 0xcd, 0x96,
-		0xa5, 0x9c, 0x7c, 0xdf, 0x27, 0x66, 0xc6, 0x4e, 0xbf, 0x13, 0x60, 0xc9, 0xd8, 0xb6, 0x35, 0xfa,
-		0xc7, 0x89, 0x51, 0x19, 0x8e, 0x2c, 0xad, 0x5e, 0x54, 0x2a, 0xb5, 0x8d, 0xa5, 0xb5, 0xd5, 0x9e,
-		0xe7, 0xe4, 0xc3, 0x6d, 0x8b, 0x6b, 0x9b, 0xd5, 0xe5, 0xba, 0xda, 0x58, 0xba, 0xb4, 0xca, 0x4e,
-		0x78, 0x43, 0x6d, 0xcf, 0xae, 0x6e, 0x2c, 0xad, 0xd4, 0xa5, 0x78, 0xf5, 0xe2, 0xd0, 0x33, 0x86,
-		0x07, 0x0f, 0x5c, 0x70, 0x5f, 0x4b, 0x86, 0x0e, 0x1a, 0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-		0x46, 0xb1, 0xfa, 0xc1, 0x34, 0x97, 0x00, 0x00,
+		// 11025 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6b, 0x90, 0x23, 0xe7,
+		0x71, 0xd8, 0xe2, 0xb9, 0x40, 0x03, 0x58, 0xcc, 0xce, 0xee, 0x1d, 0x71, 0x38, 0xf2, 0x6e, 0x39,
+		0x7c, 0x1d, 0x8f, 0xe4, 0x1e, 0x79, 0xe4, 0x1d, 0x49, 0x50, 0x14, 0x0d, 0x60, 0x71, 0x77, 0x38,
+		0xee, 0x4b, 0x83, 0xdd, 0xe3, 0xc3, 0x96, 0x46, 0xb3, 0xc0, 0xb7, 0xbb, 0xc3, 0x03, 0x66, 0x20,
+		0xcc, 0xe0, 0x6e, 0x97, 0xe5, 0x4a, 0xd1, 0x96, 0xed, 0xc8, 0x17, 0x47, 0x96, 0xa3, 0x94, 0x2d,
+		0x4b, 0x3a, 0x45, 0xb2, 0x63, 0x49, 0x76, 0x5e, 0xb2, 0xa5, 0x48, 0x72, 0x54, 0x76, 0xd9, 0xa9,
+		0x3c, 0x64, 0x27, 0x95, 0x92, 0x5d, 0xa9, 0xc4, 0x95, 0x4a, 0x18, 0x9b, 0x72, 0x45, 0x8a, 0x24,
+		0xc7, 0xb6, 0x42, 0x27, 0x71, 0x54, 0x79, 0x54, 0x7f, 0x8f, 0x79, 0xe0, 0xb1, 0x83, 0xa5, 0x8e,
+		0x8c, 0x52, 0xfa, 0x73, 0x87, 0xe9, 0xaf, 0xbb, 0xbf, 0xef, 0xeb, 0xaf, 0xbf, 0xfe, 0xba, 0xfb,
+		0x7b, 0x2c, 0xfc, 0x51, 0x05, 0x16, 0x76, 0x2c, 0x6b, 0xa7, 0x4d, 0xce, 0x74, 0x7b, 0x96, 0x63,
+		0x6d, 0xf5, 0xb7, 0xcf, 0xb4, 0x88, 0xdd, 0xec, 0x19, 0x5d, 0xc7, 0xea, 0x2d, 0x52, 0x98, 0x9c,
+		0x67, 0x18, 0x8b, 0x02, 0x43, 0x59, 0x81, 0xd9, 0x0b, 0x46, 0x9b, 0x2c, 0xb9, 0x88, 0x0d, 0xe2,
+		0xc8, 0x4f, 0x40, 0x7c, 0xdb, 0x68, 0x93, 0x42, 0x64, 0x21, 0x76, 0x2a, 0x73, 0xf6, 0xee, 0xc5,
+		0x01, 0xa2, 0xc5, 0x20, 0xc5, 0x3a, 0x82, 0x55, 0x4a, 0xa1, 0xfc, 0xef, 0x38, 0xcc, 0x8d, 0x28,
+		0x95, 0x65, 0x88, 0x9b, 0x7a, 0x07, 0x39, 0x46, 0x4e, 0xa5, 0x55, 0xfa, 0x5b, 0x2e, 0xc0, 0x74,
+		0x57, 0x6f, 0x5e, 0xd5, 0x77, 0x48, 0x21, 0x4a, 0xc1, 0xe2, 0x53, 0x3e, 0x01, 0xd0, 0x22, 0x5d,
+		0x62, 0xb6, 0x88, 0xd9, 0xdc, 0x2f, 0xc4, 0x16, 0x62, 0xa7, 0xd2, 0xaa, 0x0f, 0x22, 0x3f, 0x00,
+		0xb3, 0xdd, 0xfe, 0x56, 0xdb, 0x68, 0x6a, 0x3e, 0x34, 0x58, 0x88, 0x9d, 0x4a, 0xa8, 0x12, 0x2b,
+		0x58, 0xf2, 0x90, 0xef, 0x83, 0xfc, 0x75, 0xa2, 0x5f, 0xf5, 0xa3, 0x66, 0x28, 0xea, 0x0c, 0x82,
+		0x7d, 0x88, 0x55, 0xc8, 0x76, 0x88, 0x6d, 0xeb, 0x3b, 0x44, 0x73, 0xf6, 0xbb, 0xa4, 0x10, 0xa7,
+		0xbd, 0x5f, 0x18, 0xea, 0xfd, 0x60, 0xcf, 0x33, 0x9c, 0x6a, 0x63, 0xbf, 0x4b, 0xe4, 0x32, 0xa4,
+		0x89, 0xd9, 0xef, 0x30, 0x0e, 0x89, 0x31, 0xf2, 0xab, 0x99, 0xfd, 0xce, 0x20, 0x97, 0x14, 0x92,
+		0x71, 0x16, 0xd3, 0x36, 0xe9, 0x5d, 0x33, 0x9a, 0xa4, 0x90, 0xa4, 0x0c, 0xee, 0x1b, 0x62, 0xd0,
+		0x60, 0xe5, 0x83, 0x3c, 0x04, 0x9d, 0x5c, 0x85, 0x34, 0xd9, 0x73, 0x88, 0x69, 0x1b, 0x96, 0x59,
+		0x98, 0xa6, 0x4c, 0xee, 0x19, 0x31, 0x8a, 0xa4, 0xdd, 0x1a, 0x64, 0xe1, 0xd1, 0xc9, 0xe7, 0x61,
+		0xda, 0xea, 0x3a, 0x86, 0x65, 0xda, 0x85, 0xd4, 0x42, 0xe4, 0x54, 0xe6, 0xec, 0xed, 0x23, 0x15,
+		0x61, 0x8d, 0xe1, 0xa8, 0x02, 0x59, 0xae, 0x83, 0x64, 0x5b, 0xfd, 0x5e, 0x93, 0x68, 0x4d, 0xab,
+		0x45, 0x34, 0xc3, 0xdc, 0xb6, 0x0a, 0x69, 0xca, 0xe0, 0xe4, 0x70, 0x47, 0x28, 0x62, 0xd5, 0x6a,
+		0x91, 0xba, 0xb9, 0x6d, 0xa9, 0x33, 0x76, 0xe0, 0x5b, 0x3e, 0x0a, 0x49, 0x7b, 0xdf, 0x74, 0xf4,
+		0xbd, 0x42, 0x96, 0x6a, 0x08, 0xff, 0x42, 0xd5, 0x21, 0x2d, 0x03, 0xab, 0x2b, 0xe4, 0x98, 0xea,
+		0xf0, 0x4f, 0xe5, 0xd7, 0x93, 0x90, 0x9f, 0x44, 0xf9, 0x9e, 0x82, 0xc4, 0x36, 0xf6, 0xbf, 0x10,
+		0x3d, 0x8c, 0x74, 0x18, 0x4d, 0x50, 0xbc, 0xc9, 0x37, 0x28, 0xde, 0x32, 0x64, 0x4c, 0x62, 0x3b,
+		0xa4, 0xc5, 0x74, 0x25, 0x36, 0xa1, 0xb6, 0x01, 0x23, 0x1a, 0x56, 0xb6, 0xf8, 0x1b, 0x52, 0xb6,
+		0xe7, 0x21, 0xef, 0x36, 0x49, 0xeb, 0xe9, 0xe6, 0x8e, 0xd0, 0xda, 0x33, 0x61, 0x2d, 0x59, 0xac,
+		0x09, 0x3a, 0x15, 0xc9, 0xd4, 0x19, 0x12, 0xf8, 0x96, 0x97, 0x00, 0x2c, 0x93, 0x58, 0xdb, 0x5a,
+		0x8b, 0x34, 0xdb, 0x85, 0xd4, 0x18, 0x29, 0xad, 0x21, 0xca, 0x90, 0x94, 0x2c, 0x06, 0x6d, 0xb6,
+		0xe5, 0x27, 0x3d, 0x25, 0x9c, 0x1e, 0xa3, 0x43, 0x2b, 0x6c, 0xfa, 0x0d, 0xe9, 0xe1, 0x26, 0xcc,
+		0xf4, 0x08, 0xce, 0x08, 0xd2, 0xe2, 0x3d, 0x4b, 0xd3, 0x46, 0x2c, 0x86, 0xf6, 0x4c, 0xe5, 0x64,
+		0xac, 0x63, 0xb9, 0x9e, 0xff, 0x53, 0xbe, 0x0b, 0x5c, 0x80, 0x46, 0xd5, 0x0a, 0xa8, 0x7d, 0xca,
+		0x0a, 0xe0, 0xaa, 0xde, 0x21, 0xc5, 0x97, 0x61, 0x26, 0x28, 0x1e, 0x79, 0x1e, 0x12, 0xb6, 0xa3,
+		0xf7, 0x1c, 0xaa, 0x85, 0x09, 0x95, 0x7d, 0xc8, 0x12, 0xc4, 0x88, 0xd9, 0xa2, 0xf6, 0x2f, 0xa1,
+		0xe2, 0x4f, 0xf9, 0x07, 0xbc, 0x0e, 0xc7, 0x68, 0x87, 0xef, 0x1d, 0x1e, 0xd1, 0x00, 0xe7, 0xc1,
+		0x7e, 0x17, 0x1f, 0x87, 0x5c, 0xa0, 0x03, 0x93, 0x56, 0xad, 0xfc, 0x30, 0x1c, 0x19, 0xc9, 0x5a,
+		0x7e, 0x1e, 0xe6, 0xfb, 0xa6, 0x61, 0x3a, 0xa4, 0xd7, 0xed, 0x11, 0xd4, 0x58, 0x56, 0x55, 0xe1,
+		0x6b, 0xd3, 0x63, 0x74, 0x6e, 0xd3, 0x8f, 0xcd, 0xb8, 0xa8, 0x73, 0xfd, 0x61, 0xe0, 0xe9, 0x74,
+		0xea, 0xeb, 0xd3, 0xd2, 0x2b, 0xaf, 0xbc, 0xf2, 0x4a, 0x54, 0xf9, 0xed, 0x24, 0xcc, 0x8f, 0x9a,
+		0x33, 0x23, 0xa7, 0xef, 0x51, 0x48, 0x9a, 0xfd, 0xce, 0x16, 0xe9, 0x51, 0x21, 0x25, 0x54, 0xfe,
+		0x25, 0x97, 0x21, 0xd1, 0xd6, 0xb7, 0x48, 0xbb, 0x10, 0x5f, 0x88, 0x9c, 0x9a, 0x39, 0xfb, 0xc0,
+		0x44, 0xb3, 0x72, 0x71, 0x19, 0x49, 0x54, 0x46, 0x29, 0xbf, 0x1d, 0xe2, 0xdc, 0x78, 0x23, 0x87,
+		0xd3, 0x93, 0x71, 0xc0, 0xb9, 0xa4, 0x52, 0x3a, 0xf9, 0x38, 0xa4, 0xf1, 0x7f, 0xa6, 0x1b, 0x49,
+		0xda, 0xe6, 0x14, 0x02, 0x50, 0x2f, 0xe4, 0x22, 0xa4, 0xe8, 0x34, 0x69, 0x11, 0xb1, 0xe8, 0xb9,
+		0xdf, 0xa8, 0x58, 0x2d, 0xb2, 0xad, 0xf7, 0xdb, 0x8e, 0x76, 0x4d, 0x6f, 0xf7, 0x09, 0x55, 0xf8,
+		0xb4, 0x9a, 0xe5, 0xc0, 0x2b, 0x08, 0x93, 0x4f, 0x42, 0x86, 0xcd, 0x2a, 0xc3, 0x6c, 0x91, 0x3d,
+		0x6a, 0x57, 0x13, 0x2a, 0x9b, 0x68, 0x75, 0x84, 0x60, 0xf5, 0x2f, 0xd9, 0x96, 0x29, 0x54, 0x93,
+		0x56, 0x81, 0x00, 0x5a, 0xfd, 0xe3, 0x83, 0x26, 0xfd, 0x8e, 0xd1, 0xdd, 0x1b, 0x9a, 0x4b, 0xf7,
+		0x41, 0x9e, 0x62, 0x3c, 0xca, 0x87, 0x5e, 0x6f, 0x17, 0x66, 0x17, 0x22, 0xa7, 0x52, 0xea, 0x0c,
+		0x03, 0xaf, 0x71, 0xa8, 0xf2, 0xf9, 0x28, 0xc4, 0xa9, 0x61, 0xc9, 0x43, 0x66, 0xe3, 0x85, 0xf5,
+		0x9a, 0xb6, 0xb4, 0xb6, 0x59, 0x59, 0xae, 0x49, 0x11, 0x79, 0x06, 0x80, 0x02, 0x2e, 0x2c, 0xaf,
+		0x95, 0x37, 0xa4, 0xa8, 0xfb, 0x5d, 0x5f, 0xdd, 0x38, 0xff, 0x98, 0x14, 0x73, 0x09, 0x36, 0x19,
+		0x20, 0xee, 0x47, 0x78, 0xf4, 0xac, 0x94, 0x90, 0x25, 0xc8, 0x32, 0x06, 0xf5, 0xe7, 0x6b, 0x4b,
+		0xe7, 0x1f, 0x93, 0x92, 0x41, 0xc8, 0xa3, 0x67, 0xa5, 0x69, 0x39, 0x07, 0x69, 0x0a, 0xa9, 0xac,
+		0xad, 0x2d, 0x4b, 0x29, 0x97, 0x67, 0x63, 0x43, 0xad, 0xaf, 0x5e, 0x94, 0xd2, 0x2e, 0xcf, 0x8b,
+		0xea, 0xda, 0xe6, 0xba, 0x04, 0x2e, 0x87, 0x95, 0x5a, 0xa3, 0x51, 0xbe, 0x58, 0x93, 0x32, 0x2e,
+		0x46, 0xe5, 0x85, 0x8d, 0x5a, 0x43, 0xca, 0x06, 0x9a, 0xf5, 0xe8, 0x59, 0x29, 0xe7, 0x56, 0x51,
+		0x5b, 0xdd, 0x5c, 0x91, 0x66, 0xe4, 0x59, 0xc8, 0xb1, 0x2a, 0x44, 0x23, 0xf2, 0x03, 0xa0, 0xf3,
+		0x8f, 0x49, 0x92, 0xd7, 0x10, 0xc6, 0x65, 0x36, 0x00, 0x38, 0xff, 0x98, 0x24, 0x2b, 0x55, 0x48,
+		0x50, 0x35, 0x94, 0x65, 0x98, 0x59, 0x2e, 0x57, 0x6a, 0xcb, 0xda, 0xda, 0xfa, 0x46, 0x7d, 0x6d,
+		0xb5, 0xbc, 0x2c, 0x45, 0x3c, 0x98, 0x5a, 0x7b, 0xc7, 0x66, 0x5d, 0xad, 0x2d, 0x49, 0x51, 0x3f,
+		0x6c, 0xbd, 0x56, 0xde, 0xa8, 0x2d, 0x49, 0x31, 0xa5, 0x09, 0xf3, 0xa3, 0x0c, 0xea, 0xc8, 0x29,
+		0xe4, 0xd3, 0x85, 0xe8, 0x18, 0x5d, 0xa0, 0xbc, 0x06, 0x75, 0x41, 0xf9, 0x6a, 0x14, 0xe6, 0x46,
+		0x2c, 0x2a, 0x23, 0x2b, 0x79, 0x06, 0x12, 0x4c, 0x97, 0xd9, 0x32, 0x7b, 0xff, 0xc8, 0xd5, 0x89,
+		0x6a, 0xf6, 0xd0, 0x52, 0x4b, 0xe9, 0xfc, 0x4e, 0x48, 0x6c, 0x8c, 0x13, 0x82, 0x2c, 0x86, 0x14,
+		0xf6, 0x9d, 0x43, 0xc6, 0x9f, 0xad, 0x8f, 0xe7, 0x27, 0x59, 0x1f, 0x29, 0xec, 0x70, 0x8b, 0x40,
+		0x62, 0xc4, 0x22, 0xf0, 0x14, 0xcc, 0x0e, 0x31, 0x9a, 0xd8, 0x18, 0xbf, 0x37, 0x02, 0x85, 0x71,
+		0xc2, 0x09, 0x31, 0x89, 0xd1, 0x80, 0x49, 0x7c, 0x6a, 0x50, 0x82, 0x77, 0x8e, 0x1f, 0x84, 0xa1,
+		0xb1, 0xfe, 0x54, 0x04, 0x8e, 0x8e, 0x76, 0x36, 0x47, 0xb6, 0xe1, 0xed, 0x90, 0xec, 0x10, 0x67,
+		0xd7, 0x12, 0x6e, 0xd5, 0xbd, 0x23, 0x16, 0x6b, 0x2c, 0x1e, 0x1c, 0x6c, 0x4e, 0x25, 0x3f, 0x39,
+		0xd8, 0xd6, 0x93, 0xe3, 0x5c, 0xdf, 0xa1, 0x96, 0xfe, 0x64, 0x14, 0x8e, 0x8c, 0x64, 0x3e, 0xb2,
+		0xa1, 0x77, 0x00, 0x18, 0x66, 0xb7, 0xef, 0x30, 0xd7, 0x89, 0x59, 0xe2, 0x34, 0x85, 0x50, 0xe3,
+		0x85, 0x56, 0xb6, 0xef, 0xb8, 0xe5, 0x31, 0x5a, 0x0e, 0x0c, 0x44, 0x11, 0x9e, 0xf0, 0x1a, 0x1a,
+		0xa7, 0x0d, 0x3d, 0x31, 0xa6, 0xa7, 0x43, 0x8a, 0xf9, 0x30, 0x48, 0xcd, 0xb6, 0x41, 0x4c, 0x47,
+		0xb3, 0x9d, 0x1e, 0xd1, 0x3b, 0x86, 0xb9, 0x43, 0x97, 0x9a, 0x54, 0x29, 0xb1, 0xad, 0xb7, 0x6d,
+		0xa2, 0xe6, 0x59, 0x71, 0x43, 0x94, 0x22, 0x05, 0x55, 0xa0, 0x9e, 0x8f, 0x22, 0x19, 0xa0, 0x60,
+		0xc5, 0x2e, 0x85, 0xf2, 0x33, 0x69, 0xc8, 0xf8, 0x5c, 0x73, 0xf9, 0x4e, 0xc8, 0xbe, 0xa4, 0x5f,
+		0xd3, 0x35, 0x11, 0x6e, 0x31, 0x49, 0x64, 0x10, 0xb6, 0xce, 0x40, 0xf2, 0xc3, 0x30, 0x4f, 0x51,
+		0xac, 0xbe, 0x43, 0x7a, 0x5a, 0xb3, 0xad, 0xdb, 0x36, 0x15, 0x5a, 0x8a, 0xa2, 0xca, 0x58, 0xb6,
+		0x86, 0x45, 0x55, 0x51, 0x22, 0x9f, 0x83, 0x39, 0x4a, 0xd1, 0xe9, 0xb7, 0x1d, 0xa3, 0xdb, 0x26,
+		0x1a, 0x06, 0x80, 0x76, 0x01, 0xfc, 0x2d, 0x9b, 0x45, 0x8c, 0x15, 0x8e, 0x80, 0x2d, 0xb2, 0xe5,
+		0x25, 0xb8, 0x83, 0x92, 0xed, 0x10, 0x93, 0xf4, 0x74, 0x87, 0x68, 0xe4, 0x3d, 0x7d, 0xbd, 0x6d,
+		0x6b, 0xba, 0xd9, 0xd2, 0x76, 0x75, 0x7b, 0xb7, 0x30, 0x8f, 0x0c, 0x2a, 0xd1, 0x42, 0x44, 0x3d,
+		0x86, 0x88, 0x17, 0x39, 0x5e, 0x8d, 0xa2, 0x95, 0xcd, 0xd6, 0x25, 0xdd, 0xde, 0x95, 0x4b, 0x70,
+		0x94, 0x72, 0xb1, 0x9d, 0x9e, 0x61, 0xee, 0x68, 0xcd, 0x5d, 0xd2, 0xbc, 0xaa, 0xf5, 0x9d, 0xed,
+		0x27, 0x0a, 0xc7, 0xfd, 0xf5, 0xd3, 0x16, 0x36, 0x28, 0x4e, 0x15, 0x51, 0x36, 0x9d, 0xed, 0x27,
+		0xe4, 0x06, 0x64, 0x71, 0x30, 0x3a, 0xc6, 0xcb, 0x44, 0xdb, 0xb6, 0x7a, 0x74, 0x0d, 0x9d, 0x19,
+		0x61, 0x9a, 0x7c, 0x12, 0x5c, 0x5c, 0xe3, 0x04, 0x2b, 0x56, 0x8b, 0x94, 0x12, 0x8d, 0xf5, 0x5a,
+		0x6d, 0x49, 0xcd, 0x08, 0x2e, 0x17, 0xac, 0x1e, 0x2a, 0xd4, 0x8e, 0xe5, 0x0a, 0x38, 0xc3, 0x14,
+		0x6a, 0xc7, 0x12, 0xe2, 0x3d, 0x07, 0x73, 0xcd, 0x26, 0xeb, 0xb3, 0xd1, 0xd4, 0x78, 0x98, 0x66,
+		0x17, 0xa4, 0x80, 0xb0, 0x9a, 0xcd, 0x8b, 0x0c, 0x81, 0xeb, 0xb8, 0x2d, 0x3f, 0x09, 0x47, 0x3c,
+		0x61, 0xf9, 0x09, 0x67, 0x87, 0x7a, 0x39, 0x48, 0x7a, 0x0e, 0xe6, 0xba, 0xfb, 0xc3, 0x84, 0x72,
+		0xa0, 0xc6, 0xee, 0xfe, 0x20, 0xd9, 0xe3, 0x30, 0xdf, 0xdd, 0xed, 0x0e, 0xd3, 0x9d, 0xf6, 0xd3,
+		0xc9, 0xdd, 0xdd, 0xee, 0x20, 0xe1, 0x3d, 0x34, 0x66, 0xef, 0x91, 0xa6, 0xee, 0x90, 0x56, 0xe1,
+		0x36, 0x3f, 0xba, 0xaf, 0x40, 0x5e, 0x04, 0xa9, 0xd9, 0xd4, 0x88, 0xa9, 0x6f, 0xb5, 0x89, 0xa6,
+		0xf7, 0x88, 0xa9, 0xdb, 0x85, 0x93, 0x14, 0x39, 0xee, 0xf4, 0xfa, 0x44, 0x9d, 0x69, 0x36, 0x6b,
+		0xb4, 0xb0, 0x4c, 0xcb, 0xe4, 0xd3, 0x30, 0x6b, 0x6d, 0xbd, 0xd4, 0x64, 0x1a, 0xa9, 0x75, 0x7b,
+		0x64, 0xdb, 0xd8, 0x2b, 0xdc, 0x4d, 0xc5, 0x9b, 0xc7, 0x02, 0xaa, 0x8f, 0xeb, 0x14, 0x2c, 0xdf,
+		0x0f, 0x52, 0xd3, 0xde, 0xd5, 0x7b, 0x5d, 0x6a, 0x92, 0xed, 0xae, 0xde, 0x24, 0x85, 0x7b, 0x18,
+		0x2a, 0x83, 0xaf, 0x0a, 0x30, 0xce, 0x08, 0xfb, 0xba, 0xb1, 0xed, 0x08, 0x8e, 0xf7, 0xb1, 0x19,
+		0x41, 0x61, 0x9c, 0xdb, 0x29, 0x90, 0x50, 0x12, 0x81, 0x8a, 0x4f, 0x51, 0xb4, 0x99, 0xee, 0x6e,
+		0xd7, 0x5f, 0xef, 0x5d, 0x90, 0xeb, 0xee, 0xfa, 0x2b, 0xbd, 0x9f, 0x39, 0x6e, 0xdd, 0x5d, 0x5f,
+		0x8d, 0x8f, 0xc1, 0x51, 0x44, 0xea, 0x10, 0x47, 0x6f, 0xe9, 0x8e, 0xee, 0xc3, 0x7e, 0x90, 0x62,
+		0xa3, 0xd8, 0x57, 0x78, 0x61, 0xa0, 0x9d, 0xbd, 0xfe, 0xd6, 0xbe, 0xab, 0x58, 0x0f, 0xb1, 0x76,
+		0x22, 0x4c, 0xa8, 0xd6, 0x9b, 0xe6, 0x9c, 0x2b, 0x25, 0xc8, 0xfa, 0xf5, 0x5e, 0x4e, 0x03, 0xd3,
+		0x7c, 0x29, 0x82, 0x4e, 0x50, 0x75, 0x6d, 0x09, 0xdd, 0x97, 0x17, 0x6b, 0x52, 0x14, 0xdd, 0xa8,
+		0xe5, 0xfa, 0x46, 0x4d, 0x53, 0x37, 0x57, 0x37, 0xea, 0x2b, 0x35, 0x29, 0xe6, 0x73, 0xec, 0x2f,
+		0xc7, 0x53, 0xf7, 0x4a, 0xf7, 0x29, 0x5f, 0x8a, 0xc1, 0x4c, 0x30, 0x52, 0x93, 0xdf, 0x06, 0xb7,
+		0x89, 0x84, 0x8b, 0x4d, 0x1c, 0xed, 0xba, 0xd1, 0xa3, 0x13, 0xb2, 0xa3, 0xb3, 0xc5, 0xd1, 0xd5,
+		0x9f, 0x79, 0x8e, 0xd5, 0x20, 0xce, 0x73, 0x46, 0x0f, 0xa7, 0x5b, 0x47, 0x77, 0xe4, 0x65, 0x38,
+		0x69, 0x5a, 0x9a, 0xed, 0xe8, 0x66, 0x4b, 0xef, 0xb5, 0x34, 0x2f, 0xd5, 0xa5, 0xe9, 0xcd, 0x26,
+		0xb1, 0x6d, 0x8b, 0x2d, 0x84, 0x2e, 0x97, 0xdb, 0x4d, 0xab, 0xc1, 0x91, 0xbd, 0x15, 0xa2, 0xcc,
+		0x51, 0x07, 0xd4, 0x37, 0x36, 0x4e, 0x7d, 0x8f, 0x43, 0xba, 0xa3, 0x77, 0x35, 0x62, 0x3a, 0xbd,
+		0x7d, 0xea, 0x9f, 0xa7, 0xd4, 0x54, 0x47, 0xef, 0xd6, 0xf0, 0x5b, 0xbe, 0x02, 0xf7, 0x7a, 0xa8,
+		0x5a, 0x9b, 0xec, 0xe8, 0xcd, 0x7d, 0x8d, 0x3a, 0xe3, 0x34, 0x6d, 0xa0, 0x35, 0x2d, 0x73, 0xbb,
+		0x6d, 0x34, 0x1d, 0xbb, 0x90, 0x71, 0x6d, 0x9c, 0xe2, 0x51, 0x2c, 0x53, 0x82, 0xcb, 0xb6, 0x65,
+		0x52, 0x1f, 0xbc, 0x2a, 0xb0, 0xdf, 0x92, 0xf0, 0xeb, 0x72, 0x3c, 0x15, 0x97, 0x12, 0x97, 0xe3,
+		0xa9, 0x84, 0x94, 0xbc, 0x1c, 0x4f, 0x25, 0xa5, 0xe9, 0xcb, 0xf1, 0x54, 0x4a, 0x4a, 0x5f, 0x8e,
+		0xa7, 0xd2, 0x12, 0x28, 0x5f, 0x48, 0x41, 0xd6, 0x1f, 0x19, 0x60, 0xa0, 0xd5, 0xa4, 0x6b, 0x63,
+		0x84, 0x5a, 0xcf, 0xbb, 0x0e, 0x8c, 0x23, 0x16, 0xab, 0xb8, 0x68, 0x96, 0x92, 0xcc, 0x0d, 0x57,
+		0x19, 0x25, 0x3a, 0x2c, 0xa8, 0xd6, 0x84, 0xb9, 0x3d, 0x29, 0x95, 0x7f, 0xc9, 0x17, 0x21, 0xf9,
+		0x92, 0x4d, 0x79, 0x27, 0x29, 0xef, 0xbb, 0x0f, 0xe6, 0x7d, 0xb9, 0x41, 0x99, 0xa7, 0x2f, 0x37,
+		0xb4, 0xd5, 0x35, 0x75, 0xa5, 0xbc, 0xac, 0x72, 0x72, 0xf9, 0x18, 0xc4, 0xdb, 0xfa, 0xcb, 0xfb,
+		0xc1, 0xe5, 0x95, 0x82, 0xe4, 0x45, 0xc8, 0xf7, 0xcd, 0x6b, 0xa4, 0x67, 0x6c, 0x1b, 0x38, 0x54,
+		0x88, 0x95, 0xf7, 0x63, 0xcd, 0x78, 0xa5, 0xcb, 0x88, 0x3f, 0xa1, 0x7a, 0x1c, 0x83, 0x38, 0x26,
+		0x15, 0x83, 0x8b, 0x20, 0x05, 0xc9, 0xa7, 0x20, 0xdb, 0x22, 0x5b, 0xfd, 0x1d, 0xad, 0x47, 0x5a,
+		0x7a, 0xd3, 0x09, 0x9a, 0xfe, 0x0c, 0x2d, 0x52, 0x69, 0x89, 0xfc, 0x2c, 0xa4, 0x71, 0x8c, 0x4c,
+		0x3a, 0xc6, 0xb3, 0x54, 0x04, 0x0f, 0x1d, 0x2c, 0x02, 0x3e, 0xc4, 0x82, 0x48, 0xf5, 0xe8, 0xe5,
+		0x0b, 0x90, 0x74, 0xf4, 0xde, 0x0e, 0x71, 0xa8, 0xe5, 0x9f, 0x39, 0xbb, 0x38, 0x09, 0xa7, 0x0d,
+		0x4a, 0x41, 0x63, 0x5a, 0x4e, 0xfd, 0x26, 0x5a, 0x99, 0x33, 0x90, 0xa0, 0xea, 0x21, 0x03, 0x70,
+		0x05, 0x91, 0xa6, 0xe4, 0x14, 0xc4, 0xab, 0x6b, 0x2a, 0x5a, 0x1a, 0x09, 0xb2, 0x0c, 0xaa, 0xad,
+		0xd7, 0x6b, 0xd5, 0x9a, 0x14, 0x55, 0xce, 0x41, 0x92, 0x8d, 0x39, 0x5a, 0x21, 0x77, 0xd4, 0xa5,
+		0x29, 0xfe, 0xc9, 0x79, 0x44, 0x44, 0xe9, 0xe6, 0x4a, 0xa5, 0xa6, 0x4a, 0x51, 0x65, 0x13, 0xf2,
+		0x03, 0x72, 0x92, 0x8f, 0xc0, 0xac, 0x5a, 0xdb, 0xa8, 0xad, 0x62, 0x9c, 0xa5, 0x6d, 0xae, 0x3e,
+		0xbb, 0xba, 0xf6, 0xdc, 0xaa, 0x34, 0x15, 0x04, 0x0b, 0x93, 0x16, 0x91, 0xe7, 0x41, 0xf2, 0xc0,
+		0x8d, 0xb5, 0x4d, 0x95, 0xb6, 0xe6, 0xa7, 0xa2, 0x20, 0x0d, 0x4a, 0x4d, 0xbe, 0x0d, 0xe6, 0x36,
+		0xca, 0xea, 0xc5, 0xda, 0x86, 0xc6, 0x62, 0x47, 0x97, 0xf5, 0x3c, 0x48, 0xfe, 0x82, 0x0b, 0x75,
+		0x1a, 0x1a, 0x9f, 0x84, 0xe3, 0x7e, 0x68, 0xed, 0xf9, 0x8d, 0xda, 0x6a, 0x83, 0x56, 0x5e, 0x5e,
+		0xbd, 0x88, 0xf6, 0x75, 0x80, 0x9f, 0x88, 0x56, 0x63, 0xd8, 0xd4, 0x20, 0xbf, 0xda, 0xf2, 0x92,
+		0x14, 0x1f, 0x04, 0xaf, 0xad, 0xd6, 0xd6, 0x2e, 0x48, 0x89, 0xc1, 0xda, 0x69, 0x04, 0x9b, 0x94,
+		0x8b, 0x70, 0x74, 0x10, 0xaa, 0xd5, 0x56, 0x37, 0xd4, 0x17, 0xa4, 0xe9, 0xc1, 0x8a, 0x1b, 0x35,
+		0xf5, 0x4a, 0xbd, 0x5a, 0x93, 0x52, 0xf2, 0x51, 0x90, 0x83, 0x2d, 0xda, 0xb8, 0xb4, 0xb6, 0x24,
+		0xa5, 0x87, 0x2c, 0x8a, 0x62, 0x43, 0xd6, 0x1f, 0x46, 0xbe, 0x35, 0xb9, 0xa4, 0x0f, 0x45, 0x21,
+		0xe3, 0x0b, 0x0b, 0xd1, 0x9f, 0xd7, 0xdb, 0x6d, 0xeb, 0xba, 0xa6, 0xb7, 0x0d, 0xdd, 0xe6, 0xf6,
+		0x06, 0x28, 0xa8, 0x8c, 0x90, 0x49, 0xe7, 0xf7, 0xe4, 0x16, 0x3e, 0xf9, 0xbd, 0x68, 0xe1, 0x13,
+		0x52, 0x52, 0xf9, 0x58, 0x04, 0xa4, 0xc1, 0x78, 0x6f, 0xa0, 0xfb, 0x91, 0x71, 0xdd, 0x7f, 0x4b,
+		0xc6, 0xee, 0xa3, 0x11, 0x98, 0x09, 0x06, 0x79, 0x03, 0xcd, 0xbb, 0xf3, 0xff, 0x69, 0xf3, 0xfe,
+		0x30, 0x0a, 0xb9, 0x40, 0x68, 0x37, 0x69, 0xeb, 0xde, 0x03, 0xb3, 0x46, 0x8b, 0x74, 0xba, 0x96,
+		0x83, 0xbb, 0x4d, 0x5a, 0x9b, 0x5c, 0x23, 0xed, 0x82, 0x42, 0x8d, 0xf2, 0x99, 0x83, 0x83, 0xc7,
+		0xc5, 0xba, 0x47, 0xb7, 0x8c, 0x64, 0xa5, 0xb9, 0xfa, 0x52, 0x6d, 0x65, 0x7d, 0x6d, 0xa3, 0xb6,
+		0x5a, 0x7d, 0x41, 0x58, 0x17, 0x55, 0x32, 0x06, 0xd0, 0xde, 0x44, 0xa3, 0xbd, 0x0e, 0xd2, 0x60,
+		0xa3, 0xd0, 0x56, 0x8c, 0x68, 0x96, 0x34, 0x25, 0xcf, 0x41, 0x7e, 0x75, 0x4d, 0x6b, 0xd4, 0x97,
+		0x6a, 0x5a, 0xed, 0xc2, 0x85, 0x5a, 0x75, 0xa3, 0xc1, 0xd2, 0x81, 0x2e, 0xf6, 0x86, 0x14, 0xf5,
+		0x8b, 0xf8, 0xc3, 0x31, 0x98, 0x1b, 0xd1, 0x12, 0xb9, 0xcc, 0x03, 0x79, 0x96, 0x5b, 0x78, 0x68,
+		0x92, 0xd6, 0x2f, 0xa2, 0x2b, 0xbd, 0xae, 0xf7, 0x1c, 0x1e, 0xf7, 0xdf, 0x0f, 0x28, 0x25, 0xd3,
+		0xc1, 0x95, 0xbd, 0xc7, 0xd3, 0xac, 0x2c, 0xba, 0xcf, 0x7b, 0x70, 0x96, 0x69, 0x7d, 0x10, 0xe4,
+		0xae, 0x65, 0x1b, 0x8e, 0x71, 0x0d, 0xf7, 0xb0, 0x44, 0x4e, 0x16, 0xa3, 0xfd, 0xb8, 0x2a, 0x89,
+		0x92, 0xba, 0xe9, 0xb8, 0xd8, 0x26, 0xd9, 0xd1, 0x07, 0xb0, 0xd1, 0xf3, 0x88, 0xa9, 0x92, 0x28,
+		0x71, 0xb1, 0xef, 0x84, 0x6c, 0xcb, 0xea, 0x63, 0x08, 0xc4, 0xf0, 0xd0, 0x5a, 0x44, 0xd4, 0x0c,
+		0x83, 0xb9, 0x28, 0x3c, 0xb8, 0xf5, 0x92, 0xc1, 0x59, 0x35, 0xc3, 0x60, 0x0c, 0xe5, 0x3e, 0xc8,
+		0xeb, 0x3b, 0x3b, 0x3d, 0x64, 0x2e, 0x18, 0xb1, 0x70, 0x7d, 0xc6, 0x05, 0x53, 0xc4, 0xe2, 0x65,
+		0x48, 0x09, 0x39, 0xa0, 0x07, 0x8b, 0x92, 0xd0, 0xba, 0x2c, 0x07, 0x15, 0xc5, 0xfc, 0xb0, 0x29,
+		0x0a, 0xef, 0x84, 0xac, 0x61, 0x6b, 0xde, 0xde, 0x56, 0x74, 0x21, 0x7a, 0x2a, 0xa5, 0x66, 0x0c,
+		0xdb, 0xdd, 0x17, 0x50, 0x3e, 0x15, 0x85, 0x99, 0xe0, 0xae, 0x9d, 0xbc, 0x04, 0xa9, 0xb6, 0xd5,
+		0xd4, 0xa9, 0x6a, 0xb1, 0x2d, 0xe3, 0x53, 0x21, 0x1b, 0x7d, 0x8b, 0xcb, 0x1c, 0x5f, 0x75, 0x29,
+		0x8b, 0xff, 0x2a, 0x02, 0x29, 0x01, 0x96, 0x8f, 0x42, 0xbc, 0xab, 0x3b, 0xbb, 0x94, 0x5d, 0xa2,
+		0x12, 0x95, 0x22, 0x2a, 0xfd, 0x46, 0xb8, 0xdd, 0xd5, 0xcd, 0x42, 0xd4, 0x83, 0xe3, 0x37, 0x8e,
+		0x6b, 0x9b, 0xe8, 0x2d, 0x9a, 0x0b, 0xb0, 0x3a, 0x1d, 0x62, 0x3a, 0xb6, 0x18, 0x57, 0x0e, 0xaf,
+		0x72, 0x30, 0x6e, 0x1e, 0x3b, 0x3d, 0xdd, 0x68, 0x07, 0x70, 0xe3, 0x14, 0x57, 0x12, 0x05, 0x2e,
+		0x72, 0x09, 0x8e, 0x09, 0xbe, 0x2d, 0xe2, 0xe8, 0xcd, 0x5d, 0xd2, 0xf2, 0x88, 0x92, 0x34, 0xe7,
+		0x77, 0x1b, 0x47, 0x58, 0xe2, 0xe5, 0x82, 0x56, 0xf9, 0x4a, 0x14, 0x66, 0x45, 0xf6, 0xa2, 0xe5,
+		0x0a, 0x6b, 0x05, 0x40, 0x37, 0x4d, 0xcb, 0xf1, 0x8b, 0x6b, 0x58, 0x95, 0x87, 0xe8, 0x16, 0xcb,
+		0x2e, 0x91, 0xea, 0x63, 0x50, 0xfc, 0x66, 0x04, 0xc0, 0x2b, 0x1a, 0x2b, 0xb7, 0x93, 0x90, 0xe1,
+		0x7b, 0xb2, 0x74, 0x63, 0x9f, 0x25, 0xbc, 0x80, 0x81, 0x30, 0xcf, 0x81, 0x69, 0xc9, 0x2d, 0xb2,
+		0x63, 0x98, 0x7c, 0x3f, 0x85, 0x7d, 0x88, 0xb4, 0x64, 0xdc, 0xdb, 0x9e, 0x52, 0x21, 0x65, 0x93,
+		0x8e, 0x6e, 0x3a, 0x46, 0x93, 0xef, 0x90, 0x9c, 0x3f, 0x54, 0xe3, 0x17, 0x1b, 0x9c, 0x5a, 0x75,
+		0xf9, 0x28, 0xa7, 0x20, 0x25, 0xa0, 0xe8, 0xf8, 0xad, 0xae, 0xad, 0xd6, 0xa4, 0x29, 0x79, 0x1a,
+		0x62, 0x8d, 0xda, 0x86, 0x14, 0xc1, 0xb0, 0xb3, 0xbc, 0x5c, 0x2f, 0x37, 0xa4, 0x68, 0xe5, 0xaf,
+		0xc0, 0x5c, 0xd3, 0xea, 0x0c, 0x56, 0x58, 0x91, 0x06, 0x52, 0x7e, 0xf6, 0xa5, 0xc8, 0x8b, 0x0f,
+		0x71, 0xa4, 0x1d, 0xab, 0xad, 0x9b, 0x3b, 0x8b, 0x56, 0x6f, 0xc7, 0x3b, 0x16, 0x81, 0xd1, 0x81,
+		0xed, 0x3b, 0x1c, 0xd1, 0xdd, 0xfa, 0x1f, 0x91, 0xc8, 0x2f, 0x44, 0x63, 0x17, 0xd7, 0x2b, 0xbf,
+		0x12, 0x2d, 0x5e, 0x64, 0x84, 0xeb, 0xa2, 0x3b, 0x2a, 0xd9, 0x6e, 0x93, 0x26, 0x36, 0x1e, 0xfe,
+		0xe4, 0x01, 0x98, 0xdf, 0xb1, 0x76, 0x2c, 0xca, 0xe9, 0x0c, 0xfe, 0x62, 0x8d, 0x90, 0xd3, 0x2e,
+		0xb4, 0x18, 0x7a, 0x08, 0xa3, 0xb4, 0x0a, 0x73, 0x1c, 0x59, 0xa3, 0xdb, 0xb7, 0x2c, 0xb9, 0x20,
+		0x1f, 0x98, 0xd9, 0x2e, 0xfc, 0xea, 0x1f, 0x53, 0xaf, 0x44, 0x9d, 0xe5, 0xa4, 0x58, 0xc6, 0xf2,
+		0x0f, 0x25, 0x15, 0x8e, 0x04, 0xf8, 0x31, 0x1b, 0x41, 0x7a, 0x21, 0x1c, 0xff, 0x29, 0xe7, 0x38,
+		0xe7, 0xe3, 0xd8, 0xe0, 0xa4, 0xa5, 0x2a, 0xe4, 0x0e, 0xc3, 0xeb, 0x9f, 0x71, 0x5e, 0x59, 0xe2,
+		0x67, 0x72, 0x11, 0xf2, 0x94, 0x49, 0xb3, 0x6f, 0x3b, 0x56, 0x87, 0x1a, 0xe0, 0x83, 0xd9, 0xfc,
+		0xf3, 0x3f, 0x66, 0x93, 0x76, 0x06, 0xc9, 0xaa, 0x2e, 0x55, 0xa9, 0x04, 0x74, 0xc7, 0x1a, 0x77,
+		0x92, 0x43, 0x38, 0x7c, 0x99, 0x37, 0xc4, 0xc5, 0x2f, 0x5d, 0x81, 0x79, 0xfc, 0x4d, 0xed, 0xa3,
+		0xbf, 0x25, 0xe1, 0x69, 0xf0, 0xc2, 0xef, 0xbd, 0x97, 0xd9, 0x85, 0x39, 0x97, 0x81, 0xaf, 0x4d,
+		0xbe, 0x51, 0xdc, 0x21, 0x8e, 0x43, 0x7a, 0xb6, 0xa6, 0xb7, 0x47, 0x35, 0xcf, 0x97, 0x47, 0x2c,
+		0xfc, 0xfc, 0xb7, 0x82, 0xa3, 0x78, 0x91, 0x51, 0x96, 0xdb, 0xed, 0xd2, 0x26, 0xdc, 0x36, 0x42,
+		0x2b, 0x26, 0xe0, 0xf9, 0x61, 0xce, 0x73, 0x7e, 0x48, 0x33, 0x90, 0xed, 0x3a, 0x08, 0xb8, 0x3b,
+		0x96, 0x13, 0xf0, 0xfc, 0x08, 0xe7, 0x29, 0x73, 0x5a, 0x31, 0xa4, 0xc8, 0xf1, 0x32, 0xcc, 0x5e,
+		0x23, 0xbd, 0x2d, 0xcb, 0xe6, 0xb9, 0xdb, 0x09, 0xd8, 0x7d, 0x94, 0xb3, 0xcb, 0x73, 0x42, 0x9a,
+		0xcc, 0x45, 0x5e, 0x4f, 0x42, 0x6a, 0x5b, 0x6f, 0x92, 0x09, 0x58, 0xdc, 0xe4, 0x2c, 0xa6, 0x11,
+		0x1f, 0x49, 0xcb, 0x90, 0xdd, 0xb1, 0xf8, 0x12, 0x19, 0x4e, 0xfe, 0x31, 0x4e, 0x9e, 0x11, 0x34,
+		0x9c, 0x45, 0xd7, 0xea, 0xf6, 0xdb, 0xb8, 0x7e, 0x86, 0xb3, 0xf8, 0x5b, 0x82, 0x85, 0xa0, 0xe1,
+		0x2c, 0x0e, 0x21, 0xd6, 0x8f, 0x0b, 0x16, 0xb6, 0x4f, 0x9e, 0xcf, 0xe0, 0x96, 0x6e, 0x7b, 0xdf,
+		0x32, 0x27, 0x69, 0xc4, 0x27, 0x38, 0x07, 0xe0, 0x24, 0xc8, 0xe0, 0x29, 0x48, 0x4f, 0x3a, 0x10,
+		0xbf, 0xf4, 0x2d, 0x31, 0x3d, 0xc4, 0x08, 0x5c, 0x84, 0xbc, 0x30, 0x50, 0x78, 0x04, 0x24, 0x9c,
+		0xc5, 0x27, 0x39, 0x8b, 0x19, 0x1f, 0x19, 0xef, 0x86, 0x43, 0x6c, 0x67, 0x87, 0x4c, 0xc2, 0xe4,
+		0x53, 0xa2, 0x1b, 0x9c, 0x84, 0x8b, 0x72, 0x8b, 0x98, 0xcd, 0xdd, 0xc9, 0x38, 0x7c, 0x5a, 0x88,
+		0x52, 0xd0, 0x20, 0x8b, 0x2a, 0xe4, 0x3a, 0x7a, 0xcf, 0xde, 0xd5, 0xdb, 0x13, 0x0d, 0xc7, 0x2f,
+		0x73, 0x1e, 0x59, 0x97, 0x88, 0x4b, 0xa4, 0x6f, 0x1e, 0x86, 0xcd, 0xaf, 0x08, 0x89, 0xf4, 0xcd,
+		0x00, 0xa3, 0x75, 0x98, 0xb7, 0x1d, 0x9a, 0xe8, 0x3e, 0x0c, 0xb7, 0xbf, 0x23, 0xa6, 0x1e, 0xa3,
+		0x5d, 0xf1, 0x73, 0x7c, 0x0a, 0xd2, 0xb6, 0xf1, 0xf2, 0x44, 0x6c, 0xfe, 0xae, 0x18, 0x69, 0x4a,
+		0x80, 0xc4, 0x2f, 0xc0, 0xb1, 0x91, 0xcb, 0xc4, 0x04, 0xcc, 0xfe, 0x1e, 0x67, 0x76, 0x74, 0xc4,
+		0x52, 0xc1, 0x4d, 0xc2, 0x61, 0x59, 0xfe, 0x7d, 0x61, 0x12, 0xc8, 0x00, 0xaf, 0x75, 0x0c, 0x5a,
+		0x6c, 0x7d, 0xfb, 0x70, 0x52, 0xfb, 0x07, 0x42, 0x6a, 0x8c, 0x36, 0x20, 0xb5, 0x0d, 0x38, 0xca,
+		0x39, 0x1e, 0x6e, 0x5c, 0x3f, 0x23, 0x0c, 0x2b, 0xa3, 0xde, 0x0c, 0x8e, 0xee, 0x0f, 0x42, 0xd1,
+		0x15, 0xa7, 0xf0, 0x8e, 0x6d, 0x0d, 0xb3, 0xc3, 0xe1, 0x9c, 0x7f, 0x95, 0x73, 0x16, 0x16, 0xdf,
+		0x75, 0xaf, 0xed, 0x15, 0xbd, 0x8b, 0xcc, 0x9f, 0x87, 0x82, 0x60, 0xde, 0x37, 0x7b, 0xa4, 0x69,
+		0xed, 0x98, 0xc6, 0xcb, 0xa4, 0x35, 0x01, 0xeb, 0x5f, 0x1b, 0x18, 0xaa, 0x4d, 0x1f, 0x39, 0x72,
+		0xae, 0x83, 0xe4, 0xfa, 0x2a, 0x9a, 0xd1, 0xe9, 0x5a, 0x3d, 0x27, 0x84, 0xe3, 0x67, 0xc5, 0x48,
+		0xb9, 0x74, 0x75, 0x4a, 0x56, 0xaa, 0x01, 0x3b, 0xfd, 0x31, 0xa9, 0x4a, 0x7e, 0x8e, 0x33, 0xca,
+		0x79, 0x54, 0xdc, 0x70, 0x34, 0xad, 0x4e, 0x57, 0xef, 0x4d, 0x62, 0xff, 0xfe, 0xa1, 0x30, 0x1c,
+		0x9c, 0x84, 0x1b, 0x0e, 0xf4, 0xe8, 0x70, 0xb5, 0x9f, 0x80, 0xc3, 0xe7, 0x85, 0xe1, 0x10, 0x34,
+		0x9c, 0x85, 0x70, 0x18, 0x26, 0x60, 0xf1, 0x05, 0xc1, 0x42, 0xd0, 0x20, 0x8b, 0x77, 0x78, 0x0b,
+		0x6d, 0x8f, 0xec, 0x18, 0xb6, 0xd3, 0x63, 0x2e, 0xf9, 0xc1, 0xac, 0xbe, 0xf8, 0xad, 0xa0, 0x13,
+		0xa6, 0xfa, 0x48, 0xd1, 0x12, 0xf1, 0xad, 0x0f, 0x1a, 0xb2, 0x85, 0x37, 0xec, 0xd7, 0x85, 0x25,
+		0xf2, 0x91, 0x61, 0xdb, 0x7c, 0x1e, 0x22, 0x8a, 0xbd, 0x89, 0x81, 0xca, 0x04, 0xec, 0xfe, 0xd1,
+		0x40, 0xe3, 0x1a, 0x82, 0x16, 0x79, 0xfa, 0xfc, 0x9f, 0xbe, 0x79, 0x95, 0xec, 0x4f, 0xa4, 0x9d,
+		0x5f, 0x1a, 0xf0, 0x7f, 0x36, 0x19, 0x25, 0xb3, 0x21, 0xf9, 0x01, 0x7f, 0x4a, 0x0e, 0x3b, 0xeb,
+		0x57, 0xf8, 0x91, 0xd7, 0x79, 0x7f, 0x83, 0xee, 0x54, 0x69, 0x19, 0x24, 0x0e, 0xf1, 0x1c, 0xd8,
+		0x50, 0x66, 0xef, 0x7d, 0xdd, 0xd5, 0xf3, 0x80, 0xcf, 0x53, 0xba, 0x00, 0xb9, 0x80, 0xc3, 0x13,
+		0xce, 0xea, 0xc7, 0x38, 0xab, 0xac, 0xdf, 0xdf, 0x29, 0x9d, 0x83, 0x38, 0x3a, 0x2f, 0xe1, 0xe4,
+		0x3f, 0xce, 0xc9, 0x29, 0x7a, 0xe9, 0x69, 0x48, 0x09, 0xa7, 0x25, 0x9c, 0xf4, 0x27, 0x38, 0xa9,
+		0x4b, 0x82, 0xe4, 0xc2, 0x61, 0x09, 0x27, 0xff, 0xab, 0x82, 0x5c, 0x90, 0x20, 0xf9, 0xe4, 0x22,
+		0xfc, 0xad, 0xbf, 0x16, 0x67, 0xe4, 0x82, 0xa4, 0x84, 0xa7, 0x4f, 0x98, 0xa7, 0x12, 0x4e, 0xfd,
+		0x93, 0xbc, 0x72, 0x41, 0x51, 0x7a, 0x1c, 0x12, 0x13, 0x0a, 0xfc, 0xaf, 0x73, 0x52, 0x86, 0x5f,
+		0xaa, 0x42, 0xc6, 0xe7, 0x9d, 0x84, 0x93, 0xbf, 0x9f, 0x93, 0xfb, 0xa9, 0xb0, 0xe9, 0xdc, 0x3b,
+		0x09, 0x67, 0xf0, 0xd3, 0xa2, 0xe9, 0x9c, 0x02, 0xc5, 0x26, 0x1c, 0x93, 0x70, 0xea, 0x0f, 0x08,
+		0xa9, 0x0b, 0x92, 0xd2, 0x33, 0x90, 0x76, 0x17, 0x9b, 0x70, 0xfa, 0x9f, 0xe1, 0xf4, 0x1e, 0x0d,
+		0x4a, 0xa0, 0x6f, 0x1e, 0x82, 0xc5, 0xdf, 0x10, 0x12, 0xf0, 0x51, 0xe1, 0x34, 0x1a, 0x74, 0x60,
+		0xc2, 0x39, 0x7d, 0x50, 0x4c, 0xa3, 0x01, 0xff, 0x05, 0x47, 0x93, 0xda, 0xfc, 0x70, 0x16, 0x7f,
+		0x53, 0x8c, 0x26, 0xc5, 0xc7, 0x66, 0x0c, 0x7a, 0x04, 0xe1, 0x3c, 0x7e, 0x4e, 0x34, 0x63, 0xc0,
+		0x21, 0x28, 0xad, 0x83, 0x3c, 0xec, 0x0d, 0x84, 0xf3, 0xfb, 0x10, 0xe7, 0x37, 0x3b, 0xe4, 0x0c,
+		0x94, 0x9e, 0x83, 0xa3, 0xa3, 0x3d, 0x81, 0x70, 0xae, 0x3f, 0xff, 0xfa, 0x40, 0xec, 0xe6, 0x77,
+		0x04, 0x4a, 0x1b, 0x30, 0x3f, 0xca, 0x0b, 0x08, 0x67, 0xfb, 0xe1, 0xd7, 0x83, 0x86, 0xdb, 0xef,
+		0x04, 0x94, 0xca, 0x00, 0xde, 0x02, 0x1c, 0xce, 0xeb, 0xa3, 0x9c, 0x97, 0x8f, 0x08, 0xa7, 0x06,
+		0x5f, 0x7f, 0xc3, 0xe9, 0x6f, 0x8a, 0xa9, 0xc1, 0x29, 0x70, 0x6a, 0x88, 0xa5, 0x37, 0x9c, 0xfa,
+		0x63, 0x62, 0x6a, 0x08, 0x12, 0xd4, 0x6c, 0xdf, 0xea, 0x16, 0xce, 0xe1, 0x13, 0x42, 0xb3, 0x7d,
+		0x54, 0xa5, 0x55, 0x98, 0x1d, 0x5a, 0x10, 0xc3, 0x59, 0xfd, 0x02, 0x67, 0x25, 0x0d, 0xae, 0x87,
+		0xfe, 0xc5, 0x8b, 0x2f, 0x86, 0xe1, 0xdc, 0x7e, 0x71, 0x60, 0xf1, 0xe2, 0x6b, 0x61, 0xe9, 0x29,
+		0x48, 0x99, 0xfd, 0x76, 0x1b, 0x27, 0x8f, 0x7c, 0xf0, 0xf9, 0xdc, 0xc2, 0x7f, 0xfe, 0x0e, 0x97,
+		0x8e, 0x20, 0x28, 0x9d, 0x83, 0x04, 0xe9, 0x6c, 0x91, 0x56, 0x18, 0xe5, 0x37, 0xbe, 0x23, 0x0c,
+		0x26, 0x62, 0x97, 0x9e, 0x01, 0x60, 0xa9, 0x11, 0xba, 0x71, 0x1e, 0x42, 0xfb, 0xcd, 0xef, 0xf0,
+		0x03, 0x71, 0x1e, 0x89, 0xc7, 0x80, 0x1d, 0xaf, 0x3b, 0x98, 0xc1, 0xb7, 0x82, 0x0c, 0xe8, 0x88,
+		0x3c, 0x09, 0xd3, 0xb8, 0x91, 0xe6, 0xe8, 0x3b, 0x61, 0xd4, 0x7f, 0xc2, 0xa9, 0x05, 0x3e, 0x0a,
+		0xac, 0x63, 0xf5, 0x88, 0xa3, 0xef, 0xd8, 0x61, 0xb4, 0xff, 0x85, 0xd3, 0xba, 0x04, 0x48, 0xdc,
+		0xd4, 0x6d, 0x67, 0x92, 0x7e, 0xff, 0xa9, 0x20, 0x16, 0x04, 0xd8, 0x68, 0xfc, 0x7d, 0x95, 0xec,
+		0x87, 0xd1, 0xfe, 0x99, 0x68, 0x34, 0xc7, 0x2f, 0x3d, 0x0d, 0x69, 0xfc, 0xc9, 0x4e, 0xb9, 0x86,
+		0x10, 0xff, 0x39, 0x27, 0xf6, 0x28, 0xb0, 0x66, 0xdb, 0x69, 0x39, 0x46, 0xb8, 0xb0, 0xbf, 0xcd,
+		0x47, 0x5a, 0xe0, 0x97, 0xca, 0x90, 0xb1, 0x9d, 0x56, 0xab, 0xcf, 0xfd, 0xd3, 0x10, 0xf2, 0xff,
+		0xfa, 0x1d, 0x37, 0x65, 0xe1, 0xd2, 0xe0, 0x68, 0x5f, 0xbf, 0xea, 0x74, 0x2d, 0xba, 0xdf, 0x12,
+		0xc6, 0xe1, 0x75, 0xce, 0xc1, 0x47, 0x52, 0xaa, 0x42, 0x16, 0xfb, 0xd2, 0x23, 0x5d, 0x42, 0x37,
+		0xc7, 0x42, 0x58, 0xfc, 0x05, 0x17, 0x40, 0x80, 0xa8, 0xf2, 0xee, 0x2f, 0xbf, 0x76, 0x22, 0xf2,
+		0x95, 0xd7, 0x4e, 0x44, 0xfe, 0xf0, 0xb5, 0x13, 0x91, 0x0f, 0x7c, 0xf5, 0xc4, 0xd4, 0x57, 0xbe,
+		0x7a, 0x62, 0xea, 0x0f, 0xbe, 0x7a, 0x62, 0x6a, 0x74, 0x96, 0x18, 0x2e, 0x5a, 0x17, 0x2d, 0x96,
+		0x1f, 0x7e, 0xf1, 0x9e, 0x1d, 0xc3, 0xd9, 0xed, 0x6f, 0x2d, 0x36, 0xad, 0xce, 0x99, 0xa6, 0x65,
+		0x77, 0x2c, 0xfb, 0x4c, 0x30, 0xaf, 0x4b, 0x7f, 0xc1, 0xff, 0x8a, 0xc0, 0x31, 0xc6, 0xc6, 0x4b,
+		0xe7, 0xea, 0xe6, 0xfe, 0xb8, 0xcb, 0x74, 0xe7, 0x21, 0x56, 0x36, 0xf7, 0xe5, 0x63, 0xcc, 0xc0,
+		0x69, 0xfd, 0x5e, 0x9b, 0x1f, 0xb5, 0x9c, 0xc6, 0xef, 0xcd, 0x5e, 0x1b, 0xd3, 0xec, 0xe2, 0x3c,
+		0x34, 0x6e, 0xe7, 0xb0, 0x8f, 0xca, 0xfb, 0x23, 0x87, 0xeb, 0x49, 0xaa, 0x6c, 0xee, 0xd3, 0x8e,
+		0xac, 0x47, 0x5e, 0x7c, 0x30, 0x34, 0xcf, 0x7d, 0xd5, 0xb4, 0xae, 0x9b, 0xd8, 0xec, 0xee, 0x96,
+		0xc8, 0x71, 0x9f, 0x18, 0xcc, 0x71, 0x3f, 0x47, 0xda, 0xed, 0x67, 0x11, 0x0f, 0x8f, 0x2f, 0xd8,
+		0x5b, 0x49, 0x76, 0xaa, 0x1f, 0x3e, 0x18, 0x85, 0x13, 0x43, 0xe9, 0x6c, 0xae, 0x04, 0xe3, 0x84,
+		0x50, 0x82, 0xd4, 0x92, 0xd0, 0xad, 0x02, 0x5e, 0x65, 0x6b, 0x5a, 0x66, 0xcb, 0xa6, 0x82, 0x88,
+		0xa9, 0xe2, 0x13, 0x05, 0x61, 0xea, 0xa6, 0x65, 0xf3, 0xc3, 0xca, 0xec, 0xa3, 0xf2, 0x91, 0x43,
+		0x0a, 0x22, 0x27, 0x6a, 0x12, 0xd2, 0x78, 0x64, 0x42, 0x69, 0x88, 0x4e, 0x04, 0x32, 0xff, 0x93,
+		0x4a, 0xe5, 0xe7, 0xa2, 0x70, 0x72, 0x50, 0x2a, 0x38, 0xb3, 0x6c, 0x47, 0xef, 0x74, 0xc7, 0x89,
+		0xe5, 0x29, 0x48, 0x6f, 0x08, 0x9c, 0x43, 0xcb, 0xe5, 0xe6, 0x21, 0xe5, 0x32, 0xe3, 0x56, 0x25,
+		0x04, 0x73, 0x76, 0x42, 0xc1, 0xb8, 0xfd, 0x78, 0x43, 0x92, 0xf9, 0xcb, 0x24, 0x1c, 0x63, 0xd3,
+		0x49, 0x63, 0x53, 0x89, 0x7d, 0x70, 0x99, 0x64, 0xfd, 0x45, 0xe1, 0xfb, 0x24, 0xca, 0xb3, 0x30,
+		0x57, 0x47, 0x6b, 0x81, 0x51, 0x90, 0xb7, 0xc3, 0x33, 0xf2, 0x3c, 0xf7, 0x42, 0xc0, 0xe1, 0xe7,
+		0xfb, 0x5b, 0x7e, 0x90, 0xf2, 0x23, 0x11, 0x90, 0x1a, 0x4d, 0xbd, 0xad, 0xf7, 0xbe, 0x5b, 0x56,
+		0xf2, 0xe3, 0x00, 0xec, 0xb8, 0x87, 0x7b, 0x71, 0x6f, 0xe6, 0x6c, 0x61, 0xd1, 0xdf, 0xb9, 0x45,
+		0x56, 0x13, 0x3d, 0x41, 0x95, 0xa6, 0xb8, 0xf8, 0xf3, 0xf4, 0xf3, 0x00, 0x5e, 0x81, 0x7c, 0x1c,
+		0x6e, 0x6b, 0x54, 0xcb, 0xcb, 0x65, 0x55, 0x1c, 0x12, 0x6a, 0xac, 0xd7, 0xaa, 0xf5, 0x0b, 0xf5,
+		0xda, 0x92, 0x34, 0x85, 0xe7, 0x6b, 0xfc, 0x85, 0xee, 0xa1, 0xa6, 0x23, 0x30, 0xeb, 0x87, 0xb3,
+		0x5b, 0x2a, 0x51, 0xf4, 0x14, 0x8d, 0x4e, 0xb7, 0x4d, 0xe8, 0xce, 0xa3, 0x66, 0x08, 0xa9, 0x85,
+		0x3b, 0x21, 0xbf, 0xf3, 0x6f, 0xd8, 0xcd, 0x85, 0x39, 0x8f, 0xdc, 0x95, 0x79, 0x69, 0x19, 0x66,
+		0xf1, 0x2c, 0x65, 0x37, 0xc0, 0x32, 0xc4, 0x54, 0x23, 0x43, 0xba, 0x97, 0xca, 0x29, 0x3d, 0x6e,
+		0x8f, 0x43, 0xd2, 0xa6, 0xbd, 0x0f, 0x63, 0xf1, 0xbb, 0x9c, 0x05, 0x47, 0x2f, 0x99, 0x30, 0x8b,
+		0x9e, 0x1f, 0x26, 0x88, 0xbc, 0x66, 0x1c, 0x9c, 0x67, 0xf8, 0x8d, 0xcf, 0x3e, 0x4c, 0x77, 0x56,
+		0xef, 0x0c, 0x0e, 0xcb, 0x08, 0x75, 0x52, 0x25, 0xce, 0xdb, 0x6b, 0x28, 0x81, 0x19, 0x51, 0x1f,
+		0x6f, 0xf0, 0xc1, 0x95, 0xfd, 0x26, 0xaf, 0xec, 0xc4, 0x28, 0x1d, 0xf0, 0xd5, 0x94, 0xe3, 0x5c,
+		0x59, 0x41, 0xa5, 0x36, 0x6e, 0x4e, 0xbf, 0xf8, 0xc0, 0xf0, 0xea, 0xc4, 0xfe, 0x7b, 0x88, 0x72,
+		0x7e, 0xca, 0x5f, 0x8d, 0x3b, 0xf7, 0x3e, 0x12, 0x87, 0x59, 0x3c, 0xfe, 0x6f, 0x9d, 0xa1, 0xff,
+		0xf2, 0x39, 0x97, 0xa0, 0x1f, 0x13, 0x6c, 0x4a, 0x9e, 0x67, 0x53, 0x21, 0x5c, 0x63, 0xfe, 0xfc,
+		0xa7, 0x3e, 0x99, 0xf0, 0xa6, 0x4b, 0x69, 0x05, 0x24, 0x71, 0x88, 0x97, 0x98, 0x4d, 0xab, 0x35,
+		0x51, 0x96, 0xe2, 0xdb, 0x82, 0x87, 0xc8, 0x6f, 0xd5, 0x38, 0x69, 0xe9, 0x6d, 0xb8, 0xd3, 0xc7,
+		0xd9, 0x84, 0x79, 0x26, 0x82, 0x89, 0x4b, 0x81, 0x7e, 0x09, 0x9b, 0x99, 0x93, 0x78, 0xa1, 0xaf,
+		0x0b, 0x7a, 0x36, 0x43, 0xf1, 0x84, 0x44, 0xe9, 0x22, 0xcc, 0xb4, 0x2c, 0xd3, 0xd1, 0xac, 0x8e,
+		0xe1, 0x90, 0x4e, 0xd7, 0x09, 0xf5, 0xeb, 0xfe, 0x82, 0x31, 0x49, 0xa9, 0x39, 0xa4, 0x5b, 0x13,
+		0x64, 0xd8, 0x12, 0x76, 0x4f, 0x6f, 0x92, 0x96, 0xfc, 0x37, 0xb7, 0x25, 0x94, 0x06, 0x5b, 0xf2,
+		0x86, 0xb4, 0xc3, 0x6e, 0x5d, 0xe5, 0xc6, 0xdc, 0xd9, 0x63, 0x5a, 0xe0, 0x6a, 0xc7, 0x9f, 0xc6,
+		0xe0, 0x04, 0x47, 0xde, 0xd2, 0x6d, 0x72, 0xe6, 0xda, 0x23, 0x5b, 0xc4, 0xd1, 0x1f, 0x39, 0xd3,
+		0xb4, 0x0c, 0xb1, 0x92, 0xcf, 0xb1, 0xf2, 0x45, 0x2c, 0x5f, 0xe4, 0xe5, 0xc5, 0x91, 0xdb, 0xdd,
+		0xc5, 0xf1, 0x46, 0xbe, 0x38, 0xac, 0x83, 0xca, 0x8b, 0x10, 0xaf, 0x5a, 0x86, 0x89, 0x6b, 0x5b,
+		0x8b, 0x98, 0x56, 0x87, 0x9b, 0x5b, 0xf6, 0x21, 0x9f, 0x87, 0xa4, 0xde, 0xb1, 0xfa, 0xa6, 0xc3,
+		0x4c, 0x6d, 0xe5, 0xc4, 0x97, 0x5f, 0x3d, 0x39, 0xf5, 0xef, 0x5e, 0x3d, 0x19, 0xab, 0x9b, 0xce,
+		0xef, 0x7f, 0xee, 0x21, 0xe0, 0xdc, 0xeb, 0xa6, 0xf3, 0xe9, 0xaf, 0x7d, 0xe6, 0x74, 0x44, 0xe5,
+		0xd8, 0xa5, 0xf8, 0xd7, 0x3f, 0x7e, 0x32, 0xa2, 0x3c, 0x0f, 0xd3, 0x4b, 0xa4, 0x79, 0x00, 0xfb,
+		0x47, 0x06, 0xd8, 0x1f, 0x13, 0xec, 0x97, 0x48, 0xd3, 0xc7, 0x7e, 0x89, 0x34, 0x07, 0x38, 0x3f,
+		0x0e, 0xa9, 0xba, 0xe9, 0xb0, 0x2b, 0x44, 0x0f, 0x40, 0xcc, 0x30, 0xd9, 0xa9, 0x74, 0x1f, 0x87,
+		0xa1, 0x06, 0xaa, 0x88, 0x85, 0x84, 0x4b, 0xa4, 0xe9, 0x12, 0xb6, 0x48, 0xb3, 0x10, 0x09, 0xab,
+		0x1a, 0xb1, 0x2a, 0x4b, 0x7f, 0xf0, 0x47, 0x27, 0xa6, 0x5e, 0x79, 0xed, 0xc4, 0xd4, 0xd8, 0xa1,
+		0x57, 0xc2, 0x87, 0xde, 0x1d, 0xf1, 0x4f, 0xc6, 0xe1, 0x0e, 0x7a, 0xb3, 0xb4, 0xd7, 0x31, 0x4c,
+		0xe7, 0x4c, 0xb3, 0xb7, 0xdf, 0x75, 0x2c, 0x34, 0x00, 0xd6, 0x36, 0x1f, 0xf0, 0x59, 0xaf, 0x78,
+		0x91, 0x15, 0x8f, 0x1e, 0x6e, 0x65, 0x1b, 0x12, 0xeb, 0x48, 0x87, 0x22, 0x76, 0x2c, 0x47, 0x6f,
+		0x73, 0xaf, 0x85, 0x7d, 0x20, 0x94, 0xdd, 0x46, 0x8d, 0x32, 0xa8, 0x21, 0x2e, 0xa2, 0xb6, 0x89,
+		0xbe, 0xcd, 0x2e, 0xf5, 0xc4, 0xa8, 0xbb, 0x9b, 0x42, 0x00, 0xbd, 0xbf, 0x33, 0x0f, 0x09, 0xbd,
+		0xcf, 0x0e, 0xde, 0xc4, 0xd0, 0x0f, 0xa6, 0x1f, 0xca, 0xb3, 0x30, 0xcd, 0xf7, 0xdf, 0xf1, 0xe4,
+		0xc9, 0x55, 0xb2, 0x4f, 0xeb, 0xc9, 0xaa, 0xf8, 0x53, 0x5e, 0x84, 0x04, 0x6d, 0x3c, 0xbf, 0xad,
+		0x58, 0x58, 0x1c, 0x6a, 0xfd, 0x22, 0x6d, 0xa4, 0xca, 0xd0, 0x94, 0xcb, 0x90, 0x5a, 0xb2, 0x50,
+		0x0b, 0x83, 0xdc, 0xd2, 0x8c, 0x1b, 0x6d, 0x73, 0xb7, 0xcf, 0xb5, 0x42, 0x65, 0x1f, 0x78, 0x24,
+		0x9d, 0x5d, 0xf2, 0xe2, 0x87, 0x87, 0xf8, 0x97, 0x52, 0x85, 0x69, 0xca, 0x7b, 0xad, 0x8b, 0x2e,
+		0x83, 0x7b, 0xee, 0x3d, 0xcd, 0xaf, 0xfc, 0x72, 0xf6, 0x51, 0xaf, 0xb1, 0x32, 0xc4, 0xf1, 0x22,
+		0x07, 0xef, 0x37, 0xfd, 0xad, 0xbc, 0x1d, 0x52, 0x9c, 0x89, 0x2d, 0x9f, 0x85, 0x98, 0xd5, 0xb5,
+		0xf9, 0xf1, 0x9f, 0xe2, 0xb8, 0xae, 0xac, 0x75, 0x2b, 0x71, 0xd4, 0x19, 0x15, 0x91, 0x2b, 0xab,
+		0x63, 0xd5, 0xe2, 0xb1, 0x80, 0x5a, 0x74, 0x88, 0xb3, 0xb5, 0xed, 0x78, 0x3f, 0xd8, 0x70, 0x0e,
+		0xa9, 0x82, 0xab, 0x28, 0x37, 0xa3, 0x70, 0xc2, 0x57, 0x7a, 0x8d, 0xf4, 0x30, 0x01, 0xc5, 0xb4,
+		0x89, 0x6b, 0x8a, 0xec, 0x6b, 0x20, 0x2f, 0x1f, 0xa3, 0x2a, 0x4f, 0x43, 0xac, 0xdc, 0xed, 0xe2,
+		0x3d, 0x67, 0xfa, 0xdd, 0xb4, 0x98, 0xae, 0xc4, 0x55, 0xf7, 0x1b, 0xcb, 0x6c, 0x6b, 0xdb, 0xb9,
+		0xae, 0xf7, 0xdc, 0x3b, 0xd0, 0xe2, 0x5b, 0x79, 0x12, 0xd2, 0x55, 0xcb, 0xb4, 0x89, 0x69, 0xf7,
+		0xa9, 0x2f, 0xbc, 0xd5, 0xb6, 0x9a, 0x57, 0x39, 0x07, 0xf6, 0x81, 0xc2, 0xd6, 0xbb, 0x5d, 0x4a,
+		0x19, 0x57, 0xf1, 0x27, 0x9b, 0xaf, 0x95, 0xb5, 0xb1, 0xe2, 0x39, 0x77, 0x38, 0xf1, 0xf0, 0x0e,
+		0x7a, 0x4e, 0x6d, 0x04, 0x6e, 0x1f, 0x9e, 0x48, 0x57, 0xc9, 0xbe, 0x7d, 0xd8, 0x79, 0xf4, 0x3c,
+		0xa4, 0xd7, 0xe9, 0xf3, 0x24, 0xcf, 0x92, 0x7d, 0xb9, 0x88, 0x6f, 0x58, 0x9c, 0x3d, 0x77, 0xee,
+		0x91, 0x27, 0x99, 0x96, 0x5f, 0x9a, 0x52, 0x05, 0x40, 0x3e, 0x01, 0x69, 0x9b, 0x34, 0xbb, 0x67,
+		0xcf, 0x9d, 0xbf, 0xfa, 0x08, 0x53, 0xab, 0x4b, 0x53, 0xaa, 0x07, 0x2a, 0xa5, 0xb0, 0xc7, 0x5f,
+		0xff, 0xc4, 0xc9, 0x48, 0x25, 0x01, 0x31, 0xbb, 0xdf, 0x79, 0xd3, 0x74, 0xe3, 0x67, 0x13, 0xb0,
+		0xe0, 0x2b, 0x65, 0x8b, 0xcb, 0x35, 0xbd, 0x6d, 0xb4, 0x74, 0xef, 0x51, 0x19, 0xc9, 0xd7, 0x7f,
+		0x8a, 0x31, 0x66, 0xd5, 0x38, 0x50, 0x8a, 0xca, 0xaf, 0x45, 0x20, 0x7b, 0x45, 0x70, 0xc6, 0x57,
+		0x68, 0x9e, 0x02, 0x70, 0x6b, 0x12, 0x53, 0xe5, 0xf8, 0xe2, 0x60, 0x5d, 0x8b, 0x2e, 0x8d, 0xea,
+		0x43, 0x97, 0x1f, 0xa7, 0x0a, 0xd8, 0xb5, 0x6c, 0x7e, 0x1f, 0x36, 0x84, 0xd4, 0x45, 0xc6, 0x83,
+		0x9c, 0xd4, 0xaa, 0x69, 0xd7, 0x2c, 0x07, 0x8f, 0x96, 0x74, 0xad, 0xeb, 0xfc, 0x95, 0x81, 0x98,
+		0x2a, 0xd1, 0x92, 0x2b, 0xb4, 0x60, 0x1d, 0xe1, 0xd8, 0xe8, 0xb4, 0xcb, 0x05, 0xc3, 0x3a, 0xbd,
+		0xd5, 0xea, 0x11, 0xdb, 0xe6, 0x86, 0x4b, 0x7c, 0xe2, 0x25, 0xdc, 0x6e, 0x7f, 0x4b, 0x13, 0x56,
+		0x02, 0xaf, 0x31, 0x8f, 0x98, 0xf3, 0x42, 0x37, 0xf8, 0xac, 0x4f, 0x76, 0xfb, 0x5b, 0xa8, 0x29,
+		0x77, 0x42, 0x76, 0x44, 0x63, 0x32, 0xd7, 0xbc, 0x76, 0xd0, 0x17, 0x71, 0x78, 0x0f, 0xb4, 0x6e,
+		0xcf, 0xb0, 0x7a, 0x86, 0xb3, 0x4f, 0x8f, 0xed, 0xc5, 0x54, 0x49, 0x14, 0xac, 0x73, 0xb8, 0x72,
+		0x15, 0xf2, 0x0d, 0xea, 0xee, 0x7b, 0x2d, 0x3f, 0xe7, 0xb5, 0x2f, 0x12, 0xde, 0xbe, 0xb1, 0x2d,
+		0x8b, 0x0e, 0xb5, 0xac, 0xb2, 0x32, 0x56, 0x33, 0x1f, 0x3d, 0x9c, 0x66, 0x06, 0x57, 0xb7, 0x6f,
+		0x1e, 0x83, 0xdb, 0x07, 0x0b, 0x03, 0x26, 0x6b, 0x52, 0xa5, 0x0c, 0x8b, 0xe4, 0x8b, 0x07, 0x2f,
+		0xa2, 0xc5, 0x10, 0xd3, 0x59, 0x0c, 0x9d, 0x3e, 0xca, 0x93, 0x90, 0xc3, 0xc3, 0xb7, 0x0d, 0xe2,
+		0x5c, 0x22, 0x7a, 0x8b, 0xf4, 0x82, 0xab, 0x6c, 0x4e, 0xac, 0xb2, 0x32, 0xc4, 0xe9, 0x52, 0xca,
+		0x56, 0x19, 0xfa, 0x5b, 0xd9, 0x85, 0x38, 0x92, 0x7a, 0x2b, 0x30, 0xa7, 0xa0, 0x1f, 0x08, 0xdd,
+		0xda, 0x77, 0x88, 0xcd, 0x49, 0xd8, 0x87, 0xfc, 0x98, 0x58, 0x47, 0x63, 0x07, 0xaf, 0xa3, 0x5c,
+		0x09, 0xf9, 0x6a, 0xda, 0x86, 0xe9, 0x0a, 0x9a, 0xdf, 0xfa, 0x92, 0xdb, 0x90, 0x88, 0xd7, 0x10,
+		0x79, 0x05, 0xf2, 0x5d, 0xbd, 0xe7, 0xd0, 0x7b, 0x7c, 0xbb, 0xb4, 0x17, 0x5c, 0xcf, 0x4f, 0x0e,
+		0xcf, 0xba, 0x40, 0x67, 0x79, 0x2d, 0xb9, 0xae, 0x1f, 0xa8, 0xfc, 0xa7, 0x38, 0x24, 0xd9, 0x4f,
+		0xf9, 0x69, 0x98, 0xe6, 0x62, 0xe5, 0x9a, 0x79, 0xc7, 0xe2, 0xf0, 0x62, 0xb4, 0xe8, 0x2e, 0x1a,
+		0x9c, 0x9f, 0xa0, 0x91, 0xef, 0x85, 0x54, 0x73, 0x57, 0x37, 0x4c, 0xcd, 0x68, 0x71, 0x07, 0x30,
+		0xf3, 0xda, 0xab, 0x27, 0xa7, 0xab, 0x08, 0xab, 0x2f, 0xa9, 0xd3, 0xb4, 0xb0, 0xde, 0xc2, 0x95,
+		0x7f, 0x97, 0x18, 0x3b, 0xbb, 0x0e, 0x9f, 0x5d, 0xfc, 0x0b, 0x9f, 0xc2, 0x42, 0x85, 0xe0, 0xb7,
+		0xbc, 0x8b, 0x43, 0x7e, 0xbc, 0x9b, 0x68, 0xa9, 0xa4, 0xb0, 0xe2, 0x0f, 0xfc, 0xc7, 0x93, 0x11,
+		0x95, 0x52, 0xc8, 0x55, 0xc8, 0xb5, 0x75, 0xdb, 0xd1, 0xe8, 0xaa, 0x85, 0xd5, 0x27, 0x28, 0x8b,
+		0x63, 0xc3, 0x02, 0xe1, 0x82, 0xe5, 0x4d, 0xcf, 0x20, 0x15, 0x03, 0xb5, 0xf0, 0x12, 0x2a, 0x65,
+		0x82, 0x67, 0x8e, 0x0d, 0x87, 0xf9, 0x52, 0x49, 0x2a, 0xf7, 0x19, 0x84, 0x57, 0x29, 0x98, 0x7a,
+		0x54, 0xc7, 0x21, 0x4d, 0xef, 0x95, 0x52, 0x14, 0x76, 0x58, 0x3c, 0x85, 0x00, 0x5a, 0x78, 0x1f,
+		0xe4, 0x3d, 0xdb, 0xc8, 0x50, 0x52, 0x8c, 0x8b, 0x07, 0xa6, 0x88, 0x0f, 0xc3, 0xbc, 0x49, 0xf6,
+		0x1c, 0xcd, 0x03, 0x33, 0xec, 0x34, 0xc5, 0x96, 0xb1, 0xec, 0x4a, 0x90, 0xe2, 0x1e, 0x98, 0x69,
+		0x0a, 0xe1, 0x33, 0x5c, 0xa0, 0xb8, 0x39, 0x17, 0x4a, 0xd1, 0x8e, 0x41, 0x4a, 0xef, 0x76, 0x19,
+		0x42, 0x86, 0xdb, 0xc6, 0x6e, 0x97, 0x16, 0x9d, 0x86, 0x59, 0xda, 0xc7, 0x1e, 0xb1, 0xfb, 0x6d,
+		0x87, 0x33, 0xc9, 0x52, 0x9c, 0x3c, 0x16, 0xa8, 0x0c, 0x4e, 0x71, 0xef, 0x82, 0x1c, 0xb9, 0x86,
+		0x27, 0xf5, 0x9b, 0x84, 0xe1, 0xe5, 0x28, 0x5e, 0x56, 0x00, 0x29, 0xd2, 0xfd, 0xe0, 0xda, 0x3c,
+		0x4d, 0xd8, 0xe3, 0x19, 0xc6, 0x4f, 0xc0, 0xcb, 0x0c, 0xac, 0x14, 0x20, 0xbe, 0xa4, 0x3b, 0x3a,
+		0x3a, 0x15, 0xce, 0x1e, 0x5b, 0x64, 0xb2, 0x2a, 0xfe, 0x54, 0xbe, 0x1e, 0x85, 0xf8, 0x15, 0xcb,
+		0x21, 0xf2, 0xa3, 0x3e, 0x87, 0x6f, 0x66, 0x94, 0x3e, 0x37, 0x8c, 0x1d, 0x93, 0xb4, 0x56, 0xec,
+		0x1d, 0xdf, 0x23, 0x30, 0x9e, 0x3a, 0x45, 0x03, 0xea, 0x34, 0x0f, 0x89, 0x9e, 0xd5, 0x37, 0x5b,
+		0xe2, 0x98, 0x35, 0xfd, 0x90, 0x6b, 0x90, 0x72, 0xb5, 0x24, 0x1e, 0xa6, 0x25, 0x79, 0xd4, 0x12,
+		0xd4, 0x61, 0x0e, 0x50, 0xa7, 0xb7, 0xb8, 0xb2, 0x54, 0x20, 0xed, 0x1a, 0xaf, 0x42, 0xe2, 0x10,
+		0x0a, 0xeb, 0x91, 0xe1, 0x42, 0xe2, 0x8e, 0xbd, 0x2b, 0x3c, 0xa6, 0x71, 0x92, 0x5b, 0xc0, 0xa5,
+		0x17, 0x50, 0x2b, 0xfe, 0x20, 0xcd, 0x34, 0xed, 0x97, 0xa7, 0x56, 0xec, 0x51, 0x9a, 0xdb, 0xf1,
+		0xdc, 0xda, 0x8e, 0xa9, 0x3b, 0xfd, 0x1e, 0xe1, 0x9a, 0xe7, 0x01, 0x94, 0xdf, 0x8a, 0x40, 0x92,
+		0x69, 0xb2, 0x4f, 0x6e, 0x91, 0xd1, 0x72, 0x8b, 0x8e, 0x93, 0x5b, 0xec, 0x8d, 0xcb, 0xad, 0x0c,
+		0xe0, 0x36, 0xc6, 0xe6, 0xef, 0x84, 0x8c, 0xf0, 0x16, 0x58, 0x13, 0x1b, 0xc6, 0x0e, 0x9f, 0xa8,
+		0x3e, 0x22, 0xe5, 0x3f, 0x44, 0x20, 0xed, 0x96, 0xcb, 0x65, 0xc8, 0x89, 0x76, 0x69, 0xdb, 0x6d,
+		0x7d, 0x87, 0xeb, 0xce, 0x1d, 0x63, 0x1b, 0x77, 0xa1, 0xad, 0xef, 0xa8, 0x19, 0xde, 0x1e, 0xfc,
+		0x18, 0x3d, 0x0e, 0xd1, 0x31, 0xe3, 0x10, 0x18, 0xf8, 0xd8, 0x1b, 0x1b, 0xf8, 0xc0, 0x10, 0xc5,
+		0x07, 0x87, 0xe8, 0xb3, 0x51, 0x1a, 0xbc, 0x74, 0x2d, 0x5b, 0x6f, 0xbf, 0x15, 0x33, 0xe2, 0x38,
+		0xa4, 0xbb, 0x56, 0x5b, 0x63, 0x25, 0xec, 0xfa, 0x41, 0xaa, 0x6b, 0xb5, 0xd5, 0xa1, 0x61, 0x4f,
+		0xdc, 0xa2, 0xe9, 0x92, 0xbc, 0x05, 0x52, 0x9b, 0x1e, 0x94, 0x5a, 0x0f, 0xb2, 0x4c, 0x14, 0x7c,
+		0x2d, 0x7b, 0x18, 0x65, 0x80, 0xbf, 0x0a, 0x91, 0xe1, 0xb5, 0x97, 0x35, 0x9b, 0x61, 0xaa, 0xc9,
+		0x5d, 0x97, 0x82, 0x99, 0xfe, 0x42, 0x74, 0x1c, 0x05, 0x53, 0x3b, 0x95, 0xe3, 0x29, 0x3f, 0x1b,
+		0x01, 0x58, 0x46, 0xc9, 0xd2, 0xfe, 0xe2, 0x2a, 0x64, 0xd3, 0x26, 0x68, 0x81, 0x9a, 0x4f, 0x8c,
+		0x1b, 0x34, 0x5e, 0x7f, 0xd6, 0xf6, 0xb7, 0xbb, 0x0a, 0x39, 0x4f, 0x19, 0x6d, 0x22, 0x1a, 0x73,
+		0xe2, 0x00, 0x8f, 0xba, 0x41, 0x1c, 0x35, 0x7b, 0xcd, 0xf7, 0xa5, 0xfc, 0xe3, 0x08, 0xa4, 0x69,
+		0x9b, 0xf0, 0x95, 0x83, 0xc0, 0x18, 0x46, 0xde, 0xf8, 0x18, 0xde, 0x01, 0xc0, 0xd8, 0xe0, 0x2e,
+		0x3e, 0xd7, 0xac, 0x34, 0x85, 0xe0, 0xde, 0x3c, 0xe6, 0x96, 0x78, 0xb7, 0x63, 0x07, 0x0b, 0x5c,
+		0x78, 0xdc, 0x5c, 0xec, 0xb7, 0xc1, 0x34, 0x7d, 0x57, 0x6f, 0xcf, 0xe6, 0x4e, 0x34, 0x3e, 0xa6,
+		0xb3, 0xb1, 0x67, 0x2b, 0x2f, 0xc1, 0xf4, 0xc6, 0x1e, 0xcb, 0x85, 0x1c, 0x87, 0x74, 0xcf, 0xb2,
+		0xf8, 0x9a, 0xcc, 0x7c, 0xa1, 0x14, 0x02, 0xe8, 0x12, 0x24, 0xe2, 0xff, 0xa8, 0x17, 0xff, 0x7b,
+		0x09, 0x8c, 0xd8, 0x44, 0x09, 0x8c, 0xd3, 0xff, 0x36, 0x02, 0x19, 0x9f, 0x7d, 0x90, 0x1f, 0x81,
+		0x23, 0x95, 0xe5, 0xb5, 0xea, 0xb3, 0x5a, 0x7d, 0x49, 0xbb, 0xb0, 0x5c, 0xbe, 0xe8, 0xdd, 0xb0,
+		0x2b, 0x1e, 0xbd, 0x71, 0x73, 0x41, 0xf6, 0xe1, 0x6e, 0x9a, 0x74, 0x37, 0x47, 0x3e, 0x03, 0xf3,
+		0x41, 0x92, 0x72, 0xa5, 0x81, 0xd7, 0xed, 0x22, 0xc5, 0x23, 0x37, 0x6e, 0x2e, 0xcc, 0xfa, 0x28,
+		0xca, 0x5b, 0x36, 0x31, 0x9d, 0x61, 0x82, 0xea, 0xda, 0xca, 0x4a, 0x7d, 0x43, 0x8a, 0x0e, 0x11,
+		0x70, 0x83, 0x7d, 0x3f, 0xcc, 0x06, 0x09, 0x56, 0xeb, 0xcb, 0x52, 0xac, 0x28, 0xdf, 0xb8, 0xb9,
+		0x30, 0xe3, 0xc3, 0x5e, 0x35, 0xda, 0xc5, 0xd4, 0xfb, 0x7e, 0xf1, 0xc4, 0xd4, 0xa7, 0xff, 0xf6,
+		0x89, 0x08, 0xf6, 0x2c, 0x17, 0xb0, 0x11, 0xf2, 0x83, 0x70, 0x5b, 0xa3, 0x7e, 0x71, 0xb5, 0xb6,
+		0xa4, 0xad, 0x34, 0x2e, 0x0e, 0x5c, 0x9a, 0x2e, 0xe6, 0x6f, 0xdc, 0x5c, 0xc8, 0xf0, 0x2e, 0x8d,
+		0xc3, 0x5e, 0x57, 0x6b, 0x57, 0xd6, 0x36, 0x6a, 0x52, 0x84, 0x61, 0xaf, 0xf7, 0xc8, 0x35, 0xcb,
+		0x61, 0x4f, 0x72, 0x3e, 0x0c, 0xc7, 0x46, 0x60, 0xbb, 0x1d, 0x9b, 0xbd, 0x71, 0x73, 0x21, 0xb7,
+		0xde, 0x23, 0x6c, 0xfe, 0x50, 0x8a, 0x45, 0x28, 0x0c, 0x53, 0xac, 0xad, 0xaf, 0x35, 0xca, 0xcb,
+		0xd2, 0x42, 0x51, 0xba, 0x71, 0x73, 0x21, 0x2b, 0x8c, 0x21, 0xe2, 0x7b, 0x3d, 0x7b, 0xb3, 0xa2,
+		0x9d, 0x9f, 0x98, 0x86, 0x3b, 0x06, 0x0b, 0xcf, 0x74, 0xf5, 0x9e, 0xde, 0x39, 0x6c, 0xb8, 0x13,
+		0xb2, 0x9d, 0xab, 0xbc, 0x12, 0x85, 0xbc, 0xeb, 0x4c, 0xaf, 0xd3, 0x1a, 0xe4, 0x47, 0xfd, 0x79,
+		0x98, 0xcc, 0xd8, 0x65, 0x8c, 0x61, 0x8b, 0x34, 0x0d, 0x26, 0xf2, 0xb9, 0x53, 0xc6, 0xcd, 0xc5,
+		0xc2, 0x30, 0x5d, 0x8d, 0x63, 0x70, 0x52, 0x97, 0x42, 0x7e, 0x06, 0xd2, 0xae, 0xf1, 0x70, 0x9f,
+		0xad, 0x1a, 0x6f, 0x6d, 0x38, 0xbd, 0x47, 0x83, 0x2f, 0x49, 0x89, 0xb0, 0x21, 0x3e, 0x2e, 0x10,
+		0xb9, 0xc2, 0x10, 0x38, 0xb1, 0xc0, 0x57, 0xea, 0x7c, 0xda, 0xf1, 0xde, 0xd3, 0x27, 0x3f, 0xf6,
+		0x34, 0x16, 0x49, 0x31, 0xaf, 0x24, 0xd5, 0xd1, 0xf7, 0x2a, 0xf8, 0x8d, 0x86, 0x02, 0x0b, 0x77,
+		0xf8, 0xa5, 0xf2, 0x98, 0x9a, 0xec, 0xe8, 0x7b, 0x17, 0x75, 0xfb, 0x72, 0x3c, 0x15, 0x93, 0xe2,
+		0xca, 0x2f, 0x47, 0x60, 0x26, 0xd8, 0x47, 0xf9, 0x01, 0x90, 0x91, 0x02, 0xf7, 0x4b, 0xd0, 0xc4,
+		0x50, 0x61, 0x09, 0xbe, 0xf9, 0x8e, 0xbe, 0x57, 0xde, 0x21, 0xab, 0xfd, 0x0e, 0x6d, 0x80, 0x2d,
+		0xe3, 0xe6, 0x0a, 0x47, 0x16, 0xe3, 0xc4, 0x85, 0x79, 0x6c, 0xf8, 0x11, 0x4b, 0x8e, 0xc0, 0x16,
+		0xaa, 0x0f, 0xe1, 0x42, 0x35, 0xc3, 0xf8, 0x89, 0x92, 0x60, 0x57, 0x62, 0xc1, 0xae, 0x28, 0xcf,
+		0x40, 0x7e, 0x40, 0x9e, 0xb2, 0x02, 0x39, 0x9e, 0x15, 0xa0, 0x5b, 0x9d, 0xcc, 0x3f, 0x4e, 0xab,
+		0x19, 0x16, 0xfd, 0xd3, 0xad, 0xdf, 0x52, 0xea, 0x8b, 0x98, 0x8a, 0xc2, 0x84, 0xf9, 0x03, 0x90,
+		0x0b, 0x48, 0x54, 0x64, 0xea, 0x22, 0x5e, 0xa6, 0xce, 0x43, 0x7e, 0x11, 0xb2, 0x68, 0x28, 0x49,
+		0x8b, 0xe3, 0xde, 0x0b, 0x79, 0x66, 0xc8, 0x07, 0x65, 0xcd, 0x3c, 0xa9, 0x15, 0x21, 0x70, 0x05,
+		0x72, 0x1e, 0x9e, 0x27, 0xf6, 0x8c, 0xc0, 0xba, 0xa8, 0xdb, 0x95, 0x77, 0x7c, 0xfa, 0xb5, 0x13,
+		0x91, 0x37, 0x67, 0x22, 0xfe, 0xde, 0x3b, 0xe0, 0xb8, 0xaf, 0x50, 0xdf, 0x6a, 0x1a, 0x81, 0xac,
+		0x43, 0xde, 0x2b, 0x5c, 0xc4, 0xc2, 0xb0, 0xec, 0xc1, 0x81, 0x39, 0x8c, 0x83, 0x13, 0x66, 0xc5,
+		0x83, 0x2d, 0x42, 0x78, 0x62, 0x63, 0x74, 0x8e, 0xf2, 0xff, 0xa4, 0x60, 0x5a, 0x25, 0xef, 0xe9,
+		0x13, 0xdb, 0x91, 0xcf, 0x42, 0x9c, 0x34, 0x77, 0xad, 0x51, 0x29, 0x21, 0xec, 0xdc, 0x22, 0xc7,
+		0xab, 0x35, 0x77, 0xad, 0x4b, 0x53, 0x2a, 0xc5, 0x95, 0xcf, 0x41, 0x62, 0xbb, 0xdd, 0xe7, 0x79,
+		0x8a, 0x01, 0x63, 0xe1, 0x27, 0xba, 0x80, 0x48, 0x97, 0xa6, 0x54, 0x86, 0x8d, 0x55, 0xd1, 0x87,
+		0x82, 0x63, 0x07, 0x57, 0x85, 0xb7, 0x49, 0xb1, 0x2a, 0xc4, 0x95, 0x2b, 0x00, 0x86, 0x69, 0x38,
+		0x1a, 0x8d, 0xe1, 0x0b, 0x89, 0x61, 0x2b, 0x11, 0xa4, 0x34, 0x1c, 0x1a, 0xf5, 0x63, 0x26, 0xd5,
+		0x10, 0x1f, 0xd8, 0xdc, 0xf7, 0xf4, 0x49, 0x6f, 0xbf, 0x90, 0x3c, 0xb8, 0xb9, 0xef, 0x40, 0x24,
+		0x6c, 0x2e, 0xc5, 0x96, 0x6b, 0x90, 0xa1, 0x37, 0x64, 0xd9, 0xfc, 0xe5, 0x4f, 0xd3, 0x2a, 0xe3,
+		0x88, 0x2b, 0x88, 0x4a, 0xa7, 0xf4, 0xa5, 0x29, 0x15, 0xb6, 0xdc, 0x2f, 0x34, 0x92, 0xec, 0xe9,
+		0x32, 0x67, 0xaf, 0x90, 0x1a, 0x36, 0x53, 0x7e, 0x1e, 0xf4, 0xfd, 0xb2, 0x8d, 0x3d, 0xcc, 0x12,
+		0x37, 0xd9, 0x4f, 0xec, 0x7f, 0x8b, 0xb4, 0x0d, 0x7c, 0x19, 0xce, 0xd9, 0x2b, 0xa4, 0x0f, 0xee,
+		0xff, 0x12, 0xc3, 0xa4, 0x1c, 0xd2, 0x2d, 0xf1, 0x81, 0x86, 0x96, 0x98, 0x2d, 0xde, 0x0d, 0x18,
+		0xb6, 0xd3, 0x81, 0x71, 0x36, 0x5b, 0xa2, 0x13, 0x29, 0xc2, 0x7f, 0xcb, 0x4f, 0xb8, 0x1e, 0x6a,
+		0x66, 0xd8, 0x29, 0x0c, 0x74, 0x80, 0xe5, 0x2a, 0xa6, 0x84, 0xa7, 0x2a, 0xaf, 0xc2, 0x4c, 0xdb,
+		0xb0, 0x1d, 0xcd, 0x36, 0xf5, 0xae, 0xbd, 0x6b, 0x39, 0x36, 0x0d, 0xfa, 0xf1, 0x91, 0xe0, 0x31,
+		0x1c, 0x96, 0x0d, 0xdb, 0x69, 0x08, 0xe4, 0x4b, 0x53, 0x6a, 0xae, 0xed, 0x07, 0x20, 0x3f, 0x6b,
+		0x7b, 0x9b, 0xf4, 0x5c, 0x86, 0x85, 0xdc, 0xc1, 0xfc, 0xd6, 0x10, 0x5b, 0xd0, 0x23, 0x3f, 0xcb,
+		0x0f, 0x90, 0x7f, 0x10, 0xe6, 0xda, 0x96, 0xde, 0x72, 0xd9, 0x69, 0xcd, 0xdd, 0xbe, 0x79, 0x95,
+		0x66, 0x12, 0xf0, 0x25, 0xcb, 0x71, 0x8d, 0xb4, 0xf4, 0x96, 0x60, 0x51, 0x45, 0x82, 0x4b, 0x53,
+		0xea, 0x6c, 0x7b, 0x10, 0x28, 0xbf, 0x0b, 0xe6, 0xf5, 0x6e, 0xb7, 0xbd, 0x3f, 0xc8, 0x3d, 0x4f,
+		0xb9, 0x9f, 0x1e, 0xc7, 0xbd, 0x8c, 0x34, 0x83, 0xec, 0x65, 0x7d, 0x08, 0x2a, 0x6f, 0x60, 0x0e,
+		0x84, 0xd0, 0x4b, 0x35, 0x5d, 0xee, 0xaa, 0xd0, 0x27, 0x87, 0xf0, 0x35, 0xf1, 0x31, 0xbc, 0xd7,
+		0x19, 0xbe, 0xf0, 0x6c, 0x2e, 0x4d, 0x61, 0xba, 0x24, 0x00, 0x62, 0x5c, 0xad, 0x26, 0xa1, 0x2f,
+		0xa2, 0x71, 0xae, 0xb3, 0x61, 0x5c, 0x29, 0x7e, 0x90, 0x6b, 0x00, 0x54, 0x99, 0xe6, 0x87, 0xe2,
+		0xf8, 0x8b, 0x31, 0xf7, 0x41, 0xc6, 0x67, 0x58, 0x30, 0xa9, 0xce, 0x0f, 0x09, 0x88, 0xc3, 0x74,
+		0xfc, 0x53, 0x99, 0x81, 0xac, 0xdf, 0x98, 0x28, 0x1f, 0x88, 0x40, 0xc6, 0x67, 0x27, 0x90, 0xd2,
+		0x9f, 0x3a, 0x4c, 0x7b, 0x59, 0xc1, 0xbb, 0xc4, 0x2a, 0x22, 0xca, 0xd9, 0x6e, 0x52, 0x96, 0x02,
+		0xf9, 0x22, 0x86, 0x77, 0xe6, 0xbb, 0x67, 0xbb, 0x2e, 0x4a, 0x8c, 0xa2, 0x40, 0xf7, 0x6c, 0x57,
+		0x20, 0xdc, 0x09, 0x59, 0xec, 0xa9, 0xe6, 0x77, 0x34, 0xd2, 0x6a, 0x06, 0x61, 0x1c, 0x45, 0xf9,
+		0x97, 0x51, 0x90, 0x06, 0x0d, 0x90, 0x9b, 0x53, 0x8c, 0x1c, 0x3a, 0xa7, 0x78, 0x6c, 0x30, 0x9b,
+		0xe9, 0x25, 0x30, 0x97, 0x41, 0xf2, 0xf2, 0x70, 0x6c, 0x21, 0x18, 0xef, 0x38, 0x0d, 0x78, 0x78,
+		0x6a, 0xbe, 0x19, 0x04, 0xc8, 0x17, 0x02, 0x7b, 0x2f, 0xe2, 0x25, 0xfc, 0xc1, 0x21, 0x76, 0xfd,
+		0x85, 0xcd, 0x6e, 0x4b, 0x77, 0x88, 0xc8, 0x8b, 0x78, 0x94, 0xb8, 0xac, 0x63, 0xda, 0xcf, 0x76,
+		0x74, 0x87, 0xf0, 0x65, 0x3d, 0xc1, 0xd2, 0x83, 0x7a, 0xb7, 0xdb, 0x40, 0x28, 0x5b, 0xd6, 0xef,
+		0x81, 0x19, 0xb4, 0xc9, 0x86, 0xde, 0xd6, 0x78, 0x96, 0x20, 0xc9, 0x56, 0x7f, 0x0e, 0xbd, 0x44,
+		0x81, 0x4a, 0x0b, 0xb2, 0x7e, 0x7b, 0xec, 0x86, 0x59, 0x11, 0x5f, 0x98, 0x25, 0xf3, 0x27, 0x10,
+		0x98, 0x7c, 0xc4, 0xb3, 0x11, 0xa3, 0xb3, 0xbb, 0xf3, 0x34, 0x24, 0xbb, 0xc6, 0x12, 0x1e, 0x29,
+		0x95, 0x7d, 0x28, 0xef, 0x8d, 0xc2, 0xec, 0x90, 0xe5, 0x1e, 0x99, 0xf6, 0xf6, 0xe2, 0xcb, 0xe8,
+		0xa1, 0xe2, 0xcb, 0x67, 0x83, 0x69, 0x5d, 0xdf, 0xca, 0x77, 0x7c, 0x48, 0xc8, 0xcc, 0x6e, 0xa2,
+		0x42, 0x73, 0x26, 0xbe, 0xcc, 0x2f, 0x42, 0xe5, 0x4d, 0x98, 0xdf, 0xda, 0x7f, 0x59, 0x37, 0x1d,
+		0xc3, 0x24, 0xda, 0xd0, 0xa8, 0x0d, 0x2f, 0xa5, 0x2b, 0x86, 0xbd, 0x45, 0x76, 0xf5, 0x6b, 0x86,
+		0x25, 0x9a, 0x35, 0xe7, 0xd2, 0x7b, 0xc9, 0x5d, 0x45, 0x85, 0x99, 0xe0, 0xd2, 0x23, 0xcf, 0x40,
+		0xd4, 0xd9, 0xe3, 0xfd, 0x8f, 0x3a, 0x7b, 0xf2, 0xc3, 0x3c, 0x0f, 0x14, 0xa5, 0x79, 0xa0, 0xe1,
+		0x8a, 0x38, 0x9d, 0x97, 0x04, 0x52, 0x14, 0x90, 0x06, 0x97, 0xa3, 0x41, 0xae, 0xca, 0xfd, 0x90,
+		0x1f, 0x58, 0x6f, 0xc6, 0x65, 0x05, 0x95, 0x3c, 0xe4, 0x38, 0x2a, 0x13, 0x87, 0x72, 0x14, 0xe6,
+		0x47, 0xad, 0x15, 0xca, 0x2e, 0xcc, 0x8f, 0xb2, 0xf9, 0xf2, 0x39, 0x48, 0xb9, 0x8b, 0xc5, 0x88,
+		0x2c, 0x04, 0xed, 0x85, 0x40, 0x56, 0x5d, 0xd4, 0x40, 0x32, 0x3b, 0x1a, 0x48, 0x66, 0x2b, 0xef,
+		0x86, 0xc2, 0xb8, 0x85, 0x60, 0xa0, 0x1b, 0x71, 0x57, 0x0b, 0x8f, 0x42, 0x92, 0x3f, 0x7b, 0x18,
+		0xa5, 0xdb, 0x37, 0xfc, 0x0b, 0xb5, 0x93, 0x2d, 0x0a, 0x31, 0x0a, 0x66, 0x1f, 0x8a, 0x06, 0xc7,
+		0xc6, 0x2e, 0x06, 0xe3, 0x37, 0x82, 0x18, 0x23, 0xbe, 0x11, 0xd4, 0x14, 0xcd, 0xb1, 0x69, 0x5f,
+		0xc5, 0x61, 0x07, 0xf6, 0xa5, 0x7c, 0x28, 0x06, 0x47, 0x47, 0x2f, 0x09, 0xf2, 0x02, 0x64, 0xd1,
+		0xef, 0x76, 0x82, 0x2e, 0x3a, 0x74, 0xf4, 0xbd, 0x0d, 0xee, 0x9f, 0xf3, 0x44, 0x7a, 0xd4, 0x4d,
+		0xa4, 0xcb, 0x9b, 0x30, 0xdb, 0xb6, 0x9a, 0x7a, 0x5b, 0xf3, 0x69, 0x3c, 0x57, 0xf6, 0xbb, 0x86,
+		0x84, 0x5d, 0x63, 0x8f, 0xa1, 0xb7, 0x86, 0x94, 0x3e, 0x4f, 0x79, 0x2c, 0xbb, 0x9a, 0x2f, 0x2f,
+		0x41, 0xa6, 0xe3, 0x29, 0xf2, 0x21, 0x94, 0xdd, 0x4f, 0xe6, 0x1b, 0x92, 0xc4, 0xc8, 0x6d, 0x9f,
+		0xe4, 0xa1, 0x4d, 0xf4, 0xb8, 0x1d, 0x94, 0xe9, 0xb1, 0x3b, 0x28, 0xa3, 0xb6, 0x2b, 0x52, 0xa3,
+		0xb7, 0x2b, 0xde, 0xe7, 0x1f, 0x9a, 0xc0, 0x22, 0x3a, 0xbc, 0x83, 0x21, 0x37, 0x60, 0x9e, 0xd3,
+		0xb7, 0x02, 0xb2, 0x8f, 0x4e, 0x6a, 0x68, 0x64, 0x41, 0x3e, 0x5e, 0xec, 0xb1, 0x37, 0x26, 0x76,
+		0x61, 0x4b, 0xe3, 0x3e, 0x5b, 0xfa, 0xff, 0xd9, 0x50, 0xfc, 0xeb, 0x34, 0xa4, 0x54, 0x62, 0x77,
+		0x71, 0xe1, 0xc4, 0x54, 0x32, 0xd9, 0x6b, 0x92, 0xae, 0xe3, 0x6d, 0x53, 0x8e, 0x0a, 0x06, 0x18,
+		0x76, 0x4d, 0x60, 0xa2, 0x27, 0xee, 0x92, 0x61, 0x56, 0x9d, 0x06, 0x5b, 0xe3, 0xe3, 0x26, 0x4e,
+		0xee, 0x8f, 0xb6, 0xce, 0x8b, 0x68, 0x2b, 0x36, 0xd6, 0xf9, 0x66, 0x54, 0x03, 0xe1, 0xd6, 0xa3,
+		0x3c, 0xdc, 0x8a, 0x87, 0x54, 0x16, 0x88, 0xb7, 0xaa, 0x81, 0x78, 0x2b, 0x19, 0xd2, 0xcd, 0x31,
+		0x01, 0xd7, 0x79, 0x11, 0x70, 0x4d, 0x87, 0xb4, 0x78, 0x20, 0xe2, 0xba, 0x10, 0x8c, 0xb8, 0x52,
+		0x63, 0x0c, 0x88, 0xa0, 0x1e, 0x1b, 0x72, 0x3d, 0xed, 0x0b, 0xb9, 0xd2, 0x63, 0xe3, 0x1d, 0xc6,
+		0x64, 0x44, 0xcc, 0x55, 0x0d, 0xc4, 0x5c, 0x10, 0x22, 0x83, 0x31, 0x41, 0xd7, 0x0f, 0xf8, 0x83,
+		0xae, 0xcc, 0xd8, 0xb8, 0x8d, 0x8f, 0xf7, 0xa8, 0xa8, 0xeb, 0x49, 0x37, 0xea, 0xca, 0x8e, 0x0d,
+		0x1b, 0x79, 0x1f, 0x06, 0xc3, 0xae, 0xb5, 0xa1, 0xb0, 0x2b, 0xc7, 0xff, 0xce, 0xc8, 0x38, 0x16,
+		0x21, 0x71, 0xd7, 0xda, 0x50, 0xdc, 0x35, 0x13, 0xc2, 0x30, 0x24, 0xf0, 0xfa, 0xa1, 0xd1, 0x81,
+		0xd7, 0xf8, 0xd0, 0x88, 0x37, 0x73, 0xb2, 0xc8, 0x4b, 0x1b, 0x13, 0x79, 0xb1, 0xe8, 0xe8, 0x81,
+		0xb1, 0xec, 0x27, 0x0e, 0xbd, 0x36, 0x47, 0x84, 0x5e, 0x2c, 0x48, 0x3a, 0x35, 0x96, 0xf9, 0x04,
+		0xb1, 0xd7, 0xe6, 0x88, 0xd8, 0x4b, 0x0e, 0x65, 0x7b, 0x98, 0xe0, 0x0b, 0x9f, 0x87, 0xbc, 0x1f,
+		0x66, 0x05, 0xb1, 0x6b, 0xa7, 0xd0, 0x7f, 0x20, 0xbd, 0x9e, 0xd5, 0x13, 0x27, 0x6b, 0xe9, 0x87,
+		0x72, 0x0a, 0xb2, 0x2e, 0xea, 0xc1, 0x81, 0x1a, 0xf5, 0xd3, 0x7c, 0x76, 0x48, 0xf9, 0x62, 0x04,
+		0xb2, 0x7e, 0x13, 0x13, 0x70, 0xe4, 0xd3, 0xdc, 0x91, 0xf7, 0x85, 0x6f, 0xd1, 0x60, 0xf8, 0x86,
+		0xcf, 0x79, 0x76, 0x87, 0x22, 0x33, 0xbd, 0xeb, 0x46, 0x66, 0xe2, 0x48, 0x01, 0x0b, 0xf2, 0xf8,
+		0xb2, 0xc2, 0x76, 0x72, 0xf2, 0xee, 0xf1, 0x0a, 0x16, 0x53, 0xc8, 0x0f, 0xc1, 0x9c, 0x0f, 0xd7,
+		0xf5, 0xeb, 0x58, 0x98, 0x22, 0xb9, 0xd8, 0x65, 0xee, 0xe0, 0xfd, 0x93, 0x08, 0xcc, 0x0e, 0x99,
+		0xb8, 0x91, 0xd1, 0x57, 0xe4, 0x16, 0x45, 0x5f, 0xd1, 0x37, 0x1c, 0x7d, 0xf9, 0xfd, 0xd4, 0x58,
+		0xd0, 0x4f, 0xfd, 0xef, 0x11, 0xc8, 0x05, 0x2c, 0x2d, 0x0e, 0x41, 0xd3, 0x6a, 0x11, 0xee, 0x39,
+		0xd2, 0xdf, 0xe8, 0x54, 0xb4, 0xad, 0x1d, 0xee, 0x1f, 0xe2, 0x4f, 0xc4, 0x72, 0x17, 0x8e, 0x34,
+		0x5f, 0x17, 0x5c, 0xa7, 0x33, 0xe1, 0x3f, 0xff, 0xcb, 0x0f, 0xc5, 0x26, 0xbd, 0x43, 0xb1, 0xee,
+		0xe5, 0xb7, 0x69, 0xdf, 0xe5, 0x37, 0xf9, 0x09, 0x48, 0xd3, 0x8c, 0xa8, 0x86, 0x07, 0x62, 0x53,
+		0xc3, 0xbe, 0x49, 0xf0, 0x40, 0xac, 0x4d, 0x8f, 0xea, 0xd1, 0x5f, 0x3e, 0x8f, 0x21, 0x1d, 0xf0,
+		0x18, 0x6e, 0x87, 0x34, 0xb6, 0x9e, 0xbd, 0xae, 0x0e, 0xfc, 0xe6, 0xa4, 0x00, 0x28, 0xef, 0x02,
+		0x79, 0x78, 0x91, 0x90, 0x2f, 0x41, 0x92, 0x5c, 0xa3, 0xaf, 0xfa, 0xb1, 0x83, 0x86, 0x47, 0x87,
+		0x5d, 0x53, 0x2c, 0xae, 0x14, 0x50, 0xc8, 0xdf, 0x78, 0xf5, 0xa4, 0xc4, 0xb0, 0x1f, 0x74, 0x2f,
+		0x0a, 0xa8, 0x9c, 0x5e, 0xf9, 0xf7, 0x51, 0xc8, 0x8b, 0x0a, 0x44, 0xe4, 0x34, 0x4a, 0xb6, 0xa3,
+		0xb6, 0x08, 0x27, 0x93, 0xf7, 0x09, 0x80, 0x1d, 0xdd, 0xd6, 0xae, 0xeb, 0xa6, 0x43, 0x5a, 0x5c,
+		0xe8, 0x3e, 0x08, 0x1e, 0xb0, 0xc5, 0xaf, 0xbe, 0x4d, 0x5a, 0x3c, 0x8c, 0x76, 0xbf, 0x7d, 0xfd,
+		0x9c, 0xfe, 0xee, 0xfa, 0x19, 0x94, 0x72, 0x6a, 0x40, 0xca, 0xbe, 0xe0, 0x22, 0xed, 0x0f, 0x2e,
+		0xd8, 0xc1, 0x60, 0x7e, 0x3e, 0x11, 0x58, 0xdb, 0xc4, 0x37, 0x66, 0x65, 0x3a, 0xa4, 0xd3, 0xb5,
+		0xac, 0xb6, 0xc6, 0xcc, 0x0d, 0xfb, 0x33, 0x0a, 0x59, 0x0e, 0xac, 0x51, 0xab, 0xf3, 0xe3, 0x51,
+		0x98, 0x1d, 0x5a, 0x5e, 0xbf, 0xff, 0x04, 0x4c, 0xdf, 0x9d, 0x1e, 0x74, 0x11, 0xe4, 0x86, 0xff,
+		0xd4, 0x48, 0x9f, 0x9a, 0x05, 0xa1, 0xd0, 0x93, 0xda, 0x0f, 0xe9, 0x5a, 0x10, 0x6c, 0xcb, 0x2f,
+		0xc0, 0x6d, 0x03, 0xb6, 0xcd, 0x65, 0x1d, 0x9d, 0xd4, 0xc4, 0x1d, 0x09, 0x9a, 0x38, 0xc1, 0xda,
+		0x13, 0x56, 0xec, 0xbb, 0x9c, 0x75, 0x75, 0x98, 0x11, 0xd2, 0xe0, 0x91, 0xca, 0xa8, 0xe1, 0xa7,
+		0x7f, 0xb6, 0xc7, 0xc1, 0x04, 0x5a, 0x20, 0x1d, 0x94, 0x65, 0x40, 0x9e, 0x64, 0x5a, 0x87, 0x23,
+		0x23, 0x3d, 0x1f, 0xf9, 0x71, 0x48, 0x7b, 0x4e, 0x13, 0x93, 0xea, 0x01, 0xe9, 0x02, 0x0f, 0x57,
+		0xf9, 0xcd, 0x08, 0x1c, 0x19, 0xe9, 0xfb, 0xc8, 0x35, 0x48, 0xb2, 0x63, 0x6f, 0xfc, 0x30, 0xcd,
+		0x43, 0x93, 0xf9, 0x4c, 0x8b, 0xec, 0x4c, 0x9c, 0xca, 0x89, 0x95, 0x77, 0x41, 0x92, 0x41, 0xe4,
+		0x0c, 0x4c, 0x7b, 0x0f, 0xef, 0x02, 0x24, 0xcb, 0xd5, 0x6a, 0x6d, 0x5d, 0xbc, 0xa2, 0x59, 0x59,
+		0x53, 0xf1, 0x2f, 0x6f, 0x01, 0x24, 0xd5, 0xda, 0xe5, 0x5a, 0x75, 0x43, 0x8a, 0xe1, 0xdf, 0xaa,
+		0x62, 0xbf, 0xb5, 0x0b, 0xf8, 0x8c, 0xfa, 0x86, 0x14, 0xf7, 0x81, 0x1a, 0xb5, 0xd5, 0xa5, 0x9a,
+		0x2a, 0x25, 0x94, 0x47, 0xe0, 0x98, 0x68, 0xc7, 0x70, 0x5a, 0xc3, 0xcd, 0x2e, 0x44, 0x7c, 0xd9,
+		0x05, 0x7c, 0x82, 0xbb, 0x38, 0xde, 0x75, 0x92, 0x2f, 0x0f, 0x74, 0xfc, 0xec, 0x21, 0xfc, 0xae,
+		0x81, 0xde, 0x63, 0xf2, 0xb0, 0x47, 0xb6, 0x89, 0xd3, 0xdc, 0x65, 0xae, 0x1c, 0x5b, 0x32, 0x73,
+		0x6a, 0x8e, 0x43, 0x29, 0x91, 0xcd, 0xd0, 0x5e, 0x22, 0x4d, 0x47, 0x63, 0xb6, 0xc8, 0xe6, 0x7f,
+		0x59, 0x34, 0xc7, 0xa0, 0x0d, 0x06, 0x54, 0xde, 0x7d, 0x28, 0x59, 0xa6, 0x21, 0xa1, 0xd6, 0xf0,
+		0xad, 0xf4, 0x18, 0xfe, 0x41, 0x2e, 0xfa, 0x53, 0x6b, 0xac, 0x96, 0xd7, 0x1b, 0x97, 0xd6, 0x50,
+		0x96, 0x73, 0x90, 0x17, 0xb2, 0x14, 0xc0, 0x84, 0xf2, 0x00, 0xdc, 0x36, 0xc6, 0xef, 0x1b, 0x71,
+		0x0e, 0xf1, 0x13, 0x11, 0x3f, 0x76, 0x30, 0xe6, 0x5f, 0x83, 0xa4, 0xed, 0xe8, 0x4e, 0xdf, 0xe6,
+		0x42, 0x7c, 0x7c, 0x52, 0x47, 0x70, 0x51, 0xfc, 0x68, 0x50, 0x72, 0x95, 0xb3, 0x51, 0xce, 0xc1,
+		0x4c, 0xb0, 0x64, 0xbc, 0x0c, 0x3c, 0x25, 0x8a, 0x2a, 0x2f, 0x00, 0xf8, 0xf2, 0x91, 0xee, 0x89,
+		0xae, 0x88, 0xff, 0x44, 0xd7, 0x39, 0x48, 0x5c, 0xb3, 0x98, 0xcd, 0x18, 0x3d, 0x71, 0xf0, 0xb0,
+		0xa5, 0x2f, 0xf9, 0xc0, 0xb0, 0x15, 0x03, 0xe4, 0xe1, 0x9c, 0xd0, 0x98, 0x2a, 0x9e, 0x0e, 0x56,
+		0x71, 0xe7, 0xd8, 0xec, 0xd2, 0xe8, 0xaa, 0x5e, 0x86, 0x04, 0xb5, 0x36, 0x23, 0xaf, 0xf8, 0xbc,
+		0x13, 0x40, 0x77, 0x9c, 0x9e, 0xb1, 0xd5, 0xf7, 0x2a, 0x38, 0x39, 0xda, 0x5a, 0x95, 0x05, 0x5e,
+		0xe5, 0x76, 0x6e, 0xb6, 0xe6, 0x3d, 0x52, 0x9f, 0xe9, 0xf2, 0x31, 0x54, 0x56, 0x61, 0x26, 0x48,
+		0x3b, 0xfa, 0xca, 0x92, 0xf7, 0x76, 0x40, 0x5a, 0xb8, 0x4f, 0xae, 0xf3, 0xc5, 0xdf, 0xf3, 0xa0,
+		0x1f, 0xca, 0x8d, 0x08, 0xa4, 0x36, 0xf6, 0xb8, 0x1e, 0x1f, 0x70, 0xa8, 0xd2, 0xbb, 0xb7, 0xe5,
+		0x26, 0x0b, 0x59, 0x3e, 0x36, 0xe6, 0x66, 0x79, 0x7f, 0xc0, 0x9d, 0xa9, 0xf1, 0x49, 0xa3, 0x5d,
+		0x91, 0xed, 0xe6, 0xd6, 0xe9, 0xa9, 0xc9, 0xee, 0x48, 0x60, 0x32, 0xde, 0x77, 0xbf, 0x81, 0x7d,
+		0x28, 0x2d, 0xdf, 0xb1, 0x04, 0xb6, 0x6c, 0xf8, 0x2f, 0x53, 0x44, 0x0e, 0x7d, 0x99, 0xc2, 0xad,
+		0x25, 0xea, 0xaf, 0xe5, 0x1a, 0xa4, 0x84, 0x52, 0xc8, 0x6f, 0xf7, 0x9f, 0x3d, 0x11, 0x7b, 0x34,
+		0x63, 0x17, 0x4f, 0xce, 0xde, 0x23, 0xc1, 0xe0, 0x83, 0x1f, 0xb9, 0xf3, 0xe2, 0x0a, 0xfe, 0x27,
+		0x07, 0xf2, 0xac, 0x60, 0x59, 0x04, 0x15, 0xca, 0x2f, 0xe1, 0x6b, 0xfc, 0x03, 0x5a, 0xf9, 0x56,
+		0x36, 0x00, 0x8d, 0x22, 0x6a, 0xbf, 0xef, 0xcd, 0x6f, 0x36, 0xf2, 0x39, 0x84, 0x7a, 0xaf, 0x7e,
+		0xbf, 0x37, 0x0a, 0x19, 0x5f, 0x4e, 0x4f, 0x7e, 0x2c, 0x70, 0x04, 0x74, 0xe1, 0xa0, 0xfc, 0x9f,
+		0xef, 0x0c, 0x68, 0xa0, 0x63, 0xd1, 0xc3, 0x77, 0xec, 0xd6, 0x1f, 0xd2, 0x1f, 0x7d, 0xdb, 0x27,
+		0x31, 0xe6, 0xb6, 0xcf, 0x8f, 0x46, 0x20, 0xe5, 0x2e, 0xdd, 0x87, 0xcd, 0xe6, 0x1f, 0x85, 0x24,
+		0x5f, 0x9d, 0x58, 0x3a, 0x9f, 0x7f, 0x8d, 0xcc, 0x85, 0x16, 0x21, 0x25, 0xfe, 0x50, 0x14, 0x0f,
+		0x44, 0xdd, 0xef, 0xd3, 0x4f, 0x42, 0xc6, 0xb7, 0xb1, 0x82, 0x76, 0x62, 0xb5, 0xf6, 0x9c, 0x34,
+		0x55, 0x9c, 0xbe, 0x71, 0x73, 0x21, 0xb6, 0x4a, 0xae, 0xe3, 0x0c, 0x53, 0x6b, 0xd5, 0x4b, 0xb5,
+		0xea, 0xb3, 0x52, 0xa4, 0x98, 0xb9, 0x71, 0x73, 0x61, 0x5a, 0x25, 0x34, 0x7d, 0x75, 0xfa, 0x59,
+		0xc8, 0x0f, 0x0c, 0x4c, 0xd0, 0xbe, 0xcb, 0x30, 0xb3, 0xb4, 0xb9, 0xbe, 0x5c, 0xaf, 0x96, 0x37,
+		0x6a, 0x1a, 0x3b, 0x4e, 0x87, 0xaf, 0xfa, 0x2f, 0xd7, 0x2f, 0x5e, 0xda, 0xd0, 0xaa, 0xcb, 0xf5,
+		0xda, 0xea, 0x86, 0x56, 0xde, 0xd8, 0x28, 0x57, 0x9f, 0x95, 0xa2, 0x67, 0xbf, 0x03, 0x90, 0x2f,
+		0x57, 0xaa, 0x75, 0x5c, 0x9f, 0x0d, 0xfe, 0x6c, 0x7b, 0x15, 0xe2, 0x34, 0x15, 0x70, 0xe0, 0x51,
+		0x91, 0xe2, 0xc1, 0xb9, 0x4d, 0xf9, 0x02, 0x24, 0x68, 0x96, 0x40, 0x3e, 0xf8, 0xec, 0x48, 0x31,
+		0x24, 0xd9, 0x89, 0x8d, 0xa1, 0xd3, 0xe9, 0xc0, 0xc3, 0x24, 0xc5, 0x83, 0x73, 0x9f, 0xb2, 0x0a,
+		0x69, 0x2f, 0xca, 0x08, 0x3f, 0x5c, 0x51, 0x9c, 0xc0, 0x3a, 0xca, 0xcb, 0x30, 0x2d, 0x02, 0xc3,
+		0xb0, 0xe3, 0x1e, 0xc5, 0xd0, 0xe4, 0x24, 0x8a, 0x8b, 0x05, 0xf0, 0x07, 0x9f, 0x5d, 0x29, 0x86,
+		0x64, 0x5a, 0xe5, 0xba, 0x7b, 0x08, 0x3f, 0xe4, 0x08, 0x47, 0x31, 0x2c, 0xd9, 0x88, 0x42, 0xf3,
+		0x52, 0x23, 0xe1, 0x27, 0x72, 0x8a, 0x13, 0x24, 0x91, 0xe5, 0x4d, 0x00, 0x5f, 0xb8, 0x3e, 0xc1,
+		0x51, 0x9b, 0xe2, 0x24, 0xc9, 0x61, 0x79, 0x0d, 0x52, 0x6e, 0xf4, 0x14, 0x7a, 0xf0, 0xa5, 0x18,
+		0x9e, 0xa5, 0x95, 0xdf, 0x05, 0xb9, 0x60, 0xd4, 0x30, 0xd9, 0x71, 0x96, 0xe2, 0x84, 0xe9, 0x57,
+		0xe4, 0x1f, 0x0c, 0x21, 0x26, 0x3b, 0xde, 0x52, 0x9c, 0x30, 0x1b, 0x2b, 0xbf, 0x04, 0xb3, 0xc3,
+		0x2e, 0xfe, 0xe4, 0xa7, 0x5d, 0x8a, 0x87, 0xc8, 0xcf, 0xca, 0x1d, 0x90, 0x47, 0x84, 0x06, 0x87,
+		0x38, 0xfc, 0x52, 0x3c, 0x4c, 0xba, 0x56, 0x6e, 0x41, 0x7e, 0xd0, 0xdf, 0x9e, 0xf4, 0x30, 0x4c,
+		0x71, 0xe2, 0xd4, 0x2d, 0xab, 0x25, 0xe8, 0xa7, 0x4f, 0x7a, 0x38, 0xa6, 0x38, 0x71, 0x26, 0xb7,
+		0x52, 0x1e, 0x7b, 0xbe, 0xf1, 0xbe, 0x03, 0xcf, 0x37, 0x7a, 0x27, 0x16, 0xbd, 0xe7, 0x8c, 0xce,
+		0xc1, 0xdd, 0xfc, 0x31, 0x01, 0xdb, 0xd1, 0xaf, 0x1a, 0xe6, 0x8e, 0xfb, 0x3a, 0x04, 0xff, 0xe6,
+		0x87, 0x1b, 0x8f, 0x32, 0xac, 0x45, 0x01, 0x0d, 0x79, 0x23, 0x62, 0xec, 0xc3, 0x59, 0x61, 0x87,
+		0x90, 0xc3, 0x8f, 0x2e, 0x1e, 0xf0, 0xfe, 0x44, 0xc8, 0x2b, 0x17, 0x23, 0xde, 0xa7, 0x08, 0x39,
+		0x84, 0x79, 0xd0, 0x79, 0x4f, 0xe5, 0x83, 0x11, 0x98, 0xb9, 0x64, 0xd8, 0x8e, 0xd5, 0x33, 0x9a,
+		0x7a, 0x9b, 0xae, 0x18, 0x4f, 0x4d, 0x7a, 0xc9, 0xa3, 0x92, 0x46, 0x67, 0x84, 0x3f, 0x6a, 0xc1,
+		0x48, 0xe4, 0x25, 0x48, 0x5e, 0xd3, 0xdb, 0xec, 0x8a, 0x85, 0xff, 0xfd, 0x9a, 0x41, 0x99, 0xfb,
+		0xbc, 0x24, 0x3f, 0x17, 0x46, 0xab, 0x7c, 0x81, 0x1e, 0xe2, 0xee, 0x74, 0x0c, 0x9b, 0xfd, 0x29,
+		0x77, 0x87, 0xe0, 0x36, 0x7c, 0xbc, 0xa7, 0x3b, 0x3c, 0xa8, 0xa9, 0x94, 0xf9, 0x53, 0x14, 0xf7,
+		0x86, 0x3f, 0x28, 0xb1, 0x38, 0xf4, 0x5a, 0x05, 0xab, 0x8c, 0xb2, 0x93, 0x7f, 0x08, 0xf0, 0x04,
+		0xb1, 0x46, 0x59, 0x47, 0x6f, 0x15, 0x6b, 0x3c, 0x53, 0x8d, 0xad, 0x96, 0x0d, 0xc0, 0x23, 0xd1,
+		0xb8, 0xe1, 0x68, 0xee, 0x10, 0x56, 0x49, 0xec, 0x56, 0x55, 0x92, 0xeb, 0xe8, 0x7b, 0x55, 0xca,
+		0x18, 0xab, 0xe2, 0x8f, 0x7e, 0xfc, 0x76, 0x04, 0xc0, 0x93, 0x9c, 0xac, 0x63, 0x5a, 0x5f, 0x7c,
+		0xd1, 0xfa, 0x45, 0x5a, 0xff, 0xbe, 0x71, 0x03, 0x33, 0x20, 0xf7, 0x4a, 0x0e, 0x5b, 0xfa, 0x95,
+		0x57, 0x4f, 0x46, 0x58, 0xad, 0xf9, 0xe6, 0xc0, 0xb8, 0x5c, 0x86, 0x0c, 0xcb, 0xa6, 0x69, 0xd4,
+		0x85, 0x8d, 0x86, 0xba, 0xb0, 0x39, 0xe1, 0xc2, 0x32, 0x86, 0xc0, 0xa8, 0xb1, 0x9c, 0xf7, 0xe1,
+		0xd3, 0x11, 0xc8, 0x2c, 0xf9, 0x9e, 0xab, 0xc2, 0x7d, 0x19, 0xcb, 0x34, 0xae, 0x92, 0x9e, 0xbb,
+		0x2f, 0xc3, 0x3e, 0xd1, 0xcd, 0x64, 0x7f, 0xf5, 0xc8, 0xd9, 0x17, 0x2f, 0x31, 0x88, 0x6f, 0xa4,
+		0xba, 0x4e, 0xb6, 0x6c, 0x43, 0x88, 0x5c, 0x15, 0x9f, 0xb8, 0x49, 0x6e, 0x93, 0x66, 0x1f, 0x53,
+		0xb6, 0xf8, 0x07, 0xcf, 0x1c, 0xfc, 0x6b, 0x86, 0x2c, 0x7b, 0x9a, 0x17, 0xf0, 0x2a, 0x03, 0x23,
+		0x93, 0x16, 0x71, 0x74, 0xa3, 0xcd, 0x8e, 0x7d, 0xa5, 0x55, 0xf1, 0xc9, 0x9b, 0xfa, 0x85, 0x69,
+		0x7f, 0x68, 0x58, 0x05, 0xc9, 0xea, 0x92, 0x5e, 0xe0, 0xca, 0x1b, 0x53, 0xd7, 0xc2, 0xef, 0x7f,
+		0xee, 0xa1, 0x79, 0x2e, 0x70, 0xbe, 0x01, 0xcf, 0x5e, 0x67, 0x56, 0xf3, 0x82, 0x82, 0x83, 0xe5,
+		0x17, 0x02, 0x3b, 0x31, 0xfd, 0x2d, 0xef, 0xca, 0xfd, 0xfc, 0x90, 0x50, 0xcb, 0xe6, 0x7e, 0xa5,
+		0xf0, 0xbb, 0x1e, 0x6b, 0x2f, 0x74, 0xc4, 0x4b, 0xee, 0xbe, 0x6d, 0x19, 0xca, 0x06, 0x3d, 0xf8,
+		0x97, 0x74, 0xa3, 0x2d, 0xfe, 0x40, 0x9c, 0xca, 0xbf, 0xe4, 0x92, 0x9b, 0x86, 0x89, 0xd3, 0x70,
+		0x48, 0x19, 0xa7, 0x1b, 0x15, 0xcb, 0x6c, 0x05, 0x33, 0x2e, 0xf2, 0x06, 0x24, 0x1d, 0xeb, 0x2a,
+		0x31, 0xb9, 0x80, 0x2a, 0x6f, 0x3b, 0x84, 0x62, 0x0f, 0xbf, 0x3f, 0xc3, 0x79, 0xc9, 0x3b, 0x20,
+		0xb5, 0x48, 0x9b, 0xec, 0x50, 0x51, 0xe2, 0x9f, 0xbe, 0x25, 0xec, 0x12, 0xe7, 0xe1, 0xf8, 0x0f,
+		0x3f, 0x53, 0x93, 0x77, 0xb9, 0x36, 0x28, 0x53, 0x79, 0x3d, 0xf8, 0x58, 0xda, 0x34, 0xdf, 0x95,
+		0x1f, 0xd3, 0x7f, 0x9f, 0x56, 0xfa, 0xcd, 0x96, 0x9f, 0x05, 0x6a, 0x57, 0xdf, 0xdc, 0xb2, 0x4c,
+		0xfa, 0x37, 0x97, 0x78, 0x80, 0x95, 0x62, 0x3b, 0x77, 0x2e, 0x9c, 0xef, 0xdc, 0xad, 0xc3, 0x8c,
+		0x87, 0x4a, 0x67, 0x4f, 0xfa, 0xb0, 0xb3, 0x27, 0xe7, 0x32, 0x40, 0x14, 0xfc, 0xe3, 0x4c, 0xde,
+		0xfc, 0x74, 0x77, 0xf7, 0x43, 0x67, 0xba, 0xbf, 0x33, 0x3e, 0x06, 0x72, 0x1b, 0xe6, 0x3a, 0x86,
+		0xa9, 0xd9, 0xa4, 0xbd, 0xad, 0x71, 0xc9, 0x21, 0xdf, 0xcc, 0x2d, 0x18, 0xe9, 0xd9, 0x8e, 0x61,
+		0x36, 0x48, 0x7b, 0x7b, 0xc9, 0x65, 0x2b, 0xbf, 0x0d, 0x8e, 0x7b, 0xe2, 0xb0, 0x4c, 0x6d, 0xd7,
+		0x6a, 0xb7, 0xb4, 0x1e, 0xd9, 0xd6, 0x9a, 0xf4, 0xf9, 0xa3, 0x2c, 0x15, 0xe2, 0x6d, 0x2e, 0xca,
+		0x9a, 0x79, 0xc9, 0x6a, 0xb7, 0x54, 0xb2, 0x5d, 0xc5, 0x62, 0x4c, 0x8d, 0x7b, 0xd4, 0x46, 0x0b,
+		0x4f, 0x05, 0xc4, 0xf0, 0x48, 0xac, 0x0b, 0xac, 0xb7, 0xec, 0x52, 0xea, 0x7d, 0x1f, 0x3f, 0x39,
+		0xf5, 0xf5, 0x8f, 0x9f, 0x9c, 0x52, 0x2e, 0xd0, 0xc7, 0x3a, 0xf8, 0xa4, 0x23, 0xb6, 0x7c, 0x1e,
+		0xd2, 0xba, 0xf8, 0x60, 0xd7, 0x44, 0x0e, 0x98, 0xb4, 0x1e, 0xaa, 0xf2, 0xf1, 0x08, 0x24, 0x97,
+		0xae, 0xac, 0xeb, 0x46, 0x4f, 0xae, 0xe1, 0x33, 0x6f, 0x42, 0x69, 0x27, 0x9d, 0xff, 0x9e, 0x9e,
+		0x73, 0x38, 0xb2, 0x19, 0x7d, 0x73, 0xf6, 0x40, 0x36, 0x83, 0x77, 0x6a, 0x7d, 0x5d, 0xbd, 0x0c,
+		0xd3, 0xac, 0x85, 0xb6, 0xfc, 0x0c, 0x24, 0xba, 0xf8, 0xa3, 0x10, 0x09, 0x3c, 0xf8, 0x36, 0xac,
+		0xe8, 0x14, 0xdf, 0xaf, 0x16, 0x8c, 0x4e, 0xf9, 0xcb, 0x08, 0xc0, 0xd2, 0x95, 0x2b, 0x1b, 0x3d,
+		0xa3, 0xdb, 0x26, 0xce, 0xad, 0xea, 0xf2, 0x32, 0x1c, 0xf1, 0xba, 0x6c, 0xf7, 0x9a, 0x13, 0x77,
+		0x7b, 0xce, 0x25, 0x6b, 0xf4, 0x9a, 0x23, 0xb9, 0xb5, 0x6c, 0xc7, 0xe5, 0x16, 0x9b, 0x98, 0xdb,
+		0x92, 0xed, 0x0c, 0xcb, 0xf1, 0x79, 0xc8, 0x78, 0x5d, 0xb7, 0xe5, 0x3a, 0xa4, 0x1c, 0xfe, 0x9b,
+		0x8b, 0x53, 0x19, 0x2f, 0x4e, 0x41, 0xe6, 0x17, 0xa9, 0x4b, 0xae, 0xfc, 0x4f, 0x94, 0xaa, 0x37,
+		0x11, 0xbe, 0xa7, 0x14, 0x09, 0x2d, 0x3c, 0xb7, 0xc0, 0xb1, 0x5b, 0x60, 0x81, 0x39, 0x2f, 0x9f,
+		0x58, 0x7f, 0x2c, 0x8a, 0x7f, 0x2d, 0x91, 0x4f, 0xd2, 0xef, 0x59, 0x29, 0x6c, 0xc2, 0x34, 0x31,
+		0x9d, 0x9e, 0x41, 0xc4, 0x56, 0xdf, 0xc3, 0xe3, 0x06, 0x7b, 0x44, 0x5f, 0xe8, 0x9f, 0xd7, 0xf6,
+		0x0f, 0xbd, 0xe0, 0xe5, 0x13, 0xc3, 0x6f, 0xc4, 0xa0, 0x30, 0x8e, 0x14, 0x1f, 0x2a, 0x68, 0xf6,
+		0x08, 0x05, 0x68, 0x81, 0x54, 0xf8, 0x8c, 0x00, 0xf3, 0x25, 0x45, 0x05, 0x74, 0xd0, 0x50, 0xab,
+		0x10, 0xf5, 0x8d, 0x79, 0x64, 0x33, 0x1e, 0x07, 0xc4, 0x91, 0x09, 0xe4, 0xc5, 0xd9, 0xf6, 0x2d,
+		0xbd, 0xad, 0xe3, 0x85, 0xc8, 0xd8, 0x2d, 0x58, 0x01, 0xc4, 0x81, 0xf9, 0x0a, 0xe3, 0x29, 0x5f,
+		0x81, 0x69, 0xc1, 0x3e, 0x7e, 0x0b, 0xd8, 0x0b, 0x66, 0x78, 0xcb, 0xc1, 0xbf, 0x30, 0x50, 0x3f,
+		0x25, 0xae, 0x66, 0x5c, 0x58, 0xbd, 0x15, 0xb6, 0xf2, 0x24, 0x0f, 0x5c, 0x79, 0xb8, 0x2b, 0xf8,
+		0xa5, 0x18, 0xee, 0xeb, 0xb7, 0xbe, 0x0f, 0x07, 0xee, 0x07, 0x01, 0xd8, 0xa4, 0x46, 0x63, 0x5b,
+		0x88, 0xdf, 0x02, 0x23, 0x91, 0x66, 0xfc, 0x96, 0x6c, 0xe7, 0xad, 0x1a, 0xbd, 0x7f, 0x11, 0x85,
+		0xac, 0x7f, 0xf4, 0xbe, 0x0f, 0x56, 0x36, 0x79, 0xd5, 0x33, 0x69, 0xec, 0xf4, 0xf9, 0xfd, 0xe3,
+		0x4c, 0xda, 0x90, 0x5e, 0x87, 0xd8, 0xb2, 0x4f, 0x24, 0x21, 0xc9, 0x8f, 0x82, 0xad, 0x0d, 0xf9,
+		0xb8, 0x91, 0xb0, 0xfb, 0xbf, 0x39, 0x71, 0xff, 0x77, 0xa4, 0x8b, 0x7b, 0x0f, 0xe0, 0xad, 0x60,
+		0x2d, 0x70, 0xbe, 0x0c, 0xb7, 0x23, 0x30, 0x1c, 0xf6, 0x4e, 0x43, 0xe3, 0x11, 0x3b, 0x44, 0xf3,
+		0x6c, 0x36, 0xe2, 0xe0, 0x41, 0xff, 0x1a, 0x83, 0xc8, 0x0f, 0x81, 0xbc, 0xeb, 0x26, 0x3e, 0x34,
+		0x4f, 0x10, 0x88, 0x37, 0xeb, 0x95, 0x08, 0x74, 0x7c, 0xa8, 0xc1, 0x32, 0x5b, 0x1a, 0x7b, 0xa1,
+		0x93, 0xbf, 0x76, 0x8a, 0x90, 0x25, 0x04, 0xc8, 0x3f, 0x1d, 0x61, 0xae, 0xf2, 0x40, 0xb4, 0xcd,
+		0x83, 0x16, 0xed, 0x70, 0xb3, 0xe1, 0xdb, 0xaf, 0x9e, 0x2c, 0xee, 0xeb, 0x9d, 0x76, 0x49, 0x19,
+		0xc1, 0x52, 0x19, 0x95, 0x0b, 0x40, 0x6f, 0x3a, 0x18, 0xb8, 0xcb, 0x7d, 0xbf, 0xae, 0xd0, 0xa6,
+		0x6f, 0xeb, 0x4d, 0xdc, 0xb7, 0x9a, 0xbe, 0x55, 0x09, 0x08, 0x4f, 0xa9, 0x30, 0x2e, 0xbc, 0x40,
+		0xb9, 0xcb, 0x3f, 0x0c, 0xc7, 0x76, 0xda, 0xd6, 0x16, 0x5e, 0x97, 0x30, 0xde, 0xd3, 0x37, 0x5a,
+		0x1a, 0xd7, 0x25, 0xad, 0xa9, 0x77, 0x0b, 0xa9, 0x5b, 0x55, 0xf5, 0x51, 0x56, 0xc7, 0x32, 0xad,
+		0xa2, 0xc1, 0x6a, 0xa8, 0xea, 0x5d, 0xf9, 0x47, 0x23, 0x70, 0xbb, 0xd7, 0xeb, 0x11, 0x2d, 0x48,
+		0xdf, 0xaa, 0x16, 0x1c, 0x73, 0xab, 0x19, 0x6c, 0x44, 0xe9, 0x6e, 0xb4, 0x28, 0x37, 0xbe, 0xf6,
+		0x99, 0xd3, 0xc7, 0x7d, 0xcc, 0xf6, 0xdc, 0xdc, 0x24, 0x9b, 0x18, 0x78, 0xb5, 0x5e, 0xf6, 0x96,
+		0x79, 0xf7, 0x24, 0xfe, 0x0a, 0x3d, 0x9f, 0xcd, 0xa1, 0xee, 0x51, 0xfc, 0xb1, 0xf1, 0xa8, 0xc0,
+		0x0c, 0x44, 0x70, 0x1e, 0x03, 0xf9, 0xed, 0xde, 0xa2, 0x2a, 0xee, 0xdd, 0x8f, 0x78, 0x25, 0x77,
+		0x11, 0xdf, 0x9f, 0x0d, 0xcc, 0x69, 0x4e, 0x44, 0xad, 0xe3, 0x94, 0xf2, 0x6a, 0x04, 0xcf, 0xcf,
+		0x0c, 0xd8, 0x00, 0xb7, 0xc9, 0x4d, 0x90, 0x7b, 0xbe, 0x42, 0x3a, 0x97, 0xc4, 0xce, 0xf6, 0x1b,
+		0x33, 0x29, 0xb3, 0xbd, 0xc1, 0xd2, 0x37, 0xcb, 0x3b, 0xe0, 0xe6, 0xff, 0x77, 0x22, 0x78, 0xc1,
+		0xaa, 0x35, 0x3c, 0x1c, 0x0d, 0xc8, 0xfa, 0xdb, 0xc2, 0x7b, 0x75, 0xf7, 0x24, 0xbd, 0xf2, 0x77,
+		0x28, 0xc0, 0x04, 0xfb, 0x22, 0xec, 0x0d, 0xcb, 0x92, 0x3e, 0x32, 0xb1, 0x94, 0xdc, 0x1d, 0xa0,
+		0x51, 0x06, 0x98, 0x0d, 0xd6, 0xfb, 0xa3, 0x10, 0x5f, 0xb7, 0xac, 0x36, 0x4e, 0x86, 0x59, 0xd3,
+		0x72, 0xe8, 0xe4, 0x27, 0x2d, 0x8d, 0xa7, 0x69, 0xd8, 0x1a, 0x76, 0xe5, 0x70, 0xd2, 0xfb, 0xc6,
+		0xab, 0x27, 0x87, 0x59, 0x8d, 0x7a, 0xdc, 0x38, 0x6f, 0x5a, 0x4e, 0x85, 0x22, 0x6d, 0x50, 0x1c,
+		0xf9, 0x3a, 0xe4, 0x82, 0xf5, 0xb3, 0x85, 0x4f, 0x3d, 0x74, 0xfd, 0xb9, 0xd0, 0xba, 0xb3, 0x5b,
+		0xbe, 0x8a, 0xd9, 0x13, 0xa3, 0x7f, 0x86, 0x83, 0xfb, 0xb9, 0x08, 0xcc, 0x51, 0xa0, 0xf1, 0x32,
+		0xa1, 0x69, 0x1f, 0x95, 0x34, 0xad, 0x5e, 0x0b, 0x0f, 0x8a, 0xf0, 0xc7, 0x7b, 0xe2, 0x6a, 0xd4,
+		0x68, 0xe1, 0xfb, 0x36, 0xd6, 0x75, 0x93, 0x9f, 0xac, 0x38, 0x68, 0x35, 0x65, 0x68, 0x74, 0x25,
+		0xb2, 0x5a, 0xfd, 0x36, 0xd1, 0xf4, 0x26, 0x73, 0x35, 0x58, 0xa2, 0x31, 0xc7, 0xa0, 0x65, 0x06,
+		0xc4, 0xcc, 0x82, 0x77, 0x68, 0x20, 0x1e, 0xc2, 0xda, 0x43, 0x55, 0x5e, 0x00, 0xe9, 0xca, 0xe0,
+		0x51, 0xc6, 0x1a, 0x4c, 0x1f, 0xf6, 0x54, 0xa4, 0x5f, 0x51, 0x38, 0xed, 0xe9, 0xcf, 0x47, 0x00,
+		0xbc, 0x5c, 0x1e, 0x3e, 0x47, 0x53, 0x59, 0x5b, 0x5d, 0xd2, 0x1a, 0x1b, 0xe5, 0x8d, 0xcd, 0x46,
+		0xf0, 0x21, 0x7f, 0xf1, 0x78, 0x8d, 0xdd, 0x25, 0x4d, 0x63, 0xdb, 0x20, 0x2d, 0xf9, 0x5e, 0x98,
+		0x0f, 0x62, 0xe3, 0x57, 0x6d, 0x49, 0x8a, 0x14, 0xb3, 0x37, 0x6e, 0x2e, 0xa4, 0x58, 0x0c, 0x43,
+		0xf0, 0xe9, 0xbf, 0x23, 0xc3, 0x78, 0xf8, 0x47, 0x00, 0xa2, 0xc5, 0xdc, 0x8d, 0x9b, 0x0b, 0x69,
+		0x37, 0xd8, 0x91, 0x15, 0x90, 0xfd, 0x98, 0x9c, 0x5f, 0xac, 0x08, 0x37, 0x6e, 0x2e, 0x24, 0x99,
+		0x36, 0x15, 0xe3, 0xf8, 0x44, 0xcd, 0xe9, 0x77, 0x02, 0xd4, 0xcd, 0xed, 0x9e, 0x4e, 0xff, 0x9a,
+		0xb5, 0x5c, 0x84, 0xa3, 0xf5, 0xd5, 0x0b, 0x6a, 0xb9, 0xba, 0x51, 0x5f, 0x5b, 0x0d, 0x36, 0x7b,
+		0xa0, 0x6c, 0x69, 0x6d, 0xb3, 0xb2, 0x5c, 0xd3, 0xf0, 0x51, 0x1c, 0x76, 0x24, 0x20, 0x50, 0xf6,
+		0xdc, 0xea, 0x46, 0x7d, 0xa5, 0x26, 0x45, 0x2b, 0x17, 0xc6, 0x6e, 0x4a, 0x3d, 0x78, 0xa0, 0x9e,
+		0x7a, 0xc6, 0x3d, 0xb0, 0x33, 0xf5, 0x7f, 0x07, 0x00, 0x55, 0x6b, 0x78, 0xa9, 0xd3, 0x9c, 0x00,
+		0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2291,6 +2415,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3184,6 +3317,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3403,6 +3566,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3796,6 +4008,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3855,6 +4073,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorUpdates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6530,6 +6772,108 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7019,6 +7363,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// tokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts of the tokenize share records.
+const tokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new tokenize share record of an id.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: TokenizeShareModuleAccountName(id),
+		Validator:     validator.String(),
+	}
+}
+
+// TokenizeShareModuleAccountName returns the name of the module account of the
+// tokenize share record of an id.
+func TokenizeShareModuleAccountName(id uint64) string {
+	return tokenizeShareModuleAccountPrefix + strconv.FormatUint(id, 10)
+}
+
+// GetModuleAddress returns the address of the module account delegating the
+// shares of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the tokens of the shares of the
+// record: the lowercase operator address of the validator and the record id.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator) + "/" + strconv.FormatUint(r.Id, 10)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner of tokenize share record %d: %w", r.Id, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid validator of tokenize share record %d: %w", r.Id, err)
+	}
+	if r.ModuleAccount != TokenizeShareModuleAccountName(r.Id) {
+		return fmt.Errorf("invalid module account of tokenize share record %d: %s", r.Id, r.ModuleAccount)
+	}
+
+	return nil
+}
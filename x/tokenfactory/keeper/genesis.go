@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the tokenfactory module's genesis state from a given
+// genesis state. The bank metadata of the denoms is part of the bank genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, denom := range genState.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(denom.Denom)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.setDenom(ctx, denom.Denom, creator, denom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var denoms []types.GenesisDenom
+	err = k.DenomAuthority.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+	// the iterator of an empty collection is invalid
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, denoms)
+}
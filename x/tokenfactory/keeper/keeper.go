@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Keeper defines the tokenfactory module's keeper.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	// Params are the parameters of the module.
+	Params collections.Item[types.Params]
+	// DenomAuthority is the authority metadata of the factory denoms.
+	DenomAuthority collections.Map[string, types.DenomAuthorityMetadata]
+	// CreatorDenoms indexes the factory denoms by creator.
+	CreatorDenoms collections.KeySet[collections.Pair[string, string]]
+}
+
+// NewKeeper returns a new tokenfactory keeper. It panics if the module account
+// is not set.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey *storetypes.KVStoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	authority string,
+) Keeper {
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	k := Keeper{
+		storeKey:       storeKey,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		distrKeeper:    dk,
+		authority:      authority,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthority: collections.NewMap(sb, types.DenomAuthorityPrefix, "denom_authority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms:  collections.NewKeySet(sb, types.CreatorDenomsPrefix, "creator_denoms", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, whose admin is
+// the creator, and charges the denom creation fee to the creator, which funds
+// the community pool. The bank metadata of the denom is initialized with the
+// denom as its only unit.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthority.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if has || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", types.ErrDenomExists.Wrapf("denom %s", denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return "", types.ErrDenomExists.Wrapf("metadata of denom %s", denom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.DenomCreationFee.IsZero() {
+		creatorAddr, err := sdk.AccAddressFromBech32(creator)
+		if err != nil {
+			return "", err
+		}
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.DenomCreationFee, creatorAddr); err != nil {
+			return "", err
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("The %s token created by %s.", subdenom, creator),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      subdenom,
+	})
+
+	if err := k.setDenom(ctx, denom, creator, types.DenomAuthorityMetadata{Admin: creator}); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom, or
+// ErrDenomNotFound if the denom was not created.
+func (k Keeper) GetAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.DenomAuthority.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, types.ErrDenomNotFound.Wrapf("denom %s", denom)
+	}
+
+	return metadata, err
+}
+
+// GetDenomsFromCreator returns the factory denoms created by the creator, in
+// ascending order.
+func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error) {
+	var denoms []string
+	err := k.CreatorDenoms.Walk(ctx, collections.NewPrefixedPairRange[string, string](creator), func(key collections.Pair[string, string]) bool {
+		denoms = append(denoms, key.K2())
+		return false
+	})
+	// the iterator of an empty range is invalid
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	return denoms, nil
+}
+
+// setDenom records the authority metadata of the denom and indexes it by
+// creator.
+func (k Keeper) setDenom(ctx context.Context, denom, creator string, metadata types.DenomAuthorityMetadata) error {
+	if err := k.DenomAuthority.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(creator, denom))
+}
+
+// requireAdmin returns ErrUnauthorized if the account is not the admin of the
+// factory denom.
+func (k Keeper) requireAdmin(ctx context.Context, denom, account string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if metadata.Admin == "" || metadata.Admin != account {
+		return types.ErrUnauthorized.Wrapf("account %s is not the admin of denom %s", account, denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/golang/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/tokenfactory"
+	"cosmossdk.io/x/tokenfactory/keeper"
+	tftestutil "cosmossdk.io/x/tokenfactory/testutil"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type fixture struct {
+	ctx sdk.Context
+
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *tftestutil.MockBankKeeper
+	distrKeeper *tftestutil.MockDistributionKeeper
+
+	authority string
+	creator   sdk.AccAddress
+}
+
+func initFixture(t *testing.T) *fixture {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(tokenfactory.AppModuleBasic{})
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := tftestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	bankKeeper := tftestutil.NewMockBankKeeper(ctrl)
+	distrKeeper := tftestutil.NewMockDistributionKeeper(ctrl)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := keeper.NewKeeper(encCfg.Codec, key, accountKeeper, bankKeeper, distrKeeper, authority)
+	k.InitGenesis(ctx, types.DefaultGenesisState())
+
+	return &fixture{
+		ctx:         ctx,
+		keeper:      k,
+		msgServer:   keeper.NewMsgServerImpl(k),
+		queryServer: keeper.NewQuerier(k),
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
+		authority:   authority,
+		creator:     account("creator"),
+	}
+}
+
+func account(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// createDenom creates the subdenom of the creator, expecting the denom creation
+// fee to be paid.
+func (f *fixture) createDenom(t *testing.T, subdenom string) string {
+	denom := "factory/" + f.creator.String() + "/" + subdenom
+	f.bankKeeper.EXPECT().HasSupply(f.ctx, denom).Return(false)
+	f.bankKeeper.EXPECT().GetDenomMetaData(f.ctx, denom).Return(banktypes.Metadata{}, false)
+	f.distrKeeper.EXPECT().FundCommunityPool(f.ctx, types.DefaultDenomCreationFee, f.creator).Return(nil)
+	f.bankKeeper.EXPECT().SetDenomMetaData(f.ctx, gomock.Any())
+
+	res, err := f.msgServer.CreateDenom(f.ctx, types.NewMsgCreateDenom(f.creator.String(), subdenom))
+	assert.NilError(t, err)
+	assert.Equal(t, denom, res.NewTokenDenom)
+
+	return denom
+}
+
+func TestCreateDenom(t *testing.T) {
+	f := initFixture(t)
+
+	denom := f.createDenom(t, "bitcoin")
+	authority, err := f.queryServer.DenomAuthorityMetadata(f.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	assert.NilError(t, err)
+	assert.Equal(t, f.creator.String(), authority.AuthorityMetadata.Admin)
+
+	// a denom cannot be created twice
+	_, err = f.msgServer.CreateDenom(f.ctx, types.NewMsgCreateDenom(f.creator.String(), "bitcoin"))
+	assert.ErrorIs(t, err, types.ErrDenomExists)
+
+	// nor shadow a denom with a supply
+	litecoin := "factory/" + f.creator.String() + "/litecoin"
+	f.bankKeeper.EXPECT().HasSupply(f.ctx, litecoin).Return(true)
+	_, err = f.msgServer.CreateDenom(f.ctx, types.NewMsgCreateDenom(f.creator.String(), "litecoin"))
+	assert.ErrorIs(t, err, types.ErrDenomExists)
+
+	// without creation fee, the community pool is not funded
+	_, err = f.msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: types.NewParams(nil)})
+	assert.NilError(t, err)
+	ether := "factory/" + f.creator.String() + "/ether"
+	f.bankKeeper.EXPECT().HasSupply(f.ctx, ether).Return(false)
+	f.bankKeeper.EXPECT().GetDenomMetaData(f.ctx, ether).Return(banktypes.Metadata{}, false)
+	f.bankKeeper.EXPECT().SetDenomMetaData(f.ctx, gomock.Any()).Do(func(_ sdk.Context, metadata banktypes.Metadata) {
+		assert.NilError(t, metadata.Validate())
+		assert.Equal(t, ether, metadata.Base)
+	})
+	_, err = f.msgServer.CreateDenom(f.ctx, types.NewMsgCreateDenom(f.creator.String(), "ether"))
+	assert.NilError(t, err)
+
+	denoms, err := f.queryServer.DenomsFromCreator(f.ctx, &types.QueryDenomsFromCreatorRequest{Creator: f.creator.String()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{denom, ether}, denoms.Denoms)
+
+	denoms, err = f.queryServer.DenomsFromCreator(f.ctx, &types.QueryDenomsFromCreatorRequest{Creator: account("other").String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(denoms.Denoms))
+}
+
+func TestMintBurn(t *testing.T) {
+	f := initFixture(t)
+	denom := f.createDenom(t, "bitcoin")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	recipient := account("recipient")
+
+	// the admin mints to itself by default
+	f.bankKeeper.EXPECT().MintCoins(f.ctx, types.ModuleName, coins).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(f.ctx, types.ModuleName, f.creator, coins).Return(nil)
+	_, err := f.msgServer.Mint(f.ctx, types.NewMsgMint(f.creator.String(), coins[0], ""))
+	assert.NilError(t, err)
+
+	f.bankKeeper.EXPECT().MintCoins(f.ctx, types.ModuleName, coins).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(f.ctx, types.ModuleName, recipient, coins).Return(nil)
+	_, err = f.msgServer.Mint(f.ctx, types.NewMsgMint(f.creator.String(), coins[0], recipient.String()))
+	assert.NilError(t, err)
+
+	f.bankKeeper.EXPECT().SendCoinsFromAccountToModule(f.ctx, f.creator, types.ModuleName, coins).Return(nil)
+	f.bankKeeper.EXPECT().BurnCoins(f.ctx, types.ModuleName, coins).Return(nil)
+	_, err = f.msgServer.Burn(f.ctx, types.NewMsgBurn(f.creator.String(), coins[0]))
+	assert.NilError(t, err)
+
+	// only the admin can mint and burn
+	_, err = f.msgServer.Mint(f.ctx, types.NewMsgMint(recipient.String(), coins[0], ""))
+	assert.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = f.msgServer.Burn(f.ctx, types.NewMsgBurn(recipient.String(), coins[0]))
+	assert.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// of a created denom
+	unknown := sdk.NewInt64Coin("factory/"+f.creator.String()+"/unknown", 100)
+	_, err = f.msgServer.Mint(f.ctx, types.NewMsgMint(f.creator.String(), unknown, ""))
+	assert.ErrorIs(t, err, types.ErrDenomNotFound)
+}
+
+func TestChangeAdmin(t *testing.T) {
+	f := initFixture(t)
+	denom := f.createDenom(t, "bitcoin")
+	newAdmin := account("admin")
+
+	_, err := f.msgServer.ChangeAdmin(f.ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, newAdmin.String()))
+	assert.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = f.msgServer.ChangeAdmin(f.ctx, types.NewMsgChangeAdmin(f.creator.String(), denom, newAdmin.String()))
+	assert.NilError(t, err)
+	authority, err := f.keeper.GetAuthorityMetadata(f.ctx, denom)
+	assert.NilError(t, err)
+	assert.Equal(t, newAdmin.String(), authority.Admin)
+
+	// the previous admin cannot manage the denom anymore
+	metadata := banktypes.Metadata{
+		Description: "Wrapped bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "wbtc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "wbtc",
+		Name:    "Wrapped Bitcoin",
+		Symbol:  "WBTC",
+	}
+	_, err = f.msgServer.SetDenomMetadata(f.ctx, types.NewMsgSetDenomMetadata(f.creator.String(), metadata))
+	assert.ErrorIs(t, err, types.ErrUnauthorized)
+
+	f.bankKeeper.EXPECT().SetDenomMetaData(f.ctx, metadata)
+	_, err = f.msgServer.SetDenomMetadata(f.ctx, types.NewMsgSetDenomMetadata(newAdmin.String(), metadata))
+	assert.NilError(t, err)
+
+	// once renounced, the denom cannot be managed anymore
+	_, err = f.msgServer.ChangeAdmin(f.ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, ""))
+	assert.NilError(t, err)
+	_, err = f.msgServer.ChangeAdmin(f.ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, newAdmin.String()))
+	assert.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestUpdateParams(t *testing.T) {
+	f := initFixture(t)
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	_, err := f.msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.creator.String(), Params: params})
+	assert.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = f.msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.authority, Params: params})
+	assert.NilError(t, err)
+	res, err := f.queryServer.Params(f.ctx, &types.QueryParamsRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, params, res.Params)
+}
+
+func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	// the default state exports the default genesis
+	assert.DeepEqual(t, types.DefaultGenesisState(), f.keeper.ExportGenesis(f.ctx))
+
+	genState := types.NewGenesisState(types.NewParams(nil), []types.GenesisDenom{
+		{
+			Denom:             "factory/" + f.creator.String() + "/bitcoin",
+			AuthorityMetadata: types.DenomAuthorityMetadata{Admin: f.creator.String()},
+		},
+		{
+			Denom:             "factory/" + f.creator.String() + "/litecoin",
+			AuthorityMetadata: types.DenomAuthorityMetadata{},
+		},
+	})
+	assert.NilError(t, genState.Validate())
+
+	f.keeper.InitGenesis(f.ctx, genState)
+	assert.DeepEqual(t, genState, f.keeper.ExportGenesis(f.ctx))
+
+	denoms, err := f.keeper.GetDenomsFromCreator(f.ctx, f.creator.String())
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(denoms))
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation of the authority metadata. An empty admin
+// is valid, the denom can then no longer be managed.
+func (metadata DenomAuthorityMetadata) Validate() error {
+	if metadata.Admin == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(metadata.Admin)
+	return err
+}
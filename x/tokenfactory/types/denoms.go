@@ -0,0 +1,71 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DenomPrefix is the first part of the factory denoms, which are
+	// factory/{creator}/{subdenom}.
+	DenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the factory denom created by the creator for the
+// subdenom, i.e. factory/{creator}/{subdenom}.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if err := ValidateSubdenom(subdenom); err != nil {
+		return "", err
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a factory denom. It
+// returns an error if the denom is not a factory denom.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != DenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, DenomPrefix)
+	}
+
+	creator, subdenom = parts[1], parts[2]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+	if err := ValidateSubdenom(subdenom); err != nil {
+		return "", "", err
+	}
+
+	return creator, subdenom, nil
+}
+
+// ValidateSubdenom returns an error if the subdenom is empty, longer than
+// MaxSubdenomLength or contains a '/'.
+func ValidateSubdenom(subdenom string) error {
+	switch {
+	case subdenom == "":
+		return errorsmod.Wrap(ErrInvalidDenom, "subdenom cannot be empty")
+	case len(subdenom) > MaxSubdenomLength:
+		return errorsmod.Wrapf(ErrInvalidDenom, "subdenom cannot be longer than %d characters", MaxSubdenomLength)
+	case strings.Contains(subdenom, "/"):
+		return errorsmod.Wrapf(ErrInvalidDenom, "subdenom %s cannot contain '/'", subdenom)
+	}
+
+	return nil
+}
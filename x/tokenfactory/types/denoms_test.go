@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDenoms(t *testing.T) {
+	creator := sdk.AccAddress(crypto.AddressHash([]byte("creator"))).String()
+
+	denom, err := types.GetTokenDenom(creator, "bitcoin")
+	assert.NilError(t, err)
+	assert.Equal(t, "factory/"+creator+"/bitcoin", denom)
+
+	gotCreator, subdenom, err := types.DeconstructDenom(denom)
+	assert.NilError(t, err)
+	assert.Equal(t, creator, gotCreator)
+	assert.Equal(t, "bitcoin", subdenom)
+
+	invalidSubdenoms := []string{"", "bit/coin", "a-very-long-subdenom-which-exceeds-the-maximum-length"}
+	for _, subdenom := range invalidSubdenoms {
+		_, err := types.GetTokenDenom(creator, subdenom)
+		assert.ErrorIs(t, err, types.ErrInvalidDenom, subdenom)
+	}
+
+	invalidDenoms := []string{
+		"stake",
+		"ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2",
+		"factory/" + creator,
+		"factory/invalid/bitcoin",
+		"tokens/" + creator + "/bitcoin",
+		"factory/" + creator + "/bit/coin",
+	}
+	for _, denom := range invalidDenoms {
+		_, _, err := types.DeconstructDenom(denom)
+		assert.ErrorIs(t, err, types.ErrInvalidDenom, denom)
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	creator := sdk.AccAddress(crypto.AddressHash([]byte("creator"))).String()
+	denom := types.GenesisDenom{
+		Denom:             "factory/" + creator + "/bitcoin",
+		AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator},
+	}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"denoms", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom}), false},
+		{"renounced admin", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: denom.Denom}}), false},
+		{"invalid fee", types.NewGenesisState(types.NewParams(sdk.Coins{sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("stake", 2)}), nil), true},
+		{"duplicate denom", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom, denom}), true},
+		{"invalid denom", types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: "stake"}}), true},
+		{
+			"invalid admin",
+			types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: denom.Denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "invalid"}}}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expErr {
+			assert.Assert(t, err != nil, tc.name)
+		} else {
+			assert.NilError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	// ErrInvalidDenom is returned for a denom which is not a valid factory
+	// denom.
+	ErrInvalidDenom = errors.Register(ModuleName, 2, "invalid denom")
+
+	// ErrDenomExists is returned when creating a denom which already exists.
+	ErrDenomExists = errors.Register(ModuleName, 3, "denom already exists")
+
+	// ErrDenomNotFound is returned for a factory denom which was not created.
+	ErrDenomNotFound = errors.Register(ModuleName, 4, "denom not found")
+
+	// ErrUnauthorized is returned when the sender of a Msg is not the admin of
+	// the denom.
+	ErrUnauthorized = errors.Register(ModuleName, 5, "unauthorized account")
+)
@@ -0,0 +1,24 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the key of the module parameters: 0x1 -> ProtocolBuffer(Params)
+	ParamsKey = collections.NewPrefix(1)
+
+	// DenomAuthorityPrefix is the prefix of the authority metadata of the
+	// factory denoms: 0x2 | denom -> ProtocolBuffer(DenomAuthorityMetadata)
+	DenomAuthorityPrefix = collections.NewPrefix(2)
+
+	// CreatorDenomsPrefix is the prefix of the index of the factory denoms by
+	// creator: 0x3 | creator | denom -> []byte{}
+	CreatorDenomsPrefix = collections.NewPrefix(3)
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDenomCreationFee is the default fee paid to create a denom.
+var DefaultDenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000))
+
+// NewParams creates a new Params instance.
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams returns the default parameters of the module.
+func DefaultParams() Params {
+	return NewParams(DefaultDenomCreationFee)
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}